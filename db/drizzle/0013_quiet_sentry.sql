@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS "held_messages" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"chat_id" varchar NOT NULL,
+	"sender_id" varchar NOT NULL,
+	"message" json NOT NULL,
+	"reason" varchar NOT NULL,
+	"status" varchar NOT NULL,
+	"created_at" timestamp DEFAULT now() NOT NULL,
+	"updated_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE INDEX IF NOT EXISTS "idx_held_messages_chat_id" ON "held_messages" USING btree ("chat_id");--> statement-breakpoint
+CREATE INDEX IF NOT EXISTS "idx_held_messages_status" ON "held_messages" USING btree ("status");
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE "user_files" ADD COLUMN "metadata" json;
\ No newline at end of file
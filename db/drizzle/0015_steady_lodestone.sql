@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS "chat_read_cursors" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"chat_id" varchar NOT NULL,
+	"user_id" varchar NOT NULL,
+	"message_id" varchar NOT NULL,
+	"updated_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_chat_read_cursors_chat_user" ON "chat_read_cursors" USING btree ("chat_id","user_id");
\ No newline at end of file
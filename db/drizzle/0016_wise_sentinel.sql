@@ -0,0 +1,2 @@
+ALTER TABLE "users" ADD COLUMN "hide_online" boolean DEFAULT false;--> statement-breakpoint
+ALTER TABLE "users" ADD COLUMN "last_seen_at" timestamp;
\ No newline at end of file
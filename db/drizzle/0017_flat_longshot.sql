@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "scheduled_messages" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"chat_id" varchar NOT NULL,
+	"sender_id" varchar NOT NULL,
+	"message" json NOT NULL,
+	"deliver_at" timestamp NOT NULL,
+	"status" varchar NOT NULL,
+	"attempts" integer DEFAULT 0 NOT NULL,
+	"error" text DEFAULT '',
+	"created_at" timestamp DEFAULT now() NOT NULL,
+	"updated_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE INDEX IF NOT EXISTS "idx_scheduled_messages_chat_sender" ON "scheduled_messages" USING btree ("chat_id","sender_id");--> statement-breakpoint
+CREATE INDEX IF NOT EXISTS "idx_scheduled_messages_status_deliver_at" ON "scheduled_messages" USING btree ("status","deliver_at");
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "connection_settings" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"user_id" varchar NOT NULL,
+	"match_id" varchar NOT NULL,
+	"pinned" boolean DEFAULT false NOT NULL,
+	"archived" boolean DEFAULT false NOT NULL,
+	"muted_until" timestamp,
+	"nickname" varchar DEFAULT '' NOT NULL,
+	"updated_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_connection_settings_user_match" ON "connection_settings" USING btree ("user_id","match_id");
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "message_revisions" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"chat_id" varchar NOT NULL,
+	"message_id" varchar NOT NULL,
+	"sender_id" varchar NOT NULL,
+	"content" text DEFAULT '' NOT NULL,
+	"media" json,
+	"created_at" timestamp NOT NULL,
+	"replaced_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE INDEX IF NOT EXISTS "idx_message_revisions_chat_message" ON "message_revisions" USING btree ("chat_id","message_id");
\ No newline at end of file
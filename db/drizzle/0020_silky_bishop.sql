@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "planned_dates" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"match_id" varchar NOT NULL,
+	"chat_id" varchar NOT NULL,
+	"message_id" varchar NOT NULL,
+	"proposed_by" varchar NOT NULL,
+	"accepted_by" varchar NOT NULL,
+	"starts_at" timestamp NOT NULL,
+	"venue" text NOT NULL,
+	"note" text DEFAULT '' NOT NULL,
+	"created_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_planned_dates_message" ON "planned_dates" USING btree ("message_id");--> statement-breakpoint
+CREATE INDEX IF NOT EXISTS "idx_planned_dates_match_starts_at" ON "planned_dates" USING btree ("match_id","starts_at");
\ No newline at end of file
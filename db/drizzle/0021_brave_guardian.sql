@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "trusted_contacts" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"user_id" varchar NOT NULL,
+	"name" varchar NOT NULL,
+	"email" varchar NOT NULL,
+	"created_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_trusted_contacts_user_email" ON "trusted_contacts" USING btree ("user_id","email");--> statement-breakpoint
+CREATE TABLE IF NOT EXISTS "date_checkins" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"user_id" varchar NOT NULL,
+	"date_id" varchar NOT NULL,
+	"check_in_at" timestamp NOT NULL,
+	"status" varchar NOT NULL,
+	"checked_in_at" timestamp,
+	"alerted_at" timestamp,
+	"created_at" timestamp DEFAULT now() NOT NULL,
+	"updated_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE INDEX IF NOT EXISTS "idx_date_checkins_user" ON "date_checkins" USING btree ("user_id");--> statement-breakpoint
+CREATE INDEX IF NOT EXISTS "idx_date_checkins_status_check_in_at" ON "date_checkins" USING btree ("status","check_in_at");
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "chat_exports" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"chat_id" varchar NOT NULL,
+	"user_id" varchar NOT NULL,
+	"format" varchar NOT NULL,
+	"status" varchar NOT NULL,
+	"attempts" integer DEFAULT 0 NOT NULL,
+	"file_id" varchar DEFAULT '',
+	"error" text DEFAULT '',
+	"created_at" timestamp DEFAULT now() NOT NULL,
+	"updated_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE INDEX IF NOT EXISTS "idx_chat_exports_user_chat" ON "chat_exports" USING btree ("user_id","chat_id");
\ No newline at end of file
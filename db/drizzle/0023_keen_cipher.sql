@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS "chat_keys" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"chat_id" varchar NOT NULL,
+	"version" integer NOT NULL,
+	"master_key_id" integer NOT NULL,
+	"wrapped_key" text NOT NULL,
+	"created_at" timestamp DEFAULT now() NOT NULL,
+	"updated_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_chat_keys_chat_version" ON "chat_keys" USING btree ("chat_id","version");
\ No newline at end of file
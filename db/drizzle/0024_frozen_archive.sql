@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "chat_archives" (
+	"id" varchar PRIMARY KEY NOT NULL,
+	"chat_id" varchar NOT NULL,
+	"seq" integer NOT NULL,
+	"message_ids" json DEFAULT '[]'::json NOT NULL,
+	"message_count" integer NOT NULL,
+	"first_message_at" timestamp NOT NULL,
+	"last_message_at" timestamp NOT NULL,
+	"s3_path" text NOT NULL,
+	"created_at" timestamp DEFAULT now() NOT NULL,
+	"updated_at" timestamp DEFAULT now() NOT NULL
+);--> statement-breakpoint
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_chat_archives_chat_seq" ON "chat_archives" USING btree ("chat_id","seq");
@@ -0,0 +1 @@
+ALTER TABLE "message_revisions" ADD COLUMN "sealed" json;
\ No newline at end of file
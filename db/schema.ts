@@ -31,6 +31,8 @@ export const users = pgTable("users", {
   is_verified: boolean("is_verified").default(false),
   address: json("address").notNull().default({}),
   extra: json("extra").default({}),
+  hide_online: boolean("hide_online").default(false), // hides is_online and last_seen_at from matches
+  last_seen_at: timestamp("last_seen_at"),
   created_at: timestamp("created_at").defaultNow().notNull(),
   updated_at: timestamp("updated_at").defaultNow().notNull(),
 });
@@ -95,6 +97,7 @@ export const user_files = pgTable("user_files", {
   key: varchar("key").notNull(),
   s3_path: varchar("s3_path").notNull(),
   visibility: varchar("visibility").notNull(),
+  metadata: json("metadata"), // audio duration, waveform, codec and size
   created_at: timestamp("created_at").defaultNow(),
   updated_at: timestamp("updated_at").defaultNow(),
 });
@@ -143,3 +146,232 @@ export const user_verifications = pgTable("user_verifications", {
   created_at: timestamp("created_at").defaultNow().notNull(),
   updated_at: timestamp("updated_at").defaultNow().notNull(),
 });
+
+export const held_messages = pgTable(
+  "held_messages",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    chat_id: varchar("chat_id").notNull(),
+    sender_id: varchar("sender_id").notNull(),
+    message: json("message").notNull(),
+    reason: varchar("reason").notNull(), // "contact_info", "payment_link", "profanity", "flagged_content"
+    status: varchar("status").notNull(), // "pending", "released", "discarded"
+    created_at: timestamp("created_at").defaultNow().notNull(),
+    updated_at: timestamp("updated_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    heldMessagesChatIdIdx: index("idx_held_messages_chat_id").on(table.chat_id),
+    heldMessagesStatusIdx: index("idx_held_messages_status").on(table.status),
+  }),
+);
+
+export const chat_read_cursors = pgTable(
+  "chat_read_cursors",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    chat_id: varchar("chat_id").notNull(),
+    user_id: varchar("user_id").notNull(),
+    message_id: varchar("message_id").notNull(), // last message the user has seen
+    updated_at: timestamp("updated_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    chatReadCursorsChatUserIdx: uniqueIndex("idx_chat_read_cursors_chat_user").on(
+      table.chat_id,
+      table.user_id,
+    ),
+  }),
+);
+
+export const scheduled_messages = pgTable(
+  "scheduled_messages",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    chat_id: varchar("chat_id").notNull(),
+    sender_id: varchar("sender_id").notNull(),
+    message: json("message").notNull(),
+    deliver_at: timestamp("deliver_at").notNull(),
+    status: varchar("status").notNull(), // "pending", "sending", "sent", "held", "cancelled", "failed"
+    attempts: integer("attempts").default(0).notNull(),
+    error: text("error").default(""),
+    created_at: timestamp("created_at").defaultNow().notNull(),
+    updated_at: timestamp("updated_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    scheduledMessagesChatSenderIdx: index("idx_scheduled_messages_chat_sender").on(
+      table.chat_id,
+      table.sender_id,
+    ),
+    scheduledMessagesDueIdx: index("idx_scheduled_messages_status_deliver_at").on(
+      table.status,
+      table.deliver_at,
+    ),
+  }),
+);
+
+export const connection_settings = pgTable(
+  "connection_settings",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    user_id: varchar("user_id").notNull(),
+    match_id: varchar("match_id").notNull(),
+    pinned: boolean("pinned").default(false).notNull(),
+    archived: boolean("archived").default(false).notNull(),
+    muted_until: timestamp("muted_until"),
+    nickname: varchar("nickname").default("").notNull(),
+    updated_at: timestamp("updated_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    connectionSettingsUserMatchIdx: uniqueIndex("idx_connection_settings_user_match").on(
+      table.user_id,
+      table.match_id,
+    ),
+  }),
+);
+
+export const message_revisions = pgTable(
+  "message_revisions",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    chat_id: varchar("chat_id").notNull(),
+    message_id: varchar("message_id").notNull(),
+    sender_id: varchar("sender_id").notNull(),
+    content: text("content").default("").notNull(),
+    media: json("media"),
+    sealed: json("sealed"), // content and media under the chat's data key
+    created_at: timestamp("created_at").notNull(), // when this version was written
+    replaced_at: timestamp("replaced_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    messageRevisionsChatMessageIdx: index("idx_message_revisions_chat_message").on(
+      table.chat_id,
+      table.message_id,
+    ),
+  }),
+);
+
+export const planned_dates = pgTable(
+  "planned_dates",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    match_id: varchar("match_id").notNull(),
+    chat_id: varchar("chat_id").notNull(),
+    message_id: varchar("message_id").notNull(), // the accepted DATE_PROPOSAL
+    proposed_by: varchar("proposed_by").notNull(),
+    accepted_by: varchar("accepted_by").notNull(),
+    starts_at: timestamp("starts_at").notNull(),
+    venue: text("venue").notNull(),
+    note: text("note").default("").notNull(),
+    created_at: timestamp("created_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    plannedDatesMessageIdx: uniqueIndex("idx_planned_dates_message").on(
+      table.message_id,
+    ),
+    plannedDatesMatchIdx: index("idx_planned_dates_match_starts_at").on(
+      table.match_id,
+      table.starts_at,
+    ),
+  }),
+);
+
+export const trusted_contacts = pgTable(
+  "trusted_contacts",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    user_id: varchar("user_id").notNull(),
+    name: varchar("name").notNull(),
+    email: varchar("email").notNull(),
+    created_at: timestamp("created_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    trustedContactsUserEmailIdx: uniqueIndex("idx_trusted_contacts_user_email").on(
+      table.user_id,
+      table.email,
+    ),
+  }),
+);
+
+export const date_checkins = pgTable(
+  "date_checkins",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    user_id: varchar("user_id").notNull(),
+    date_id: varchar("date_id").notNull(),
+    check_in_at: timestamp("check_in_at").notNull(),
+    status: varchar("status").notNull(), // "scheduled", "checked_in", "missed", "cancelled"
+    checked_in_at: timestamp("checked_in_at"),
+    alerted_at: timestamp("alerted_at"),
+    created_at: timestamp("created_at").defaultNow().notNull(),
+    updated_at: timestamp("updated_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    dateCheckinsUserIdx: index("idx_date_checkins_user").on(table.user_id),
+    dateCheckinsDueIdx: index("idx_date_checkins_status_check_in_at").on(
+      table.status,
+      table.check_in_at,
+    ),
+  }),
+);
+
+export const chat_exports = pgTable(
+  "chat_exports",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    chat_id: varchar("chat_id").notNull(),
+    user_id: varchar("user_id").notNull(),
+    format: varchar("format").notNull(), // "json", "html"
+    status: varchar("status").notNull(), // "pending", "running", "ready", "failed"
+    attempts: integer("attempts").default(0).notNull(),
+    file_id: varchar("file_id").default(""),
+    error: text("error").default(""),
+    created_at: timestamp("created_at").defaultNow().notNull(),
+    updated_at: timestamp("updated_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    chatExportsUserChatIdx: index("idx_chat_exports_user_chat").on(
+      table.user_id,
+      table.chat_id,
+    ),
+  }),
+);
+
+export const chat_keys = pgTable(
+  "chat_keys",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    chat_id: varchar("chat_id").notNull(),
+    version: integer("version").notNull(),
+    master_key_id: integer("master_key_id").notNull(),
+    wrapped_key: text("wrapped_key").notNull(), // base64 nonce and ciphertext
+    created_at: timestamp("created_at").defaultNow().notNull(),
+    updated_at: timestamp("updated_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    chatKeysChatVersionIdx: uniqueIndex("idx_chat_keys_chat_version").on(
+      table.chat_id,
+      table.version,
+    ),
+  }),
+);
+
+export const chat_archives = pgTable(
+  "chat_archives",
+  {
+    id: varchar("id").primaryKey().notNull(),
+    chat_id: varchar("chat_id").notNull(),
+    seq: integer("seq").notNull(),
+    message_ids: json("message_ids").default([]).notNull(),
+    message_count: integer("message_count").notNull(),
+    first_message_at: timestamp("first_message_at").notNull(),
+    last_message_at: timestamp("last_message_at").notNull(),
+    s3_path: text("s3_path").notNull(), // gzipped JSON of sealed messages
+    created_at: timestamp("created_at").defaultNow().notNull(),
+    updated_at: timestamp("updated_at").defaultNow().notNull(),
+  },
+  (table) => ({
+    chatArchivesChatSeqIdx: uniqueIndex("idx_chat_archives_chat_seq").on(
+      table.chat_id,
+      table.seq,
+    ),
+  }),
+);
@@ -0,0 +1,47 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// LastActivity returns when each chat last had a message, keyed by chat id.
+// It reads last_activity_ts from the chat meta hash and falls back to the
+// newest flushed message, then to when the chat was created.
+func LastActivity(chats []models.Chat) (map[string]time.Time, error) {
+	activity := make(map[string]time.Time, len(chats))
+	if len(chats) == 0 {
+		return activity, nil
+	}
+
+	backend := openBackend()
+	defer backend.Close()
+
+	keys := make([]string, len(chats))
+	for i, chat := range chats {
+		keys[i] = chatMetaKey(chat.Id)
+	}
+	stamps, err := backend.Fields("last_activity_ts", keys...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chat activity: %w", err)
+	}
+
+	for i, chat := range chats {
+		last := chat.CreatedAt
+		if n := len(chat.Messages); n > 0 && chat.Messages[n-1].CreatedAt.After(last) {
+			last = chat.Messages[n-1].CreatedAt
+		}
+		if raw := stamps[i]; raw != "" {
+			if ts, err := strconv.ParseInt(raw, 10, 64); err == nil {
+				if t := time.Unix(ts, 0); t.After(last) {
+					last = t
+				}
+			}
+		}
+		activity[chat.Id] = last
+	}
+
+	return activity, nil
+}
@@ -0,0 +1,399 @@
+package chatservice
+
+import (
+	"blindly/internal/helpers/storage"
+	"blindly/internal/models"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/utils"
+)
+
+// The oldest messages of long chats move out of the chats row into gzipped
+// segments in the file backend, still sealed. A segment row keeps the ids it
+// holds so a page or a reply can find it without downloading every segment.
+// Archived messages are read-only: reactions, edits and receipts only apply
+// to the hot window.
+
+const (
+	// ArchiveSegmentSize is how many messages go into one segment. Only whole
+	// segments are archived, so small chats never are.
+	ArchiveSegmentSize = 200
+	// DefaultArchiveKeep is how many recent messages stay in the chats row
+	// when CHAT_ARCHIVE_KEEP is not set.
+	DefaultArchiveKeep = 500
+	// DefaultArchiveAfter is the age past which messages are archived when
+	// CHAT_ARCHIVE_AFTER is not set.
+	DefaultArchiveAfter = 90 * 24 * time.Hour
+
+	// ArchiveInterval is how often the scheduler looks for chats to archive.
+	ArchiveInterval = 6 * time.Hour
+	// archiveSweepLimit bounds the chats archived per sweep.
+	archiveSweepLimit = 100
+	// archiveCacheTTL keeps a segment in Redis while a client scrolls it.
+	archiveCacheTTL = 10 * time.Minute
+	// allArchived is the beforeSeq that takes in every segment.
+	allArchived = math.MaxInt32
+)
+
+func chatArchiveCacheKey(chatId string, seq int) string {
+	return fmt.Sprintf("blindly:chat:%s:archive:%d", chatId, seq)
+}
+
+// ArchiveKeep is how many recent messages stay in the chats row. It is read
+// from CHAT_ARCHIVE_KEEP.
+func ArchiveKeep() int {
+	raw := config.GetEnvRaw("CHAT_ARCHIVE_KEEP")
+	if raw == "" {
+		return DefaultArchiveKeep
+	}
+	keep, err := strconv.Atoi(raw)
+	if err != nil || keep < 0 {
+		log.Printf("invalid CHAT_ARCHIVE_KEEP %q, using %d", raw, DefaultArchiveKeep)
+		return DefaultArchiveKeep
+	}
+	return keep
+}
+
+// ArchiveAfter is how old a message has to be to be archived regardless of
+// ArchiveKeep. It is read from CHAT_ARCHIVE_AFTER as a duration such as
+// "720h".
+func ArchiveAfter() time.Duration {
+	raw := config.GetEnvRaw("CHAT_ARCHIVE_AFTER")
+	if raw == "" {
+		return DefaultArchiveAfter
+	}
+	after, err := time.ParseDuration(raw)
+	if err != nil || after <= 0 {
+		log.Printf("invalid CHAT_ARCHIVE_AFTER %q, using %s", raw, DefaultArchiveAfter)
+		return DefaultArchiveAfter
+	}
+	return after
+}
+
+// archivableCount returns how many of the oldest messages are due for the
+// archive: those beyond the newest keep and those older than cutoff, rounded
+// down to whole segments.
+func archivableCount(messages []models.Message, keep int, cutoff time.Time) int {
+	n := max(len(messages)-keep, 0)
+	for n < len(messages) && messages[n].CreatedAt.Before(cutoff) {
+		n++
+	}
+	return n - n%ArchiveSegmentSize
+}
+
+func encodeSegment(messages []models.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(messages); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeSegment(data []byte) ([]models.Message, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+	var messages []models.Message
+	if err := json.Unmarshal(raw, &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// ArchiveChat moves the chat's due messages into archive segments and
+// returns how many were moved.
+func ArchiveChat(chatId string) (int, error) {
+	s := NewStoreWithoutAuth(chatId)
+	defer s.Close()
+
+	return s.archiveMessages(ArchiveKeep(), time.Now().Add(-ArchiveAfter()))
+}
+
+func (s *Store) archiveMessages(keep int, cutoff time.Time) (int, error) {
+	archived := 0
+	err := s.modifyMessagesInTx(func(tx execer, messages []models.Message) ([]models.Message, bool, error) {
+		archived = 0
+		n := archivableCount(messages, keep, cutoff)
+		if n == 0 {
+			return messages, false, nil
+		}
+
+		seq, err := s.lastArchiveSeq()
+		if err != nil {
+			return nil, false, err
+		}
+
+		now := time.Now()
+		for start := 0; start < n; start += ArchiveSegmentSize {
+			seq++
+			segment := slices.Clone(messages[start : start+ArchiveSegmentSize])
+			if err := s.sealAll(segment); err != nil {
+				return nil, false, fmt.Errorf("failed to seal segment: %w", err)
+			}
+			data, err := encodeSegment(segment)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to encode segment: %w", err)
+			}
+
+			id := strings.ToUpper(utils.GenerateID(20))
+			// A failed commit leaves the object behind unreferenced, never
+			// messages missing from both places.
+			s3Path, err := storage.Put(
+				fmt.Sprintf("blindly/chat_archives/%s/%d-%s.json.gz", s.chatId, seq, id),
+				data,
+				"application/gzip",
+			)
+			if err != nil {
+				return nil, false, err
+			}
+
+			ids := make([]string, len(segment))
+			for i, m := range segment {
+				ids[i] = m.Id
+			}
+			idsJSON, err := json.Marshal(ids)
+			if err != nil {
+				return nil, false, err
+			}
+			if _, err := tx.Exec(`
+				INSERT INTO chat_archives
+					(id, chat_id, seq, message_ids, message_count, first_message_at, last_message_at, s3_path, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+			`, id, s.chatId, seq, string(idsJSON), len(segment), segment[0].CreatedAt, segment[len(segment)-1].CreatedAt, s3Path, now); err != nil {
+				return nil, false, fmt.Errorf("failed to record archive segment: %w", err)
+			}
+		}
+
+		archived = n
+		return messages[n:], true, nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive chat %s: %w", s.chatId, err)
+	}
+	return archived, nil
+}
+
+func (s *Store) lastArchiveSeq() (int, error) {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	var seq int
+	if err := db.QueryRow(`
+		SELECT COALESCE(MAX(seq), 0) FROM chat_archives WHERE chat_id = $1
+	`, s.chatId).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("failed to get archive segments: %w", err)
+	}
+	return seq, nil
+}
+
+// ArchiveOldChats archives every chat that has at least one segment due and
+// returns how many messages were moved.
+func ArchiveOldChats() (int, error) {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	keep := ArchiveKeep()
+	cutoff := time.Now().Add(-ArchiveAfter())
+
+	var ids []string
+	err = db.Select(&ids, `
+		SELECT id FROM chats
+		WHERE json_array_length(messages) >= $1::int + $2::int
+		   OR (json_array_length(messages) >= $2::int
+		       AND (messages::jsonb -> ($2::int - 1) ->> 'created_at')::timestamptz < $3)
+		LIMIT $4
+	`, keep, ArchiveSegmentSize, cutoff.UTC(), archiveSweepLimit)
+	db.Close()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get chats to archive: %w", err)
+	}
+
+	total := 0
+	for _, id := range ids {
+		s := NewStoreWithoutAuth(id)
+		n, err := s.archiveMessages(keep, cutoff)
+		s.Close()
+		if err != nil {
+			log.Printf("archive: %v", err)
+			continue
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// archiveSegments returns the chat's segments before seq, newest first,
+// without their message ids.
+func (s *Store) archiveSegments(beforeSeq int) ([]models.ChatArchive, error) {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`
+		SELECT id, seq, s3_path FROM chat_archives
+		WHERE chat_id = $1 AND seq < $2
+		ORDER BY seq DESC
+	`, s.chatId, beforeSeq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive segments: %w", err)
+	}
+	defer rows.Close()
+
+	var segments []models.ChatArchive
+	for rows.Next() {
+		seg := models.ChatArchive{ChatId: s.chatId}
+		if err := rows.Scan(&seg.Id, &seg.Seq, &seg.S3Path); err != nil {
+			return nil, fmt.Errorf("failed to read archive segment: %w", err)
+		}
+		segments = append(segments, seg)
+	}
+	return segments, rows.Err()
+}
+
+// archiveSegmentOf returns the segment holding messageId, or nil when the
+// message is not archived.
+func (s *Store) archiveSegmentOf(messageId string) (*models.ChatArchive, error) {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	rows, err := db.Query(`
+		SELECT id, seq, s3_path FROM chat_archives
+		WHERE chat_id = $1 AND message_ids::jsonb @> jsonb_build_array($2::text)
+		LIMIT 1
+	`, s.chatId, messageId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find archive segment: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	seg := &models.ChatArchive{ChatId: s.chatId}
+	if err := rows.Scan(&seg.Id, &seg.Seq, &seg.S3Path); err != nil {
+		return nil, fmt.Errorf("failed to read archive segment: %w", err)
+	}
+	return seg, nil
+}
+
+// loadArchiveSegment returns the opened messages of a segment, oldest first.
+func (s *Store) loadArchiveSegment(seg *models.ChatArchive) ([]models.Message, error) {
+	s.ensureBackend()
+
+	key := chatArchiveCacheKey(s.chatId, seg.Seq)
+	cached, err := s.backend.Get(key)
+	if err != nil {
+		log.Printf("failed to read archive cache: %v", err)
+	}
+	data := []byte(cached)
+	if cached == "" {
+		if data, err = storage.Get(seg.S3Path); err != nil {
+			return nil, fmt.Errorf("failed to load archive segment %d: %w", seg.Seq, err)
+		}
+		if err := s.backend.Set(key, string(data), archiveCacheTTL); err != nil {
+			log.Printf("failed to cache archive segment: %v", err)
+		}
+	}
+
+	messages, err := decodeSegment(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode archive segment %d: %w", seg.Seq, err)
+	}
+	if err := s.openAll(messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// prependArchived fills messages with archived ones from segments before
+// beforeSeq until it holds limit, or the whole history when limit is zero.
+func (s *Store) prependArchived(messages []models.Message, limit int, beforeSeq int) ([]models.Message, error) {
+	segments, err := s.archiveSegments(beforeSeq)
+	if err != nil {
+		return nil, err
+	}
+	for i := range segments {
+		if limit > 0 && len(messages) >= limit {
+			break
+		}
+		older, err := s.loadArchiveSegment(&segments[i])
+		if err != nil {
+			return nil, err
+		}
+		messages = append(older, messages...)
+	}
+	if limit > 0 && len(messages) > limit {
+		messages = messages[len(messages)-limit:]
+	}
+	return messages, nil
+}
+
+// archivedPageBefore returns up to limit messages preceding an archived
+// message, or nil when messageId is not archived.
+func (s *Store) archivedPageBefore(limit int, messageId string) ([]models.Message, error) {
+	seg, err := s.archiveSegmentOf(messageId)
+	if err != nil || seg == nil {
+		return nil, err
+	}
+	messages, err := s.loadArchiveSegment(seg)
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(messages, func(m models.Message) bool { return m.Id == messageId })
+	if idx == -1 {
+		return nil, fmt.Errorf("archive segment %d does not hold message %s", seg.Seq, messageId)
+	}
+	return s.prependArchived(messages[:idx], limit, seg.Seq)
+}
+
+// archivedMessage returns an archived message by id, or nil when it is not
+// archived.
+func (s *Store) archivedMessage(messageId string) (*models.Message, error) {
+	seg, err := s.archiveSegmentOf(messageId)
+	if err != nil || seg == nil {
+		return nil, err
+	}
+	messages, err := s.loadArchiveSegment(seg)
+	if err != nil {
+		return nil, err
+	}
+	for i := range messages {
+		if messages[i].Id == messageId {
+			return &messages[i], nil
+		}
+	}
+	return nil, nil
+}
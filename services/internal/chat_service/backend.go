@@ -0,0 +1,115 @@
+package chatservice
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/utils"
+)
+
+// maxUpdateRetries bounds the optimistic updates of a backend.
+const maxUpdateRetries = 5
+
+// ErrContended is returned when an optimistic update lost to concurrent
+// writers more than maxUpdateRetries times.
+var ErrContended = errors.New("value kept changing, giving up")
+
+// Backend holds the hot state of chats: the buffer of messages not yet
+// flushed to Postgres, the channel their sockets listen on and small keyed
+// values such as flush tokens, locks, cursors and call state. Redis backs it
+// in production. The memory backend, chosen with CHAT_BACKEND=memory, serves
+// single-node deployments and tests, and flushes its buffer in process
+// instead of through QStash.
+type Backend interface {
+	// AppendMessage buffers msg, publishes event to the chat and records at
+	// as its last activity, in one step.
+	AppendMessage(chatId string, msg string, event string, at time.Time) error
+	// Buffered returns the buffered messages of each chat, oldest first.
+	Buffered(chatIds ...string) ([][]string, error)
+	BufferLen(chatId string) (int, error)
+	// PopBuffered removes and returns up to n of the oldest buffered messages.
+	PopBuffered(chatId string, n int) ([]string, error)
+	// RestoreBuffered puts msgs back in front of the buffer, in order.
+	RestoreBuffered(chatId string, msgs []string) error
+	// UpdateBuffered hands fn the buffer and replaces the messages it
+	// returns, by index. If the buffer changes before they are written, fn
+	// runs again on the new buffer.
+	UpdateBuffered(chatId string, fn func(msgs []string) (map[int]string, error)) error
+
+	// Publish sends event to the subscribers of every chat in chatIds.
+	Publish(event string, chatIds ...string) error
+	Subscribe(chatId string) Feed
+
+	// Get returns the value at key, or "" when there is none.
+	Get(key string) (string, error)
+	// Set stores value at key. A zero ttl keeps it until deleted.
+	Set(key string, value string, ttl time.Duration) error
+	// SetNX stores value only if key is unset and reports whether it did.
+	SetNX(key string, value string, ttl time.Duration) (bool, error)
+	Del(key string) error
+	// UpdateValue hands fn the value at key and stores what it returns for
+	// ttl, or deletes key when it returns "". If the value changes before it
+	// is written, fn runs again.
+	UpdateValue(key string, fn func(current string) (next string, ttl time.Duration, err error)) error
+
+	// Fields returns field of each hash in keys, "" where it is unset.
+	Fields(field string, keys ...string) ([]string, error)
+	SetFieldNX(key string, field string, value string) error
+	// UpdateField is UpdateValue for a hash field. Returning current leaves
+	// the field as it is.
+	UpdateField(key string, field string, fn func(current string) (string, error)) error
+
+	Close() error
+}
+
+// Feed delivers the events published to one chat.
+type Feed interface {
+	// Next blocks until an event arrives. It returns false once the feed is
+	// closed.
+	Next() (string, bool)
+	Close() error
+}
+
+var (
+	backendMu sync.Mutex
+	shared    Backend
+)
+
+// UseBackend makes every store share b instead of opening a Redis client of
+// its own. Stores never close a shared backend. Passing nil goes back to
+// Redis.
+func UseBackend(b Backend) {
+	backendMu.Lock()
+	defer backendMu.Unlock()
+	shared = b
+}
+
+// openBackend returns the shared backend, if any, or a new Redis backend.
+func openBackend() Backend {
+	backendMu.Lock()
+	defer backendMu.Unlock()
+
+	if shared == nil && config.GetEnvRaw("CHAT_BACKEND") == "memory" {
+		shared = NewMemoryBackend()
+	}
+	if shared != nil {
+		return sharedBackend{shared}
+	}
+	return newRedisBackend(utils.RedisConnect())
+}
+
+type sharedBackend struct{ Backend }
+
+func (sharedBackend) Close() error { return nil }
+
+// inProcess reports whether b keeps its state in this process, where a job
+// delivered to another instance could not see it.
+func inProcess(b Backend) bool {
+	if sb, ok := b.(sharedBackend); ok {
+		b = sb.Backend
+	}
+	_, ok := b.(*memoryBackend)
+	return ok
+}
@@ -0,0 +1,340 @@
+package chatservice
+
+import (
+	"log"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// feedBuffer is how many events a subscriber may fall behind before new ones
+// are dropped, like the channel of a Redis subscription.
+const feedBuffer = 100
+
+type memoryValue struct {
+	value     string
+	expiresAt time.Time
+}
+
+// memoryBackend keeps everything in process. Every key carries a version
+// that moves on each write, so optimistic updates retry exactly when a Redis
+// WATCH would. Nothing reads the set of active chats, so it is not kept.
+type memoryBackend struct {
+	mu       sync.Mutex
+	lists    map[string][]string
+	values   map[string]memoryValue
+	hashes   map[string]map[string]string
+	versions map[string]uint64
+	feeds    map[string]map[*memoryFeed]struct{}
+}
+
+// NewMemoryBackend returns an empty in-process backend. Its state lasts as
+// long as the backend, so it suits single-node deployments and tests.
+func NewMemoryBackend() Backend {
+	return &memoryBackend{
+		lists:    make(map[string][]string),
+		values:   make(map[string]memoryValue),
+		hashes:   make(map[string]map[string]string),
+		versions: make(map[string]uint64),
+		feeds:    make(map[string]map[*memoryFeed]struct{}),
+	}
+}
+
+func (b *memoryBackend) touch(key string) {
+	b.versions[key]++
+}
+
+func (b *memoryBackend) AppendMessage(chatId string, msg string, event string, at time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := chatMsgsKey(chatId)
+	b.lists[key] = append(b.lists[key], msg)
+	b.touch(key)
+	b.publish(event, chatId)
+	b.setField(chatMetaKey(chatId), "last_activity_ts", strconv.FormatInt(at.Unix(), 10))
+	return nil
+}
+
+func (b *memoryBackend) Buffered(chatIds ...string) ([][]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	buffered := make([][]string, len(chatIds))
+	for i, chatId := range chatIds {
+		buffered[i] = slices.Clone(b.lists[chatMsgsKey(chatId)])
+	}
+	return buffered, nil
+}
+
+func (b *memoryBackend) BufferLen(chatId string) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.lists[chatMsgsKey(chatId)]), nil
+}
+
+func (b *memoryBackend) PopBuffered(chatId string, n int) ([]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := chatMsgsKey(chatId)
+	msgs := b.lists[key]
+	n = min(n, len(msgs))
+	if n == 0 {
+		return nil, nil
+	}
+	popped := slices.Clone(msgs[:n])
+	b.setList(key, slices.Clone(msgs[n:]))
+	return popped, nil
+}
+
+func (b *memoryBackend) RestoreBuffered(chatId string, msgs []string) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := chatMsgsKey(chatId)
+	b.setList(key, append(slices.Clone(msgs), b.lists[key]...))
+	return nil
+}
+
+func (b *memoryBackend) setList(key string, msgs []string) {
+	if len(msgs) == 0 {
+		delete(b.lists, key)
+	} else {
+		b.lists[key] = msgs
+	}
+	b.touch(key)
+}
+
+func (b *memoryBackend) UpdateBuffered(chatId string, fn func(msgs []string) (map[int]string, error)) error {
+	key := chatMsgsKey(chatId)
+	read := func() []string { return slices.Clone(b.lists[key]) }
+	return optimistic(b, key, read, func(msgs []string) (func(), error) {
+		changes, err := fn(msgs)
+		if err != nil || len(changes) == 0 {
+			return nil, err
+		}
+		return func() {
+			for i, msg := range changes {
+				b.lists[key][i] = msg
+			}
+			b.touch(key)
+		}, nil
+	})
+}
+
+func (b *memoryBackend) Publish(event string, chatIds ...string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.publish(event, chatIds...)
+	return nil
+}
+
+func (b *memoryBackend) publish(event string, chatIds ...string) {
+	for _, chatId := range chatIds {
+		for f := range b.feeds[chatId] {
+			select {
+			case f.events <- event:
+			default:
+				log.Printf("[%s] subscriber is too slow, dropping event", chatId)
+			}
+		}
+	}
+}
+
+func (b *memoryBackend) Subscribe(chatId string) Feed {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	f := &memoryFeed{backend: b, chatId: chatId, events: make(chan string, feedBuffer)}
+	if b.feeds[chatId] == nil {
+		b.feeds[chatId] = make(map[*memoryFeed]struct{})
+	}
+	b.feeds[chatId][f] = struct{}{}
+	return f
+}
+
+type memoryFeed struct {
+	backend *memoryBackend
+	chatId  string
+	events  chan string
+}
+
+func (f *memoryFeed) Next() (string, bool) {
+	event, ok := <-f.events
+	return event, ok
+}
+
+func (f *memoryFeed) Close() error {
+	b := f.backend
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.feeds[f.chatId][f]; !ok {
+		return nil
+	}
+	delete(b.feeds[f.chatId], f)
+	if len(b.feeds[f.chatId]) == 0 {
+		delete(b.feeds, f.chatId)
+	}
+	close(f.events)
+	return nil
+}
+
+func (b *memoryBackend) Get(key string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.get(key), nil
+}
+
+func (b *memoryBackend) get(key string) string {
+	value, _ := b.lookup(key)
+	return value
+}
+
+// lookup returns the value at key, dropping it once it has expired.
+func (b *memoryBackend) lookup(key string) (string, bool) {
+	v, ok := b.values[key]
+	if !ok {
+		return "", false
+	}
+	if !v.expiresAt.IsZero() && !time.Now().Before(v.expiresAt) {
+		delete(b.values, key)
+		b.touch(key)
+		return "", false
+	}
+	return v.value, true
+}
+
+func (b *memoryBackend) Set(key string, value string, ttl time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.set(key, value, ttl)
+	return nil
+}
+
+func (b *memoryBackend) set(key string, value string, ttl time.Duration) {
+	v := memoryValue{value: value}
+	if ttl > 0 {
+		v.expiresAt = time.Now().Add(ttl)
+	}
+	b.values[key] = v
+	b.touch(key)
+}
+
+func (b *memoryBackend) SetNX(key string, value string, ttl time.Duration) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.lookup(key); ok {
+		return false, nil
+	}
+	b.set(key, value, ttl)
+	return true, nil
+}
+
+func (b *memoryBackend) Del(key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.values, key)
+	delete(b.lists, key)
+	delete(b.hashes, key)
+	b.touch(key)
+	return nil
+}
+
+func (b *memoryBackend) UpdateValue(key string, fn func(current string) (string, time.Duration, error)) error {
+	read := func() string { return b.get(key) }
+	return optimistic(b, key, read, func(current string) (func(), error) {
+		next, ttl, err := fn(current)
+		if err != nil {
+			return nil, err
+		}
+		return func() {
+			if next == "" {
+				delete(b.values, key)
+				b.touch(key)
+				return
+			}
+			b.set(key, next, ttl)
+		}, nil
+	})
+}
+
+func (b *memoryBackend) Fields(field string, keys ...string) ([]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	values := make([]string, len(keys))
+	for i, key := range keys {
+		values[i] = b.hashes[key][field]
+	}
+	return values, nil
+}
+
+func (b *memoryBackend) SetFieldNX(key string, field string, value string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.hashes[key][field]; !ok {
+		b.setField(key, field, value)
+	}
+	return nil
+}
+
+func (b *memoryBackend) setField(key string, field string, value string) {
+	if b.hashes[key] == nil {
+		b.hashes[key] = make(map[string]string)
+	}
+	b.hashes[key][field] = value
+	b.touch(key)
+}
+
+func (b *memoryBackend) UpdateField(key string, field string, fn func(current string) (string, error)) error {
+	read := func() string { return b.hashes[key][field] }
+	return optimistic(b, key, read, func(current string) (func(), error) {
+		next, err := fn(current)
+		if err != nil || next == current {
+			return nil, err
+		}
+		return func() { b.setField(key, field, next) }, nil
+	})
+}
+
+// optimistic takes a snapshot of key with read, hands it to change outside
+// the lock, so callbacks may take their time or use the backend themselves,
+// and applies the write change returns only if key was not written in
+// between, as a Redis WATCH would.
+func optimistic[T any](b *memoryBackend, key string, read func() T, change func(T) (func(), error)) error {
+	for range maxUpdateRetries {
+		b.mu.Lock()
+		state := read()
+		version := b.versions[key]
+		b.mu.Unlock()
+
+		write, err := change(state)
+		if err != nil || write == nil {
+			return err
+		}
+
+		b.mu.Lock()
+		if b.versions[key] == version {
+			write()
+			b.mu.Unlock()
+			return nil
+		}
+		b.mu.Unlock()
+	}
+	return ErrContended
+}
+
+// Close keeps the state, which belongs to the backend rather than to a store.
+func (b *memoryBackend) Close() error {
+	return nil
+}
@@ -0,0 +1,239 @@
+package chatservice
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisBackend struct {
+	rc *redis.Client
+}
+
+func newRedisBackend(rc *redis.Client) *redisBackend {
+	return &redisBackend{rc: rc}
+}
+
+func (b *redisBackend) AppendMessage(chatId string, msg string, event string, at time.Time) error {
+	pipe := b.rc.Pipeline()
+	pipe.RPush(ctx, chatMsgsKey(chatId), msg)
+	pipe.Publish(ctx, chatPubKey(chatId), event)
+	pipe.HSet(ctx, chatMetaKey(chatId), "last_activity_ts", at.Unix())
+	pipe.ZAdd(ctx, chatActiveKey(), redis.Z{
+		Score:  float64(at.Unix()),
+		Member: chatId,
+	})
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis pipeline failed: %w", err)
+	}
+	return nil
+}
+
+func (b *redisBackend) Buffered(chatIds ...string) ([][]string, error) {
+	pipe := b.rc.Pipeline()
+	cmds := make([]*redis.StringSliceCmd, len(chatIds))
+	for i, chatId := range chatIds {
+		cmds[i] = pipe.LRange(ctx, chatMsgsKey(chatId), 0, -1)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	buffered := make([][]string, len(chatIds))
+	for i, cmd := range cmds {
+		buffered[i] = cmd.Val()
+	}
+	return buffered, nil
+}
+
+func (b *redisBackend) BufferLen(chatId string) (int, error) {
+	n, err := b.rc.LLen(ctx, chatMsgsKey(chatId)).Result()
+	return int(n), err
+}
+
+var popScript = redis.NewScript(`
+	local msgs = redis.call('LRANGE', KEYS[1], 0, ARGV[1] - 1)
+	if #msgs > 0 then
+		redis.call('LTRIM', KEYS[1], ARGV[1], -1)
+	end
+	return msgs
+`)
+
+func (b *redisBackend) PopBuffered(chatId string, n int) ([]string, error) {
+	return popScript.Run(ctx, b.rc, []string{chatMsgsKey(chatId)}, n).StringSlice()
+}
+
+func (b *redisBackend) RestoreBuffered(chatId string, msgs []string) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	key := chatMsgsKey(chatId)
+	pipe := b.rc.Pipeline()
+	for i := len(msgs) - 1; i >= 0; i-- {
+		pipe.LPush(ctx, key, msgs[i])
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (b *redisBackend) UpdateBuffered(chatId string, fn func(msgs []string) (map[int]string, error)) error {
+	key := chatMsgsKey(chatId)
+	return b.watch(key, func(tx *redis.Tx) error {
+		msgs, err := tx.LRange(ctx, key, 0, -1).Result()
+		if err != nil {
+			return err
+		}
+		changes, err := fn(msgs)
+		if err != nil || len(changes) == 0 {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, msg := range changes {
+				pipe.LSet(ctx, key, int64(i), msg)
+			}
+			return nil
+		})
+		return err
+	})
+}
+
+func (b *redisBackend) Publish(event string, chatIds ...string) error {
+	if len(chatIds) == 1 {
+		return b.rc.Publish(ctx, chatPubKey(chatIds[0]), event).Err()
+	}
+
+	pipe := b.rc.Pipeline()
+	for _, chatId := range chatIds {
+		pipe.Publish(ctx, chatPubKey(chatId), event)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (b *redisBackend) Subscribe(chatId string) Feed {
+	pubsub := b.rc.Subscribe(ctx, chatPubKey(chatId))
+	return &redisFeed{
+		pubsub: pubsub,
+		channel: pubsub.Channel(
+			redis.WithChannelHealthCheckInterval(30*time.Second),
+			redis.WithChannelSendTimeout(10*time.Second),
+		),
+	}
+}
+
+type redisFeed struct {
+	pubsub  *redis.PubSub
+	channel <-chan *redis.Message
+}
+
+func (f *redisFeed) Next() (string, bool) {
+	msg, ok := <-f.channel
+	if !ok {
+		return "", false
+	}
+	return msg.Payload, true
+}
+
+func (f *redisFeed) Close() error {
+	return f.pubsub.Close()
+}
+
+func (b *redisBackend) Get(key string) (string, error) {
+	value, err := b.rc.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return value, err
+}
+
+func (b *redisBackend) Set(key string, value string, ttl time.Duration) error {
+	return b.rc.Set(ctx, key, value, ttl).Err()
+}
+
+func (b *redisBackend) SetNX(key string, value string, ttl time.Duration) (bool, error) {
+	return b.rc.SetNX(ctx, key, value, ttl).Result()
+}
+
+func (b *redisBackend) Del(key string) error {
+	return b.rc.Del(ctx, key).Err()
+}
+
+func (b *redisBackend) UpdateValue(key string, fn func(current string) (string, time.Duration, error)) error {
+	return b.watch(key, func(tx *redis.Tx) error {
+		current, err := tx.Get(ctx, key).Result()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		next, ttl, err := fn(current)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			if next == "" {
+				pipe.Del(ctx, key)
+			} else {
+				pipe.Set(ctx, key, next, ttl)
+			}
+			return nil
+		})
+		return err
+	})
+}
+
+func (b *redisBackend) Fields(field string, keys ...string) ([]string, error) {
+	pipe := b.rc.Pipeline()
+	cmds := make([]*redis.StringCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.HGet(ctx, key, field)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	values := make([]string, len(keys))
+	for i, cmd := range cmds {
+		values[i] = cmd.Val()
+	}
+	return values, nil
+}
+
+func (b *redisBackend) SetFieldNX(key string, field string, value string) error {
+	return b.rc.HSetNX(ctx, key, field, value).Err()
+}
+
+func (b *redisBackend) UpdateField(key string, field string, fn func(current string) (string, error)) error {
+	return b.watch(key, func(tx *redis.Tx) error {
+		current, err := tx.HGet(ctx, key, field).Result()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		next, err := fn(current)
+		if err != nil || next == current {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, key, field, next)
+			return nil
+		})
+		return err
+	})
+}
+
+// watch runs txf under WATCH on key, again whenever another client changed
+// key before txf's writes landed.
+func (b *redisBackend) watch(key string, txf func(tx *redis.Tx) error) error {
+	for range maxUpdateRetries {
+		err := b.rc.Watch(ctx, txf, key)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+	return ErrContended
+}
+
+func (b *redisBackend) Close() error {
+	return b.rc.Close()
+}
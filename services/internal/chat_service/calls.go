@@ -0,0 +1,318 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/MelloB1989/karma/utils"
+)
+
+const (
+	CallAudio = "audio"
+	CallVideo = "video"
+
+	// RingTimeout is how long a call rings before it counts as missed.
+	RingTimeout = 45 * time.Second
+	// ringingTTL lets a ringing call expire on its own if the caller's
+	// instance goes away before the ring timeout fires.
+	ringingTTL = RingTimeout + 15*time.Second
+	// maxCallLength bounds how long an answered call outlives a lost hangup.
+	maxCallLength = 4 * time.Hour
+
+	// maxSignalSize caps SDP and ICE payloads; real ones are a few KB.
+	maxSignalSize = 16 * 1024
+)
+
+type CallSignalType string
+
+const (
+	CallOffer       CallSignalType = "offer"
+	CallAnswer      CallSignalType = "answer"
+	CallCandidate   CallSignalType = "ice_candidate"
+	CallHangup      CallSignalType = "hangup"
+	CallRingTimeout CallSignalType = "ring_timeout"
+)
+
+var (
+	ErrCallsLocked    = errors.New("calls are available once you have both revealed")
+	ErrCallInProgress = errors.New("a call is already in progress in this chat")
+	ErrNoActiveCall   = errors.New("call not found or already ended")
+	ErrInvalidSignal  = errors.New("invalid call signal")
+	ErrCallLogMessage = errors.New("call log entries are written by the server")
+)
+
+func chatCallKey(chatId string) string { return fmt.Sprintf("blindly:chat:%s:call", chatId) }
+
+// CallSignal is relayed between the two participants of a call. Sdp carries
+// offers and answers, and Candidate an ICE candidate as the browser gave it.
+type CallSignal struct {
+	CallId    string          `json:"call_id"`
+	Type      CallSignalType  `json:"type"`
+	UserId    string          `json:"user_id"`
+	Media     string          `json:"media,omitempty"`
+	Sdp       string          `json:"sdp,omitempty"`
+	Candidate json.RawMessage `json:"candidate,omitempty"`
+	Timestamp time.Time       `json:"timestamp"`
+}
+
+// callState is the chat's current call, shared by every instance in Redis.
+type callState struct {
+	CallId     string     `json:"call_id"`
+	CallerId   string     `json:"caller_id"`
+	Media      string     `json:"media"`
+	StartedAt  time.Time  `json:"started_at"`
+	AnsweredAt *time.Time `json:"answered_at,omitempty"`
+}
+
+// Signal checks a signal from userId against the chat's call state and
+// relays it to the other participant. Calls can only start once the match
+// is unlocked.
+func (s *Store) Signal(userId string, sig *CallSignal) error {
+	if !s.IsParticipant(userId) {
+		return ErrUnauthorized
+	}
+	if len(sig.Sdp) > maxSignalSize || len(sig.Candidate) > maxSignalSize {
+		return ErrInvalidSignal
+	}
+	s.ensureBackend()
+
+	sig.UserId = userId
+	sig.Timestamp = time.Now()
+
+	switch sig.Type {
+	case CallOffer:
+		return s.startCall(sig)
+	case CallAnswer:
+		return s.answerCall(sig)
+	case CallCandidate:
+		if len(sig.Candidate) == 0 {
+			return ErrInvalidSignal
+		}
+		state, err := s.currentCall()
+		if err != nil {
+			return err
+		}
+		if state == nil || state.CallId != sig.CallId {
+			return ErrNoActiveCall
+		}
+		return s.publishCallSignal(sig)
+	case CallHangup, CallRingTimeout:
+		return s.EndCall(sig.CallId, userId, sig.Type)
+	default:
+		return ErrInvalidSignal
+	}
+}
+
+func (s *Store) startCall(sig *CallSignal) error {
+	if sig.Sdp == "" {
+		return ErrInvalidSignal
+	}
+	// Matches unlock while sockets are open, so check the current state.
+	if err := s.loadParticipants(); err != nil {
+		return err
+	}
+	if !s.unlocked {
+		return ErrCallsLocked
+	}
+
+	if sig.Media != CallVideo {
+		sig.Media = CallAudio
+	}
+	if sig.CallId == "" {
+		sig.CallId = strings.ToUpper(utils.GenerateID(20))
+	}
+	data, err := json.Marshal(callState{
+		CallId:    sig.CallId,
+		CallerId:  sig.UserId,
+		Media:     sig.Media,
+		StartedAt: sig.Timestamp,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal call state: %w", err)
+	}
+
+	started, err := s.backend.SetNX(chatCallKey(s.chatId), string(data), ringingTTL)
+	if err != nil {
+		return fmt.Errorf("failed to start call: %w", err)
+	}
+	if !started {
+		return ErrCallInProgress
+	}
+
+	if err := s.publishCallSignal(sig); err != nil {
+		return err
+	}
+
+	// The caller's instance ends the call if nobody answers. The store may be
+	// closed by then, so the timer uses its own.
+	chatId, callId := s.chatId, sig.CallId
+	time.AfterFunc(RingTimeout, func() {
+		ts := NewStoreWithoutAuth(chatId)
+		defer ts.Close()
+		if err := ts.EndCall(callId, "", CallRingTimeout); err != nil && !errors.Is(err, ErrNoActiveCall) {
+			log.Printf("[%s] failed to time out call %s: %v", chatId, callId, err)
+		}
+	})
+
+	return nil
+}
+
+func (s *Store) answerCall(sig *CallSignal) error {
+	if sig.Sdp == "" {
+		return ErrInvalidSignal
+	}
+
+	_, err := s.modifyCall(func(state *callState) (*callState, error) {
+		if state == nil || state.CallId != sig.CallId || state.AnsweredAt != nil {
+			return nil, ErrNoActiveCall
+		}
+		if state.CallerId == sig.UserId {
+			return nil, ErrInvalidSignal
+		}
+		answered := sig.Timestamp
+		state.AnsweredAt = &answered
+		return state, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sig.Media = ""
+	return s.publishCallSignal(sig)
+}
+
+// EndCall ends the chat's call and records it in the chat history. An empty
+// callId ends whatever call is in progress, for clients that lost track of
+// it. A ring timeout only ends calls that were never answered.
+func (s *Store) EndCall(callId string, userId string, reason CallSignalType) error {
+	s.ensureBackend()
+
+	var ended *callState
+	_, err := s.modifyCall(func(state *callState) (*callState, error) {
+		if state == nil || (callId != "" && state.CallId != callId) {
+			return nil, ErrNoActiveCall
+		}
+		if reason == CallRingTimeout && state.AnsweredAt != nil {
+			return nil, ErrNoActiveCall
+		}
+		ended = state
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := s.publishCallSignal(&CallSignal{
+		CallId:    ended.CallId,
+		Type:      reason,
+		UserId:    userId,
+		Timestamp: now,
+	}); err != nil {
+		log.Printf("[%s] failed to relay end of call %s: %v", s.chatId, ended.CallId, err)
+	}
+
+	entry := &models.Message{
+		Id:        strings.ToUpper(utils.GenerateID(20)),
+		Type:      models.CALL,
+		SenderId:  ended.CallerId,
+		Call:      callLogFor(ended, userId, reason, now),
+		CreatedAt: now,
+	}
+	if err := s.SendMessage(entry); err != nil {
+		return fmt.Errorf("failed to record call log: %w", err)
+	}
+	return nil
+}
+
+func callLogFor(state *callState, endedBy string, reason CallSignalType, now time.Time) *models.CallLog {
+	entry := &models.CallLog{
+		CallId:     state.CallId,
+		Media:      state.Media,
+		StartedAt:  state.StartedAt,
+		AnsweredAt: state.AnsweredAt,
+		EndedAt:    now,
+		EndedBy:    endedBy,
+	}
+	switch {
+	case state.AnsweredAt != nil:
+		entry.Status = models.CallCompleted
+		entry.DurationSec = int(now.Sub(*state.AnsweredAt).Seconds())
+	case reason == CallRingTimeout:
+		entry.Status = models.CallMissed
+	case endedBy == state.CallerId:
+		entry.Status = models.CallCancelled
+	default:
+		entry.Status = models.CallDeclined
+	}
+	return entry
+}
+
+func (s *Store) currentCall() (*callState, error) {
+	raw, err := s.backend.Get(chatCallKey(s.chatId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get call state: %w", err)
+	}
+	if raw == "" {
+		return nil, nil
+	}
+	var state callState
+	if err := json.Unmarshal([]byte(raw), &state); err != nil {
+		return nil, fmt.Errorf("failed to decode call state: %w", err)
+	}
+	return &state, nil
+}
+
+// modifyCall applies fn to the chat's call state atomically. Returning nil
+// ends the call.
+func (s *Store) modifyCall(fn func(state *callState) (*callState, error)) (*callState, error) {
+	var next *callState
+	err := s.backend.UpdateValue(chatCallKey(s.chatId), func(raw string) (string, time.Duration, error) {
+		var current *callState
+		if raw != "" {
+			current = &callState{}
+			if err := json.Unmarshal([]byte(raw), current); err != nil {
+				return "", 0, fmt.Errorf("failed to decode call state: %w", err)
+			}
+		}
+
+		var err error
+		next, err = fn(current)
+		if err != nil || next == nil {
+			return "", 0, err
+		}
+
+		data, err := json.Marshal(next)
+		if err != nil {
+			return "", 0, err
+		}
+		ttl := ringingTTL
+		if next.AnsweredAt != nil {
+			ttl = maxCallLength
+		}
+		return string(data), ttl, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+func (s *Store) publishCallSignal(sig *CallSignal) error {
+	data, err := json.Marshal(sig)
+	if err != nil {
+		return fmt.Errorf("failed to marshal call signal: %w", err)
+	}
+	event := PubSubEvent{
+		Type:   MessageEventCall,
+		Data:   data,
+		Origin: s.origin,
+	}
+	eventJSON, _ := json.Marshal(event)
+	return s.backend.Publish(string(eventJSON), s.chatId)
+}
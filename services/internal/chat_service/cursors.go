@@ -0,0 +1,253 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/utils"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+func chatCursorsKey(chatId string) string { return fmt.Sprintf("blindly:chat:%s:cursors", chatId) }
+
+// ReadCursor is the furthest message a participant has seen in a chat. Every
+// message from the other participant after it counts as unread.
+type ReadCursor struct {
+	MessageId string    `json:"message_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ReadCursor returns userId's cursor for this chat, or nil if they have never
+// marked anything seen. The backend is checked first and refilled from
+// Postgres.
+func (s *Store) ReadCursor(userId string) (*ReadCursor, error) {
+	s.ensureBackend()
+
+	raw, err := s.backend.Fields(userId, chatCursorsKey(s.chatId))
+	if err == nil && raw[0] != "" {
+		var cursor ReadCursor
+		if err := json.Unmarshal([]byte(raw[0]), &cursor); err == nil {
+			return &cursor, nil
+		}
+	} else if err != nil {
+		log.Printf("[%s] failed to read cursor from backend: %v", s.chatId, err)
+	}
+
+	cursorORM := orm.Load(&models.ChatReadCursor{})
+	defer cursorORM.Close()
+
+	var rows []models.ChatReadCursor
+	if err := cursorORM.GetByFieldEquals("ChatId", s.chatId).Scan(&rows); err != nil {
+		return nil, fmt.Errorf("failed to get read cursors: %w", err)
+	}
+	for _, row := range rows {
+		if row.UserId != userId {
+			continue
+		}
+		cursor := &ReadCursor{MessageId: row.MessageId, UpdatedAt: row.UpdatedAt}
+		if data, err := json.Marshal(cursor); err == nil {
+			s.backend.SetFieldNX(chatCursorsKey(s.chatId), userId, string(data))
+		}
+		return cursor, nil
+	}
+
+	return nil, nil
+}
+
+// advanceReadCursor moves userId's cursor to the latest of messageIds in chat
+// order. It never moves backwards, so seen events arriving out of order from
+// several devices are harmless. It reports whether the cursor moved.
+func (s *Store) advanceReadCursor(messageIds []string, userId string) (bool, error) {
+	if len(messageIds) == 0 {
+		return false, nil
+	}
+
+	messages, err := s.hotMessages()
+	if err != nil {
+		return false, err
+	}
+
+	current, err := s.ReadCursor(userId)
+	if err != nil {
+		return false, err
+	}
+
+	positions := make(map[string]int, len(messages))
+	for i, m := range messages {
+		positions[m.Id] = i
+	}
+
+	target := -1
+	for _, id := range messageIds {
+		if pos, ok := positions[id]; ok && pos > target {
+			target = pos
+		}
+	}
+	if target == -1 {
+		return false, nil
+	}
+
+	cursor := ReadCursor{MessageId: messages[target].Id, UpdatedAt: time.Now()}
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal read cursor: %w", err)
+	}
+
+	moved := false
+	err = s.backend.UpdateField(chatCursorsKey(s.chatId), userId, func(raw string) (string, error) {
+		moved = false
+		if raw != "" {
+			var existing ReadCursor
+			if json.Unmarshal([]byte(raw), &existing) == nil {
+				current = &existing
+			}
+		}
+		if current != nil {
+			if pos, ok := positions[current.MessageId]; ok && pos >= target {
+				return raw, nil
+			}
+		}
+		moved = true
+		return string(data), nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to advance read cursor: %w", err)
+	}
+	if !moved {
+		return false, nil
+	}
+
+	if err := s.saveReadCursor(userId, cursor); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+func (s *Store) saveReadCursor(userId string, cursor ReadCursor) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`
+		INSERT INTO chat_read_cursors (id, chat_id, user_id, message_id, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (chat_id, user_id)
+		DO UPDATE SET message_id = EXCLUDED.message_id, updated_at = EXCLUDED.updated_at
+	`, utils.GenerateID(), s.chatId, userId, cursor.MessageId, cursor.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to save read cursor: %w", err)
+	}
+	return nil
+}
+
+// UnreadCount returns how many messages from the other participant userId has
+// not seen yet, including those still buffered.
+func (s *Store) UnreadCount(userId string) (int, error) {
+	messages, err := s.hotMessages()
+	if err != nil {
+		return 0, err
+	}
+
+	cursor, err := s.ReadCursor(userId)
+	if err != nil {
+		return 0, err
+	}
+
+	cursorId := ""
+	if cursor != nil {
+		cursorId = cursor.MessageId
+	}
+	return countUnread(messages, userId, cursorId), nil
+}
+
+// countUnread counts messages from others after cursorId. Without a usable
+// cursor, for chats that predate cursors or whose cursor message is gone, it
+// falls back to the per-message seen flag.
+func countUnread(messages []models.Message, userId string, cursorId string) int {
+	start := -1
+	if cursorId != "" {
+		for i := len(messages) - 1; i >= 0; i-- {
+			if messages[i].Id == cursorId {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	count := 0
+	if start == -1 {
+		for _, m := range messages {
+			if m.SenderId != userId && !m.Seen {
+				count++
+			}
+		}
+		return count
+	}
+
+	for _, m := range messages[start:] {
+		if m.SenderId != userId {
+			count++
+		}
+	}
+	return count
+}
+
+// UnreadCounts returns userId's unread count for each chat, keyed by chat id.
+// chats carry their flushed history and storedCursors the cursors already
+// loaded from Postgres; buffered messages and fresher cursors are fetched from
+// the backend in one round trip each.
+func UnreadCounts(userId string, chats []models.Chat, storedCursors map[string]string) (map[string]int, error) {
+	counts := make(map[string]int, len(chats))
+	if len(chats) == 0 {
+		return counts, nil
+	}
+
+	backend := openBackend()
+	defer backend.Close()
+
+	chatIds := make([]string, len(chats))
+	cursorKeys := make([]string, len(chats))
+	for i, chat := range chats {
+		chatIds[i] = chat.Id
+		cursorKeys[i] = chatCursorsKey(chat.Id)
+	}
+	buffered, err := backend.Buffered(chatIds...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load unread state: %w", err)
+	}
+	cursors, err := backend.Fields(userId, cursorKeys...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load unread state: %w", err)
+	}
+
+	for i, chat := range chats {
+		messages := chat.Messages
+		if raw := buffered[i]; len(raw) > 0 {
+			messages = make([]models.Message, 0, len(chat.Messages)+len(raw))
+			messages = append(messages, chat.Messages...)
+			for _, r := range raw {
+				var msg models.Message
+				if json.Unmarshal([]byte(r), &msg) == nil {
+					messages = append(messages, msg)
+				}
+			}
+		}
+
+		cursorId := storedCursors[chat.Id]
+		if raw := cursors[i]; raw != "" {
+			var cursor ReadCursor
+			if json.Unmarshal([]byte(raw), &cursor) == nil {
+				cursorId = cursor.MessageId
+			}
+		}
+
+		counts[chat.Id] = countUnread(messages, userId, cursorId)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,318 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/MelloB1989/karma/utils"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+const (
+	MaxDateVenueLength = 200
+	MaxDateNoteLength  = 500
+	// MaxDateAhead bounds how far out a date can be proposed.
+	MaxDateAhead = 365 * 24 * time.Hour
+	// DateDuration is how long an exported date blocks out in a calendar.
+	DateDuration = 2 * time.Hour
+
+	// dateResponseLock keeps two answers to one proposal from racing.
+	dateResponseLock = 10 * time.Second
+)
+
+type DateAction string
+
+const (
+	DateAccept  DateAction = "ACCEPT"
+	DateDecline DateAction = "DECLINE"
+	DateCounter DateAction = "COUNTER"
+)
+
+var (
+	ErrNotDateProposal   = errors.New("message is not a date proposal")
+	ErrProposalAnswered  = errors.New("date proposal has already been answered")
+	ErrOwnProposal       = errors.New("you cannot answer your own date proposal")
+	ErrProposalExpired   = errors.New("the proposed time has already passed")
+	ErrCounterRequired   = errors.New("a counter proposal is required")
+	ErrUnknownDateAction = errors.New("unknown date proposal action")
+)
+
+func chatDateLockKey(chatId string, messageId string) string {
+	return fmt.Sprintf("blindly:chat:%s:date:%s", chatId, messageId)
+}
+
+// DateResponse is the outcome of answering a proposal. Counter is the new
+// proposal sent back and Date the planned date, when there is one.
+type DateResponse struct {
+	Proposal *models.Message     `json:"proposal"`
+	Counter  *models.Message     `json:"counter,omitempty"`
+	Date     *models.PlannedDate `json:"date,omitempty"`
+}
+
+// validateDateProposal checks a new proposal and resets its response fields,
+// so a client cannot send one that is already answered.
+func validateDateProposal(p *models.DateProposal, now time.Time) error {
+	if p == nil {
+		return errors.New("date_proposal is required")
+	}
+	p.Venue = strings.TrimSpace(p.Venue)
+	p.Note = strings.TrimSpace(p.Note)
+
+	if p.Venue == "" {
+		return errors.New("date_proposal.venue is required")
+	}
+	if len([]rune(p.Venue)) > MaxDateVenueLength {
+		return fmt.Errorf("date_proposal.venue must be at most %d characters", MaxDateVenueLength)
+	}
+	if len([]rune(p.Note)) > MaxDateNoteLength {
+		return fmt.Errorf("date_proposal.note must be at most %d characters", MaxDateNoteLength)
+	}
+	if !p.StartsAt.After(now) {
+		return ErrProposalExpired
+	}
+	if p.StartsAt.Sub(now) > MaxDateAhead {
+		return fmt.Errorf("date_proposal.starts_at must be within %s", MaxDateAhead)
+	}
+
+	p.StartsAt = p.StartsAt.UTC()
+	p.Status = models.DatePending
+	p.RespondedBy = ""
+	p.RespondedAt = nil
+	p.CounterId = ""
+	return nil
+}
+
+// prepareDateProposal validates a DATE_PROPOSAL message and moderates its
+// venue and note the way SendMessage moderates content. A mask is applied
+// in place and returned so the sender can be told.
+func (s *Store) prepareDateProposal(msg *models.Message) (*ModerationError, error) {
+	if err := validateDateProposal(msg.DateProposal, time.Now()); err != nil {
+		return nil, err
+	}
+
+	var modErr *ModerationError
+	for _, field := range []*string{&msg.DateProposal.Venue, &msg.DateProposal.Note} {
+		if *field == "" {
+			continue
+		}
+		verdict := s.Moderate(msg.SenderId, *field)
+		if verdict.Action >= ModerationHold {
+			return nil, &ModerationError{Action: ModerationReject, Reason: verdict.Reason}
+		}
+		if verdict.Action == ModerationMask {
+			*field = verdict.Content
+			modErr = &ModerationError{Action: verdict.Action, Reason: verdict.Reason}
+		}
+	}
+	return modErr, nil
+}
+
+// RespondToDateProposal answers a pending proposal from the other
+// participant. Accepting records a planned date for the match; countering
+// sends counter back as a new proposal replying to the original.
+func (s *Store) RespondToDateProposal(userId string, messageId string, action DateAction, counter *models.DateProposal) (*DateResponse, error) {
+	if !s.IsParticipant(userId) {
+		return nil, ErrUnauthorized
+	}
+	s.ensureBackend()
+
+	msg, err := s.GetMessageById(messageId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Type != models.DATE_PROPOSAL || msg.DateProposal == nil {
+		return nil, ErrNotDateProposal
+	}
+	if msg.SenderId == userId {
+		return nil, ErrOwnProposal
+	}
+	if msg.DateProposal.Status != models.DatePending {
+		return nil, ErrProposalAnswered
+	}
+
+	lockKey := chatDateLockKey(s.chatId, msg.Id)
+	locked, err := s.backend.SetNX(lockKey, userId, dateResponseLock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock date proposal: %w", err)
+	}
+	if !locked {
+		return nil, ErrProposalAnswered
+	}
+	defer s.backend.Del(lockKey)
+
+	// Another device may have answered between the read above and taking the
+	// lock, which is released once that answer is stored.
+	msg, err = s.GetMessageById(messageId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.DateProposal == nil || msg.DateProposal.Status != models.DatePending {
+		return nil, ErrProposalAnswered
+	}
+
+	now := time.Now()
+	answered := *msg.DateProposal
+	answered.RespondedBy = userId
+	answered.RespondedAt = &now
+
+	resp := &DateResponse{}
+	switch action {
+	case DateAccept:
+		if !answered.StartsAt.After(now) {
+			return nil, ErrProposalExpired
+		}
+		date := &models.PlannedDate{
+			Id:         strings.ToUpper(utils.GenerateID(20)),
+			MatchId:    s.matchId,
+			ChatId:     s.chatId,
+			MessageId:  msg.Id,
+			ProposedBy: msg.SenderId,
+			AcceptedBy: userId,
+			StartsAt:   answered.StartsAt,
+			Venue:      answered.Venue,
+			Note:       answered.Note,
+			CreatedAt:  now,
+		}
+		dateORM := orm.Load(&models.PlannedDate{})
+		defer dateORM.Close()
+		// message_id is unique, so a proposal is never planned twice.
+		if err := dateORM.Insert(date); err != nil {
+			return nil, fmt.Errorf("failed to save planned date: %w", err)
+		}
+		answered.Status = models.DateAccepted
+		resp.Date = date
+	case DateDecline:
+		answered.Status = models.DateDeclined
+	case DateCounter:
+		if counter == nil {
+			return nil, ErrCounterRequired
+		}
+		proposal := *counter
+		counterMsg := &models.Message{
+			Id:           strings.ToUpper(utils.GenerateID(20)),
+			Type:         models.DATE_PROPOSAL,
+			SenderId:     userId,
+			ReplyToId:    msg.Id,
+			DateProposal: &proposal,
+			CreatedAt:    now,
+		}
+		if err := s.SendMessage(counterMsg); err != nil {
+			var modErr *ModerationError
+			if !errors.As(err, &modErr) || !modErr.Delivered() {
+				return nil, err
+			}
+		}
+		answered.Status = models.DateCountered
+		answered.CounterId = counterMsg.Id
+		resp.Counter = counterMsg
+	default:
+		return nil, ErrUnknownDateAction
+	}
+
+	// UpdateMessage always writes the flags, so carry the current ones over.
+	updated, err := s.UpdateMessage(msg.Id, &models.Message{
+		DateProposal: &answered,
+		Received:     msg.Received,
+		Seen:         msg.Seen,
+	}, ChangeDateAnswer)
+	if err != nil {
+		log.Printf("[%s] failed to record %s of date proposal %s: %v", s.chatId, action, msg.Id, err)
+		return nil, err
+	}
+	resp.Proposal = updated
+
+	return resp, nil
+}
+
+// PlannedDates returns userId's accepted dates, soonest first. matchId
+// narrows them to one match and upcoming drops dates that have passed.
+func PlannedDates(userId string, matchId string, upcoming bool) ([]models.PlannedDate, error) {
+	dateORM := orm.Load(&models.PlannedDate{})
+	defer dateORM.Close()
+
+	query := `SELECT * FROM planned_dates WHERE (proposed_by = $1 OR accepted_by = $1)`
+	args := []any{userId}
+	if matchId != "" {
+		args = append(args, matchId)
+		query += fmt.Sprintf(" AND match_id = $%d", len(args))
+	}
+	if upcoming {
+		args = append(args, time.Now().UTC())
+		query += fmt.Sprintf(" AND starts_at >= $%d", len(args))
+	}
+	query += " ORDER BY starts_at"
+
+	var dates []models.PlannedDate
+	if err := dateORM.QueryRaw(query, args...).Scan(&dates); err != nil {
+		return nil, fmt.Errorf("failed to get planned dates: %w", err)
+	}
+	return dates, nil
+}
+
+// DatesICS renders dates as an iCalendar file.
+func DatesICS(dates []models.PlannedDate, now time.Time) []byte {
+	var buf bytes.Buffer
+	line := func(s string) {
+		buf.WriteString(foldICSLine(s))
+		buf.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Blindly//Dates//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	for _, d := range dates {
+		line("BEGIN:VEVENT")
+		line("UID:" + d.Id + "@blindly")
+		line("DTSTAMP:" + icsTime(now))
+		line("DTSTART:" + icsTime(d.StartsAt))
+		line("DTEND:" + icsTime(d.StartsAt.Add(DateDuration)))
+		line("SUMMARY:" + escapeICS("Blindly date at "+d.Venue))
+		line("LOCATION:" + escapeICS(d.Venue))
+		if d.Note != "" {
+			line("DESCRIPTION:" + escapeICS(d.Note))
+		}
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+func icsTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeICS(s string) string {
+	return icsEscaper.Replace(s)
+}
+
+// foldICSLine splits lines longer than 75 octets as RFC 5545 requires,
+// without breaking a UTF-8 sequence.
+func foldICSLine(s string) string {
+	const limit = 75
+	if len(s) <= limit {
+		return s
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		n := len(string(r))
+		if width+n > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += n
+	}
+	return b.String()
+}
@@ -0,0 +1,143 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/utils"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+// DefaultEditWindow is how long a message stays editable when
+// CHAT_EDIT_WINDOW is not set.
+const DefaultEditWindow = 15 * time.Minute
+
+var (
+	ErrNotMessageSender = errors.New("only the sender can edit a message")
+	ErrEditWindowClosed = errors.New("this message can no longer be edited")
+)
+
+// EditWindow is how long after sending a message can be edited. It is read
+// from CHAT_EDIT_WINDOW as a duration such as "30m".
+func EditWindow() time.Duration {
+	raw := config.GetEnvRaw("CHAT_EDIT_WINDOW")
+	if raw == "" {
+		return DefaultEditWindow
+	}
+	window, err := time.ParseDuration(raw)
+	if err != nil || window <= 0 {
+		log.Printf("invalid CHAT_EDIT_WINDOW %q, using %s", raw, DefaultEditWindow)
+		return DefaultEditWindow
+	}
+	return window
+}
+
+func checkEditable(msg *models.Message, editorId string, now time.Time, window time.Duration) error {
+	if msg.SenderId != editorId {
+		return ErrNotMessageSender
+	}
+	if now.Sub(msg.CreatedAt) > window {
+		return ErrEditWindowClosed
+	}
+	return nil
+}
+
+// EditMessage replaces the content or media of one of editorId's messages
+// and keeps the version it replaced as a revision. Media is left alone when
+// nil. An edit that changes nothing returns the message as it is.
+func (s *Store) EditMessage(messageId string, editorId string, content string, media []models.Media) (*models.Message, error) {
+	if !s.IsParticipant(editorId) {
+		return nil, ErrUnauthorized
+	}
+
+	msg, err := s.GetMessageById(messageId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := checkEditable(msg, editorId, now, EditWindow()); err != nil {
+		return nil, err
+	}
+	if (content == "" || content == msg.Content) && media == nil {
+		return msg, nil
+	}
+
+	written := msg.CreatedAt
+	if msg.EditedAt != nil {
+		written = *msg.EditedAt
+	}
+	revision := &models.MessageRevision{
+		Id:         strings.ToUpper(utils.GenerateID(20)),
+		ChatId:     s.chatId,
+		MessageId:  msg.Id,
+		SenderId:   msg.SenderId,
+		Content:    msg.Content,
+		Media:      msg.Media,
+		CreatedAt:  written,
+		ReplacedAt: now,
+	}
+	if err := s.sealRevision(revision); err != nil {
+		return nil, fmt.Errorf("failed to seal message revision: %w", err)
+	}
+
+	revisionORM := orm.Load(&models.MessageRevision{})
+	defer revisionORM.Close()
+
+	// The revision is written first so an edit is never visible without the
+	// version it replaced.
+	if err := revisionORM.Insert(revision); err != nil {
+		return nil, fmt.Errorf("failed to save message revision: %w", err)
+	}
+
+	// UpdateMessage always writes the flags, so carry the current ones over.
+	updated, err := s.UpdateMessage(msg.Id, &models.Message{
+		Content:  content,
+		Media:    media,
+		EditedAt: &now,
+		Received: msg.Received,
+		Seen:     msg.Seen,
+	}, ChangeEdit)
+	if err != nil {
+		if _, derr := revisionORM.DeleteByPrimaryKey(revision.Id); derr != nil {
+			log.Printf("[%s] failed to drop revision %s of %s: %v", s.chatId, revision.Id, msg.Id, derr)
+		}
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// MessageRevisions returns the earlier versions of a message, oldest first.
+// Both participants can read them.
+func (s *Store) MessageRevisions(userId string, messageId string) ([]models.MessageRevision, error) {
+	if !s.IsParticipant(userId) {
+		return nil, ErrUnauthorized
+	}
+
+	revisionORM := orm.Load(&models.MessageRevision{})
+	defer revisionORM.Close()
+
+	var revisions []models.MessageRevision
+	if err := revisionORM.GetByFieldsEquals(map[string]any{
+		"ChatId":    s.chatId,
+		"MessageId": messageId,
+	}).Scan(&revisions); err != nil {
+		return nil, fmt.Errorf("failed to get message revisions: %w", err)
+	}
+
+	for i := range revisions {
+		if err := openRevision(s.chatId, &revisions[i]); err != nil {
+			return nil, fmt.Errorf("failed to open message revision: %w", err)
+		}
+	}
+	slices.SortFunc(revisions, func(a, b models.MessageRevision) int {
+		return a.ReplacedAt.Compare(b.ReplacedAt)
+	})
+	return revisions, nil
+}
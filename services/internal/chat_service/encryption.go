@@ -0,0 +1,587 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/utils"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+// Messages are sealed with a per-chat data key before they reach the Redis
+// buffer or the chats table. Data keys are stored wrapped by a master key
+// from CHAT_MASTER_KEYS, a comma separated list of id:base64 pairs. The
+// highest id wraps new data keys; older ids only need to stay configured
+// until RewrapChatKeys has moved every data key off them. Without any master
+// key messages are stored in plaintext, as they were before.
+
+const (
+	masterKeysEnv = "CHAT_MASTER_KEYS"
+	dataKeySize   = 32
+
+	// keyCacheTTL bounds how long an instance keeps sealing with a data key
+	// after another one rotated it. Older versions stay readable regardless.
+	keyCacheTTL = 5 * time.Minute
+)
+
+var (
+	ErrNoMasterKey       = errors.New("chat encryption is not configured")
+	ErrUnknownKeyVersion = errors.New("chat data key version not found")
+)
+
+type masterKeys struct {
+	keys    map[int][]byte
+	current int
+}
+
+func parseMasterKeys(raw string) (*masterKeys, error) {
+	mk := &masterKeys{keys: make(map[int][]byte)}
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		idStr, encoded, ok := strings.Cut(entry, ":")
+		id, err := strconv.Atoi(idStr)
+		if !ok || err != nil || id <= 0 {
+			return nil, fmt.Errorf("%s: entries must look like <id>:<base64 key>", masterKeysEnv)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != dataKeySize {
+			return nil, fmt.Errorf("%s: key %d must be %d bytes of base64", masterKeysEnv, id, dataKeySize)
+		}
+		if _, dup := mk.keys[id]; dup {
+			return nil, fmt.Errorf("%s: key %d is listed twice", masterKeysEnv, id)
+		}
+		mk.keys[id] = key
+		mk.current = max(mk.current, id)
+	}
+	if len(mk.keys) == 0 {
+		return nil, nil
+	}
+	return mk, nil
+}
+
+// loadMasterKeys returns nil when encryption is not configured.
+func loadMasterKeys() (*masterKeys, error) {
+	return parseMasterKeys(config.GetEnvRaw(masterKeysEnv))
+}
+
+// sealedFields is what a sealed message keeps encrypted.
+type sealedFields struct {
+	Content      string                 `json:"content,omitempty"`
+	Media        []models.Media         `json:"media,omitempty"`
+	ReplyTo      *models.MessagePreview `json:"reply_to,omitempty"`
+	DateProposal *models.DateProposal   `json:"date_proposal,omitempty"`
+}
+
+// messageAAD binds a sealed message to its chat, id and key version, so a
+// sealed payload cannot be moved onto another message.
+func messageAAD(chatId string, messageId string, version int) []byte {
+	return fmt.Appendf(nil, "blindly:msg:%s:%s:%d", chatId, messageId, version)
+}
+
+func dataKeyAAD(chatId string, version int) []byte {
+	return fmt.Appendf(nil, "blindly:dek:%s:%d", chatId, version)
+}
+
+func gcmSeal(key []byte, plaintext []byte, aad []byte) ([]byte, []byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	return nonce, gcm.Seal(nil, nonce, plaintext, aad), nil
+}
+
+func gcmOpen(key []byte, nonce []byte, ciphertext []byte, aad []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, errors.New("invalid nonce")
+	}
+	return gcm.Open(nil, nonce, ciphertext, aad)
+}
+
+// sealMessage encrypts msg's sealed fields with key and clears them.
+func sealMessage(msg *models.Message, chatId string, version int, key []byte) error {
+	plaintext, err := json.Marshal(sealedFields{
+		Content:      msg.Content,
+		Media:        msg.Media,
+		ReplyTo:      msg.ReplyTo,
+		DateProposal: msg.DateProposal,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode message %s: %w", msg.Id, err)
+	}
+	nonce, data, err := gcmSeal(key, plaintext, messageAAD(chatId, msg.Id, version))
+	if err != nil {
+		return fmt.Errorf("failed to seal message %s: %w", msg.Id, err)
+	}
+
+	msg.Content = ""
+	msg.Media = nil
+	msg.ReplyTo = nil
+	msg.DateProposal = nil
+	msg.Sealed = &models.SealedContent{KeyVersion: version, Nonce: nonce, Data: data}
+	return nil
+}
+
+// openMessage restores msg's sealed fields with the key it was sealed with.
+func openMessage(msg *models.Message, chatId string, key []byte) error {
+	sealed := msg.Sealed
+	plaintext, err := gcmOpen(key, sealed.Nonce, sealed.Data, messageAAD(chatId, msg.Id, sealed.KeyVersion))
+	if err != nil {
+		return fmt.Errorf("failed to open message %s: %w", msg.Id, err)
+	}
+	var fields sealedFields
+	if err := json.Unmarshal(plaintext, &fields); err != nil {
+		return fmt.Errorf("failed to decode message %s: %w", msg.Id, err)
+	}
+
+	msg.Content = fields.Content
+	msg.Media = fields.Media
+	msg.ReplyTo = fields.ReplyTo
+	msg.DateProposal = fields.DateProposal
+	msg.Sealed = nil
+	return nil
+}
+
+// wrapDataKey encrypts a data key with a master key, as base64 of the nonce
+// followed by the ciphertext.
+func wrapDataKey(master []byte, chatId string, version int, dek []byte) (string, error) {
+	nonce, data, err := gcmSeal(master, dek, dataKeyAAD(chatId, version))
+	if err != nil {
+		return "", fmt.Errorf("failed to wrap data key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(append(nonce, data...)), nil
+}
+
+func unwrapDataKey(master []byte, chatId string, version int, wrapped string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil || len(raw) < 12 {
+		return nil, errors.New("malformed wrapped data key")
+	}
+	dek, err := gcmOpen(master, raw[:12], raw[12:], dataKeyAAD(chatId, version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key %s/%d: %w", chatId, version, err)
+	}
+	return dek, nil
+}
+
+// chatKeySet is the unwrapped data keys of one chat.
+type chatKeySet struct {
+	keys     map[int][]byte
+	latest   int
+	loadedAt time.Time
+}
+
+var (
+	dataKeysMu sync.Mutex
+	dataKeys   = make(map[string]*chatKeySet)
+)
+
+func cachedKeys(chatId string) *chatKeySet {
+	dataKeysMu.Lock()
+	defer dataKeysMu.Unlock()
+	return dataKeys[chatId]
+}
+
+func cacheKeys(chatId string, set *chatKeySet) {
+	dataKeysMu.Lock()
+	defer dataKeysMu.Unlock()
+	dataKeys[chatId] = set
+}
+
+func forgetKeys(chatId string) {
+	dataKeysMu.Lock()
+	defer dataKeysMu.Unlock()
+	delete(dataKeys, chatId)
+}
+
+func loadChatKeys(chatId string, mk *masterKeys) (*chatKeySet, error) {
+	keyORM := orm.Load(&models.ChatKey{})
+	defer keyORM.Close()
+
+	var rows []models.ChatKey
+	if err := keyORM.GetByFieldEquals("ChatId", chatId).Scan(&rows); err != nil {
+		return nil, fmt.Errorf("failed to get chat keys: %w", err)
+	}
+
+	set := &chatKeySet{keys: make(map[int][]byte, len(rows)), loadedAt: time.Now()}
+	for _, row := range rows {
+		master, ok := mk.keys[row.MasterKeyId]
+		if !ok {
+			return nil, fmt.Errorf("master key %d for chat %s is not configured", row.MasterKeyId, chatId)
+		}
+		dek, err := unwrapDataKey(master, chatId, row.Version, row.WrappedKey)
+		if err != nil {
+			return nil, err
+		}
+		set.keys[row.Version] = dek
+		set.latest = max(set.latest, row.Version)
+	}
+	cacheKeys(chatId, set)
+	return set, nil
+}
+
+// createChatKey stores a new data key version for the chat. Losing a race
+// to another instance creating the same version is fine; its key is used.
+func createChatKey(chatId string, version int, mk *masterKeys) error {
+	dek := make([]byte, dataKeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return fmt.Errorf("failed to generate data key: %w", err)
+	}
+	wrapped, err := wrapDataKey(mk.keys[mk.current], chatId, version, dek)
+	if err != nil {
+		return err
+	}
+
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	now := time.Now()
+	if _, err := db.Exec(`
+		INSERT INTO chat_keys (id, chat_id, version, master_key_id, wrapped_key, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $6)
+		ON CONFLICT (chat_id, version) DO NOTHING
+	`, strings.ToUpper(utils.GenerateID(20)), chatId, version, mk.current, wrapped, now); err != nil {
+		return fmt.Errorf("failed to store data key: %w", err)
+	}
+	forgetKeys(chatId)
+	return nil
+}
+
+// currentDataKey returns the version and key new messages are sealed with,
+// creating the chat's first key when it has none.
+func currentDataKey(chatId string, mk *masterKeys) (int, []byte, error) {
+	set := cachedKeys(chatId)
+	if set == nil || set.latest == 0 || time.Since(set.loadedAt) > keyCacheTTL {
+		var err error
+		if set, err = loadChatKeys(chatId, mk); err != nil {
+			return 0, nil, err
+		}
+	}
+	if set.latest == 0 {
+		if err := createChatKey(chatId, 1, mk); err != nil {
+			return 0, nil, err
+		}
+		var err error
+		if set, err = loadChatKeys(chatId, mk); err != nil {
+			return 0, nil, err
+		}
+		if set.latest == 0 {
+			return 0, nil, ErrUnknownKeyVersion
+		}
+	}
+	return set.latest, set.keys[set.latest], nil
+}
+
+func dataKeyVersion(chatId string, version int, mk *masterKeys) ([]byte, error) {
+	if set := cachedKeys(chatId); set != nil {
+		if key, ok := set.keys[version]; ok {
+			return key, nil
+		}
+	}
+	// Another instance may have rotated the chat since it was cached.
+	set, err := loadChatKeys(chatId, mk)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := set.keys[version]
+	if !ok {
+		return nil, ErrUnknownKeyVersion
+	}
+	return key, nil
+}
+
+// OpenMessage decrypts a message read straight from storage. Messages that
+// were never sealed are left as they are.
+func OpenMessage(chatId string, msg *models.Message) error {
+	if msg.Sealed == nil {
+		return nil
+	}
+	mk, err := loadMasterKeys()
+	if err != nil {
+		return err
+	}
+	if mk == nil {
+		return ErrNoMasterKey
+	}
+	key, err := dataKeyVersion(chatId, msg.Sealed.KeyVersion, mk)
+	if err != nil {
+		return err
+	}
+	return openMessage(msg, chatId, key)
+}
+
+// sealForStorage seals msg with the chat's current data key. Sealed
+// messages and stores without a master key are left alone.
+func (s *Store) sealForStorage(msg *models.Message) error {
+	if msg.Sealed != nil {
+		return nil
+	}
+	mk, err := loadMasterKeys()
+	if err != nil || mk == nil {
+		return err
+	}
+	version, key, err := currentDataKey(s.chatId, mk)
+	if err != nil {
+		return err
+	}
+	return sealMessage(msg, s.chatId, version, key)
+}
+
+func (s *Store) sealAll(messages []models.Message) error {
+	for i := range messages {
+		if err := s.sealForStorage(&messages[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) openAll(messages []models.Message) error {
+	for i := range messages {
+		if err := OpenMessage(s.chatId, &messages[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sealRevision seals a revision's content and media like a message's, bound
+// to the revision's own id.
+func (s *Store) sealRevision(rev *models.MessageRevision) error {
+	if rev.Sealed != nil {
+		return nil
+	}
+	msg := models.Message{Id: rev.Id, Content: rev.Content, Media: rev.Media}
+	if err := s.sealForStorage(&msg); err != nil || msg.Sealed == nil {
+		return err
+	}
+	rev.Content, rev.Media, rev.Sealed = "", nil, msg.Sealed
+	return nil
+}
+
+// openRevision restores a revision sealed by sealRevision.
+func openRevision(chatId string, rev *models.MessageRevision) error {
+	if rev.Sealed == nil {
+		return nil
+	}
+	msg := models.Message{Id: rev.Id, Sealed: rev.Sealed}
+	if err := OpenMessage(chatId, &msg); err != nil {
+		return err
+	}
+	rev.Content, rev.Media, rev.Sealed = msg.Content, msg.Media, nil
+	return nil
+}
+
+// RotateChatKey gives a chat a new data key version and re-seals its flushed
+// history, revisions, held and scheduled messages with it. Buffered messages
+// keep the version they were sealed with until they are flushed, and archived
+// segments keep theirs for good, so older versions stay available for reading.
+func RotateChatKey(chatId string) (int, error) {
+	mk, err := loadMasterKeys()
+	if err != nil {
+		return 0, err
+	}
+	if mk == nil {
+		return 0, ErrNoMasterKey
+	}
+
+	set, err := loadChatKeys(chatId, mk)
+	if err != nil {
+		return 0, err
+	}
+	next := set.latest + 1
+	if err := createChatKey(chatId, next, mk); err != nil {
+		return 0, err
+	}
+
+	s := NewStoreWithoutAuth(chatId)
+	defer s.Close()
+
+	// Every write opens the history and seals it again with the latest key.
+	if err := s.modifyMessagesInDB(func(messages []models.Message) ([]models.Message, bool, error) {
+		return messages, len(messages) > 0, nil
+	}); err != nil {
+		return 0, fmt.Errorf("failed to re-seal chat %s: %w", chatId, err)
+	}
+	if err := s.resealRows(); err != nil {
+		return 0, fmt.Errorf("failed to re-seal chat %s: %w", chatId, err)
+	}
+	return next, nil
+}
+
+// resealRows seals the chat's revisions and its held and scheduled messages
+// again with the latest data key.
+func (s *Store) resealRows() error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	for _, table := range []string{"held_messages", "scheduled_messages"} {
+		var rows []struct {
+			Id      string `db:"id"`
+			Message []byte `db:"message"`
+		}
+		if err := db.Select(&rows, `SELECT id, message FROM `+table+` WHERE chat_id = $1`, s.chatId); err != nil {
+			return fmt.Errorf("failed to get %s: %w", table, err)
+		}
+		for _, row := range rows {
+			var msg models.Message
+			if err := json.Unmarshal(row.Message, &msg); err != nil {
+				return fmt.Errorf("failed to decode %s %s: %w", table, row.Id, err)
+			}
+			if err := OpenMessage(s.chatId, &msg); err != nil {
+				return err
+			}
+			if err := s.sealForStorage(&msg); err != nil {
+				return err
+			}
+			data, err := json.Marshal(&msg)
+			if err != nil {
+				return err
+			}
+			if _, err := db.Exec(`UPDATE `+table+` SET message = $2 WHERE id = $1`, row.Id, string(data)); err != nil {
+				return fmt.Errorf("failed to re-seal %s %s: %w", table, row.Id, err)
+			}
+		}
+	}
+
+	var revisions []struct {
+		Id      string `db:"id"`
+		Content string `db:"content"`
+		Media   []byte `db:"media"`
+		Sealed  []byte `db:"sealed"`
+	}
+	if err := db.Select(&revisions, `
+		SELECT id, content, media, sealed FROM message_revisions WHERE chat_id = $1
+	`, s.chatId); err != nil {
+		return fmt.Errorf("failed to get message revisions: %w", err)
+	}
+	for _, row := range revisions {
+		rev := models.MessageRevision{Id: row.Id, Content: row.Content}
+		if len(row.Media) > 0 {
+			if err := json.Unmarshal(row.Media, &rev.Media); err != nil {
+				return fmt.Errorf("failed to decode revision %s: %w", row.Id, err)
+			}
+		}
+		if len(row.Sealed) > 0 {
+			if err := json.Unmarshal(row.Sealed, &rev.Sealed); err != nil {
+				return fmt.Errorf("failed to decode revision %s: %w", row.Id, err)
+			}
+		}
+		if err := openRevision(s.chatId, &rev); err != nil {
+			return err
+		}
+		if err := s.sealRevision(&rev); err != nil {
+			return err
+		}
+		sealed, err := json.Marshal(rev.Sealed)
+		if err != nil {
+			return err
+		}
+		if _, err := db.Exec(`
+			UPDATE message_revisions SET content = $2, media = NULL, sealed = $3 WHERE id = $1
+		`, row.Id, rev.Content, string(sealed)); err != nil {
+			return fmt.Errorf("failed to re-seal revision %s: %w", row.Id, err)
+		}
+	}
+	return nil
+}
+
+// RewrapChatKeys wraps every data key still under an older master key with
+// the current one and returns how many were rewrapped. Everything sealed at
+// rest, messages as well as revisions, held and scheduled messages, is sealed
+// under data keys, so none of it needs rewriting. Once it has run, the older
+// master keys can be removed from the configuration.
+func RewrapChatKeys() (int, error) {
+	mk, err := loadMasterKeys()
+	if err != nil {
+		return 0, err
+	}
+	if mk == nil {
+		return 0, ErrNoMasterKey
+	}
+
+	db, err := database.PostgresConn()
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	dbRows, err := db.Query(`
+		SELECT id, chat_id, version, master_key_id, wrapped_key FROM chat_keys WHERE master_key_id <> $1
+	`, mk.current)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get chat keys: %w", err)
+	}
+	var rows []models.ChatKey
+	for dbRows.Next() {
+		var row models.ChatKey
+		if err := dbRows.Scan(&row.Id, &row.ChatId, &row.Version, &row.MasterKeyId, &row.WrappedKey); err != nil {
+			dbRows.Close()
+			return 0, fmt.Errorf("failed to read chat key: %w", err)
+		}
+		rows = append(rows, row)
+	}
+	dbRows.Close()
+	if err := dbRows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read chat keys: %w", err)
+	}
+
+	rewrapped := 0
+	for _, row := range rows {
+		master, ok := mk.keys[row.MasterKeyId]
+		if !ok {
+			return rewrapped, fmt.Errorf("master key %d for chat %s is not configured", row.MasterKeyId, row.ChatId)
+		}
+		dek, err := unwrapDataKey(master, row.ChatId, row.Version, row.WrappedKey)
+		if err != nil {
+			return rewrapped, err
+		}
+		wrapped, err := wrapDataKey(mk.keys[mk.current], row.ChatId, row.Version, dek)
+		if err != nil {
+			return rewrapped, err
+		}
+		if _, err := db.Exec(`
+			UPDATE chat_keys SET wrapped_key = $2, master_key_id = $3, updated_at = $4 WHERE id = $1
+		`, row.Id, wrapped, mk.current, time.Now()); err != nil {
+			return rewrapped, fmt.Errorf("failed to rewrap data key %s: %w", row.Id, err)
+		}
+		rewrapped++
+	}
+	return rewrapped, nil
+}
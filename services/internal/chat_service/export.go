@@ -0,0 +1,423 @@
+package chatservice
+
+import (
+	"blindly/internal/helpers/storage"
+	"blindly/internal/helpers/users"
+	"blindly/internal/models"
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/utils"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+const (
+	ExportJSON = "json"
+	ExportHTML = "html"
+
+	ExportPending = "pending"
+	ExportRunning = "running"
+	ExportReady   = "ready"
+	ExportFailed  = "failed"
+
+	// ExportLinkTTL is how long media links inside a transcript keep working.
+	// S3 does not sign for longer.
+	ExportLinkTTL = 7 * 24 * time.Hour
+	// ExportDownloadTTL bounds the link handed out for the transcript itself.
+	ExportDownloadTTL = 15 * time.Minute
+	// MaxExportAttempts bounds retries of transient export failures.
+	MaxExportAttempts = 3
+
+	// staleExportAfter lets a retried job take over an export left running by
+	// a crashed instance.
+	staleExportAfter = 10 * time.Minute
+	// exportFileKey is the user_files key transcripts are stored under.
+	exportFileKey = "chat_export"
+)
+
+var (
+	ErrExportInProgress    = errors.New("an export of this chat is already in progress")
+	ErrUnknownExportFormat = errors.New("export format must be json or html")
+)
+
+type ExportRequest struct {
+	Id string `json:"id"`
+}
+
+// Transcript is a participant's copy of a chat, flushed and buffered
+// messages alike. Media from uploaded files links to signed URLs.
+type Transcript struct {
+	ChatId       string                  `json:"chat_id"`
+	ExportedBy   string                  `json:"exported_by"`
+	ExportedAt   time.Time               `json:"exported_at"`
+	Participants []TranscriptParticipant `json:"participants"`
+	Messages     []models.Message        `json:"messages"`
+}
+
+type TranscriptParticipant struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// RequestExport queues a transcript of this chat for userId. The transcript
+// is built by a QStash job and lands in the user's files.
+func (s *Store) RequestExport(userId string, format string) (*models.ChatExport, error) {
+	if !s.IsParticipant(userId) {
+		return nil, ErrUnauthorized
+	}
+	format = strings.ToLower(format)
+	if format != ExportJSON && format != ExportHTML {
+		return nil, ErrUnknownExportFormat
+	}
+
+	existing, err := ChatExports(userId, s.chatId)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range existing {
+		if e.Status == ExportPending || e.Status == ExportRunning {
+			return nil, ErrExportInProgress
+		}
+	}
+
+	now := time.Now()
+	export := &models.ChatExport{
+		Id:        strings.ToUpper(utils.GenerateID(20)),
+		ChatId:    s.chatId,
+		UserId:    userId,
+		Format:    format,
+		Status:    ExportPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	exportORM := orm.Load(&models.ChatExport{})
+	defer exportORM.Close()
+
+	if err := exportORM.Insert(export); err != nil {
+		return nil, fmt.Errorf("failed to create export: %w", err)
+	}
+
+	if err := publishQStashJob(
+		config.GetEnvRaw("QSTASH_TOKEN"),
+		"/v1/chat/exports/run",
+		ExportRequest{Id: export.Id},
+		0,
+		fmt.Sprintf("chat--%s--export--%s", s.chatId, export.Id),
+	); err != nil {
+		// Nothing else picks exports up, so do not leave one pending forever.
+		if _, delErr := exportORM.DeleteByPrimaryKey(export.Id); delErr != nil {
+			log.Printf("[%s] failed to remove unqueued export %s: %v", s.chatId, export.Id, delErr)
+		}
+		return nil, fmt.Errorf("failed to queue export: %w", err)
+	}
+
+	return export, nil
+}
+
+// ChatExports returns userId's exports of chatId, newest first.
+func ChatExports(userId string, chatId string) ([]models.ChatExport, error) {
+	exportORM := orm.Load(&models.ChatExport{})
+	defer exportORM.Close()
+
+	var exports []models.ChatExport
+	if err := exportORM.GetByFieldsEquals(map[string]any{"UserId": userId, "ChatId": chatId}).Scan(&exports); err != nil {
+		return nil, fmt.Errorf("failed to get exports: %w", err)
+	}
+	slices.SortFunc(exports, func(a, b models.ChatExport) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return exports, nil
+}
+
+// ExportDownloadURL returns a short-lived link to a ready export's file, or
+// an empty string while it is not ready.
+func ExportDownloadURL(export *models.ChatExport) (string, error) {
+	if export.Status != ExportReady || export.FileId == "" {
+		return "", nil
+	}
+
+	fileORM := orm.Load(&models.UserFiles{})
+	defer fileORM.Close()
+
+	var files []models.UserFiles
+	if err := fileORM.GetByFieldEquals("Id", export.FileId).Scan(&files); err != nil {
+		return "", fmt.Errorf("failed to get export file: %w", err)
+	}
+	if len(files) == 0 || files[0].Uid != export.UserId {
+		return "", fmt.Errorf("export file not found: %s", export.FileId)
+	}
+	return storage.PresignGet(files[0].S3Path, ExportDownloadTTL)
+}
+
+// RunExport builds, uploads and records the transcript for an export.
+// Claiming the row first keeps a redelivered job from building it twice;
+// transient failures put it back to pending for QStash to retry.
+func RunExport(id string) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	now := time.Now()
+	var (
+		chatId, userId, format string
+		attempts               int
+	)
+	err = db.QueryRow(`
+		UPDATE chat_exports
+		SET status = 'running', attempts = attempts + 1, updated_at = $2
+		WHERE id = $1 AND (status = 'pending' OR (status = 'running' AND updated_at < $3))
+		RETURNING chat_id, user_id, format, attempts
+	`, id, now, now.Add(-staleExportAfter)).Scan(&chatId, &userId, &format, &attempts)
+	if err == sql.ErrNoRows {
+		// Already built, failed for good or being built elsewhere.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to claim export: %w", err)
+	}
+
+	finish := func(status string, fileId string, reason string) {
+		if _, err := db.Exec(`
+			UPDATE chat_exports SET status = $2, file_id = $3, error = $4, updated_at = $5 WHERE id = $1
+		`, id, status, fileId, reason, time.Now()); err != nil {
+			log.Printf("failed to record export %s as %s: %v", id, status, err)
+		}
+	}
+
+	store, err := NewStore(chatId, userId)
+	if err != nil {
+		if errors.Is(err, ErrUnauthorized) {
+			finish(ExportFailed, "", err.Error())
+			return nil
+		}
+		return retryExport(finish, attempts, err)
+	}
+	defer store.Close()
+
+	transcript, err := store.buildTranscript(userId, now, func(path string) (string, error) {
+		return storage.PresignGet(path, ExportLinkTTL)
+	})
+	if err != nil {
+		return retryExport(finish, attempts, err)
+	}
+
+	body, contentType, err := renderTranscript(transcript, format)
+	if err != nil {
+		finish(ExportFailed, "", err.Error())
+		return nil
+	}
+
+	s3Path, err := storage.Put(
+		fmt.Sprintf("blindly/user_files/%s/chat-%s-%s.%s", userId, chatId, id, format),
+		body,
+		contentType,
+	)
+	if err != nil {
+		return retryExport(finish, attempts, err)
+	}
+
+	file := &models.UserFiles{
+		Id:         utils.GenerateID(),
+		Uid:        userId,
+		Key:        exportFileKey,
+		S3Path:     s3Path,
+		Visibility: "private",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	fileORM := orm.Load(&models.UserFiles{})
+	defer fileORM.Close()
+
+	if err := fileORM.Insert(file); err != nil {
+		return retryExport(finish, attempts, fmt.Errorf("failed to record export file: %w", err))
+	}
+
+	finish(ExportReady, file.Id, "")
+	return nil
+}
+
+func retryExport(finish func(string, string, string), attempts int, cause error) error {
+	if attempts >= MaxExportAttempts {
+		finish(ExportFailed, "", cause.Error())
+		return nil
+	}
+	finish(ExportPending, "", cause.Error())
+	return fmt.Errorf("failed to export chat: %w", cause)
+}
+
+// buildTranscript collects the whole chat for userId. The other participant
+// is only named once the match is unlocked.
+func (s *Store) buildTranscript(userId string, now time.Time, sign func(path string) (string, error)) (*Transcript, error) {
+	messages, err := s.GetMessages(0, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := signTranscriptMedia(messages, sign); err != nil {
+		return nil, err
+	}
+
+	participants := make([]TranscriptParticipant, 0, len(s.participants))
+	for _, id := range s.participants {
+		name := "Your match"
+		if id == userId || s.unlocked {
+			user, err := users.GetUserById(id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get user: %w", err)
+			}
+			name = user.FirstName
+		}
+		participants = append(participants, TranscriptParticipant{Id: id, Name: name})
+	}
+
+	return &Transcript{
+		ChatId:       s.chatId,
+		ExportedBy:   userId,
+		ExportedAt:   now,
+		Participants: participants,
+		Messages:     messages,
+	}, nil
+}
+
+// signTranscriptMedia swaps stored file URLs for signed ones. View-once media
+// never has a URL to sign, and links the client supplied are kept as is.
+func signTranscriptMedia(messages []models.Message, sign func(path string) (string, error)) error {
+	for i := range messages {
+		media := slices.Clone(messages[i].Media)
+		for j := range media {
+			if media[j].FileId == "" || media[j].Url == "" {
+				continue
+			}
+			url, err := sign(media[j].Url)
+			if err != nil {
+				return fmt.Errorf("failed to sign media %s: %w", media[j].Id, err)
+			}
+			media[j].Url = url
+		}
+		messages[i].Media = media
+	}
+	return nil
+}
+
+// renderTranscript encodes t in format and returns the body with its
+// content type.
+func renderTranscript(t *Transcript, format string) ([]byte, string, error) {
+	switch format {
+	case ExportJSON:
+		data, err := json.MarshalIndent(t, "", "  ")
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to encode transcript: %w", err)
+		}
+		return data, "application/json", nil
+	case ExportHTML:
+		var buf bytes.Buffer
+		if err := transcriptHTML.Execute(&buf, newTranscriptPage(t)); err != nil {
+			return nil, "", fmt.Errorf("failed to render transcript: %w", err)
+		}
+		return buf.Bytes(), "text/html; charset=utf-8", nil
+	default:
+		return nil, "", ErrUnknownExportFormat
+	}
+}
+
+const transcriptTimeLayout = "2 Jan 2006, 15:04 MST"
+
+type transcriptPage struct {
+	*Transcript
+	names map[string]string
+}
+
+func newTranscriptPage(t *Transcript) transcriptPage {
+	names := make(map[string]string, len(t.Participants))
+	for _, p := range t.Participants {
+		names[p.Id] = p.Name
+	}
+	return transcriptPage{Transcript: t, names: names}
+}
+
+func (p transcriptPage) Name(id string) string {
+	if id == models.SystemSenderId {
+		return "Blindly"
+	}
+	if name, ok := p.names[id]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+func (p transcriptPage) Time(t time.Time) string {
+	return t.UTC().Format(transcriptTimeLayout)
+}
+
+// MediaKind picks how a media item is shown: image, video, audio or a link.
+func (p transcriptPage) MediaKind(m models.Media) string {
+	kind := strings.ToLower(m.Type)
+	for _, k := range []string{"image", "video", "audio"} {
+		if strings.HasPrefix(kind, k) {
+			return k
+		}
+	}
+	return "link"
+}
+
+// transcriptHTML is self-contained: styles are inline and the only external
+// references are the signed media URLs.
+var transcriptHTML = template.Must(template.New("transcript").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Blindly chat transcript</title>
+<style>
+body{font-family:-apple-system,BlinkMacSystemFont,"Segoe UI",Roboto,sans-serif;background:#f6f6f8;color:#1d1d1f;margin:0;padding:24px}
+main{max-width:720px;margin:0 auto}
+header{margin-bottom:24px}
+h1{font-size:20px;margin:0 0 4px}
+.sub{color:#6e6e73;font-size:13px;margin:0}
+.msg{background:#fff;border-radius:12px;padding:10px 14px;margin:8px 0;max-width:80%}
+.mine{margin-left:auto;background:#e8f0fe}
+.system{margin:8px auto;background:none;color:#6e6e73;text-align:center;font-size:13px}
+.meta{color:#6e6e73;font-size:12px;margin-bottom:4px}
+.content{white-space:pre-wrap;word-wrap:break-word;margin:0}
+.quote{border-left:3px solid #c7c7cc;padding-left:8px;color:#6e6e73;font-size:13px;margin-bottom:6px}
+.card{border:1px solid #d2d2d7;border-radius:8px;padding:8px;margin-top:6px;font-size:14px}
+img,video{max-width:100%;border-radius:8px;margin-top:6px}
+audio{width:100%;margin-top:6px}
+.reactions{font-size:13px;margin-top:4px}
+</style>
+</head>
+<body>
+<main>
+<header>
+<h1>Chat transcript</h1>
+<p class="sub">{{range $i, $p := .Participants}}{{if $i}} &amp; {{end}}{{$p.Name}}{{end}} &middot; exported {{$.Time .ExportedAt}} &middot; {{len .Messages}} messages</p>
+<p class="sub">Media links expire seven days after export.</p>
+</header>
+{{range .Messages}}
+{{if eq .Type "SYSTEM"}}<div class="msg system">{{.Content}}</div>{{else}}
+<div class="msg{{if eq .SenderId $.ExportedBy}} mine{{end}}">
+<div class="meta">{{$.Name .SenderId}} &middot; {{$.Time .CreatedAt}}{{if .EditedAt}} &middot; edited{{end}}</div>
+{{with .ReplyTo}}<div class="quote">{{$.Name .SenderId}}: {{.Content}}</div>{{end}}
+{{with .Content}}<p class="content">{{.}}</p>{{end}}
+{{range .Media}}{{if .ViewOnce}}<div class="card">View-once {{.Type}}{{if .OpenedAt}} (opened){{end}}</div>{{else if .Url}}{{$kind := $.MediaKind .}}{{if eq $kind "image"}}<a href="{{.Url}}"><img src="{{.Url}}" alt="image"></a>{{else if eq $kind "video"}}<video controls src="{{.Url}}"></video>{{else if eq $kind "audio"}}<audio controls src="{{.Url}}"></audio>{{else}}<div class="card"><a href="{{.Url}}">Download {{.Type}}</a></div>{{end}}{{end}}{{end}}
+{{with .DateProposal}}<div class="card">Date at {{.Venue}}, {{$.Time .StartsAt}}{{with .Note}}<br>{{.}}{{end}}<br>{{.Status}}</div>{{end}}
+{{with .Call}}<div class="card">{{.Media}} call &middot; {{.Status}}{{if .DurationSec}} &middot; {{.DurationSec}}s{{end}}</div>{{end}}
+{{with .Reactions}}<div class="reactions">{{range .}}{{.Content}} {{end}}</div>{{end}}
+</div>{{end}}
+{{end}}
+</main>
+</body>
+</html>
+`))
@@ -0,0 +1,284 @@
+package chatservice
+
+import (
+	"blindly/internal/helpers/users"
+	"blindly/internal/models"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MelloB1989/karma/utils"
+)
+
+const (
+	DefaultIcebreakers = 5
+	MaxIcebreakers     = 10
+
+	// icebreakerQuoteLength caps how much of a profile prompt is quoted.
+	icebreakerQuoteLength = 80
+)
+
+type IcebreakerSource string
+
+const (
+	IcebreakerHobby    IcebreakerSource = "hobby"
+	IcebreakerInterest IcebreakerSource = "interest"
+	IcebreakerProfile  IcebreakerSource = "profile"
+	IcebreakerPrompt   IcebreakerSource = "prompt"
+	IcebreakerGeneric  IcebreakerSource = "generic"
+)
+
+// Icebreaker is a suggested opener built from what two matched users have in
+// common. Topic is the shared hobby, interest or profile detail it is about.
+type Icebreaker struct {
+	Text   string           `json:"text"`
+	Topic  string           `json:"topic,omitempty"`
+	Source IcebreakerSource `json:"source"`
+}
+
+var (
+	hobbyTemplates = []string{
+		"You both enjoy %s. What got you into it?",
+		"Both of you listed %s. When did you last make time for it?",
+		"You share a love of %s. What's the best memory you have of it?",
+	}
+	interestTemplates = []string{
+		"You're both into %s. What's something about it you could talk about for hours?",
+		"You both mentioned %s. How did you first get interested?",
+		"You both follow %s. Any recent favourite you'd recommend?",
+	}
+	genericIcebreakers = []string{
+		"What's something you're looking forward to this month?",
+		"What does a perfect lazy Sunday look like for you?",
+		"What's a small thing that always makes your day better?",
+		"If you could be anywhere right now, where would it be?",
+		"What's the best thing you've eaten recently?",
+	}
+)
+
+// SharedIcebreakers suggests openers that read the same for both users, built
+// only from what they have in common. It is what new chats start with.
+func SharedIcebreakers(a models.User, b models.User, limit int) []Icebreaker {
+	return generateIcebreakers(a, b, false, limit)
+}
+
+// IcebreakersFor suggests openers for viewer to send to other, including ones
+// about other's own profile prompts.
+func IcebreakersFor(viewer models.User, other models.User, limit int) []Icebreaker {
+	return generateIcebreakers(viewer, other, true, limit)
+}
+
+func generateIcebreakers(a models.User, b models.User, withPrompts bool, limit int) []Icebreaker {
+	if limit <= 0 {
+		limit = DefaultIcebreakers
+	}
+	if limit > MaxIcebreakers {
+		limit = MaxIcebreakers
+	}
+
+	var out []Icebreaker
+	add := func(ib Icebreaker) {
+		for _, existing := range out {
+			if strings.EqualFold(existing.Topic, ib.Topic) && ib.Topic != "" {
+				return
+			}
+		}
+		out = append(out, ib)
+	}
+
+	for _, hobby := range sharedValues(a.Hobbies, b.Hobbies) {
+		add(Icebreaker{Text: fmt.Sprintf(pickTemplate(hobbyTemplates, hobby), hobby), Topic: hobby, Source: IcebreakerHobby})
+	}
+	for _, interest := range sharedValues(a.Interests, b.Interests) {
+		add(Icebreaker{Text: fmt.Sprintf(pickTemplate(interestTemplates, interest), interest), Topic: interest, Source: IcebreakerInterest})
+	}
+	for _, ib := range profileIcebreakers(a.Extra, b.Extra) {
+		add(ib)
+	}
+	if withPrompts {
+		for _, prompt := range b.UserPrompts {
+			if ib, ok := promptIcebreaker(prompt); ok {
+				add(ib)
+			}
+		}
+	}
+
+	// Interleave sources so the first few are not all about hobbies.
+	out = interleaveBySource(out)
+
+	for _, text := range genericIcebreakers {
+		if len(out) >= limit {
+			break
+		}
+		out = append(out, Icebreaker{Text: text, Source: IcebreakerGeneric})
+	}
+
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// profileIcebreakers looks for matching details in the extra profile fields.
+func profileIcebreakers(a models.ExtraMetadata, b models.ExtraMetadata) []Icebreaker {
+	var out []Icebreaker
+	for _, lang := range sharedValues(a.Languages, b.Languages) {
+		out = append(out, Icebreaker{
+			Text:   fmt.Sprintf("You both speak %s. Where did you pick it up?", lang),
+			Topic:  lang,
+			Source: IcebreakerProfile,
+		})
+	}
+	for _, goal := range sharedValues(a.LookingFor, b.LookingFor) {
+		out = append(out, Icebreaker{
+			Text:   fmt.Sprintf("You're both looking for %s. What does that look like for you?", strings.ToLower(goal)),
+			Topic:  goal,
+			Source: IcebreakerProfile,
+		})
+	}
+	if sameValue(a.School, b.School) {
+		out = append(out, Icebreaker{
+			Text:   fmt.Sprintf("You both went to %s. Any favourite spot on campus?", strings.TrimSpace(a.School)),
+			Topic:  a.School,
+			Source: IcebreakerProfile,
+		})
+	}
+	if sameValue(a.Excercise, b.Excercise) {
+		out = append(out, Icebreaker{
+			Text:   "You have the same take on exercise. What does a typical active week look like for you?",
+			Topic:  a.Excercise,
+			Source: IcebreakerProfile,
+		})
+	}
+	return out
+}
+
+// promptIcebreaker turns one of the other user's profile prompts into a
+// question about it. Prompts written as "Question? Answer" quote the answer.
+func promptIcebreaker(prompt string) (Icebreaker, bool) {
+	prompt = strings.TrimSpace(prompt)
+	if prompt == "" {
+		return Icebreaker{}, false
+	}
+
+	quote := prompt
+	if i := strings.Index(prompt, "?"); i >= 0 && strings.TrimSpace(prompt[i+1:]) != "" {
+		quote = strings.TrimSpace(prompt[i+1:])
+	}
+	if r := []rune(quote); len(r) > icebreakerQuoteLength {
+		quote = strings.TrimSpace(string(r[:icebreakerQuoteLength])) + "…"
+	}
+
+	return Icebreaker{
+		Text:   fmt.Sprintf("Their profile says \"%s\". Ask them the story behind it.", quote),
+		Topic:  prompt,
+		Source: IcebreakerPrompt,
+	}, true
+}
+
+// sharedValues returns the values present in both lists, compared without
+// case, in a stable order.
+func sharedValues(a []string, b []string) []string {
+	seen := make(map[string]bool, len(b))
+	for _, v := range b {
+		seen[normalizeTopic(v)] = true
+	}
+
+	var out []string
+	added := make(map[string]bool)
+	for _, v := range a {
+		key := normalizeTopic(v)
+		if key == "" || !seen[key] || added[key] {
+			continue
+		}
+		added[key] = true
+		out = append(out, strings.TrimSpace(v))
+	}
+	slices.SortFunc(out, func(x, y string) int {
+		return strings.Compare(normalizeTopic(x), normalizeTopic(y))
+	})
+	return out
+}
+
+func sameValue(a string, b string) bool {
+	return normalizeTopic(a) != "" && normalizeTopic(a) == normalizeTopic(b)
+}
+
+func normalizeTopic(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// pickTemplate chooses a template from the topic itself, so the same pair
+// always gets the same suggestions while different topics read differently.
+func pickTemplate(templates []string, topic string) string {
+	h := fnv.New32a()
+	h.Write([]byte(normalizeTopic(topic)))
+	return templates[int(h.Sum32()%uint32(len(templates)))]
+}
+
+func interleaveBySource(in []Icebreaker) []Icebreaker {
+	var order []IcebreakerSource
+	groups := make(map[IcebreakerSource][]Icebreaker)
+	for _, ib := range in {
+		if _, ok := groups[ib.Source]; !ok {
+			order = append(order, ib.Source)
+		}
+		groups[ib.Source] = append(groups[ib.Source], ib)
+	}
+
+	out := make([]Icebreaker, 0, len(in))
+	for len(out) < len(in) {
+		for _, src := range order {
+			if g := groups[src]; len(g) > 0 {
+				out = append(out, g[0])
+				groups[src] = g[1:]
+			}
+		}
+	}
+	return out
+}
+
+// IcebreakerMessage is the system message a new chat starts with. It carries
+// the best shared suggestion so both users see the same opener.
+func IcebreakerMessage(a models.User, b models.User) models.Message {
+	now := time.Now()
+	text := genericIcebreakers[0]
+	if suggestions := SharedIcebreakers(a, b, 1); len(suggestions) > 0 {
+		text = suggestions[0].Text
+	}
+	return models.Message{
+		Id:        strings.ToUpper(utils.GenerateID(20)),
+		Type:      models.SYSTEM,
+		SenderId:  models.SystemSenderId,
+		Content:   text,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Icebreakers returns suggestions for userId to open this chat with.
+func (s *Store) Icebreakers(userId string, limit int) ([]Icebreaker, error) {
+	if !s.IsParticipant(userId) {
+		return nil, ErrUnauthorized
+	}
+
+	var otherId string
+	for _, p := range s.participants {
+		if p != userId {
+			otherId = p
+		}
+	}
+
+	viewer, err := users.GetUserById(userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	other, err := users.GetUserById(otherId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match: %w", err)
+	}
+
+	return IcebreakersFor(*viewer, *other, limit), nil
+}
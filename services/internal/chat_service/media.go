@@ -0,0 +1,50 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"errors"
+	"fmt"
+
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+var ErrMediaNotOwned = errors.New("media file does not belong to the sender")
+
+// ResolveMediaFile fills media from the sender's uploaded file, so the URL and
+// any audio metadata come from what the server recorded at upload time rather
+// than from the client.
+//
+// View-once media must reference an uploaded file, and its URL is never
+// stored on the message; it is handed out by OpenViewOnce instead.
+func ResolveMediaFile(senderId string, media *models.Media) error {
+	if media.FileId == "" {
+		if media.ViewOnce {
+			return fmt.Errorf("view-once media requires an uploaded file_id")
+		}
+		return nil
+	}
+
+	fileORM := orm.Load(&models.UserFiles{})
+	defer fileORM.Close()
+
+	var files []models.UserFiles
+	if err := fileORM.GetByFieldEquals("Id", media.FileId).Scan(&files); err != nil {
+		return fmt.Errorf("failed to get media file: %w", err)
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("media file not found: %s", media.FileId)
+	}
+
+	file := files[0]
+	if file.Uid != senderId {
+		return ErrMediaNotOwned
+	}
+
+	media.Url = file.S3Path
+	media.Metadata = file.Metadata
+	if media.ViewOnce {
+		media.Url = ""
+		media.OpenedAt = nil
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package chatservice
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type ModerationAction int
+
+// Actions are ordered by severity; the chain keeps the most severe verdict.
+const (
+	ModerationAllow ModerationAction = iota
+	ModerationMask
+	ModerationHold
+	ModerationReject
+)
+
+func (a ModerationAction) String() string {
+	switch a {
+	case ModerationMask:
+		return "mask"
+	case ModerationHold:
+		return "hold"
+	case ModerationReject:
+		return "reject"
+	default:
+		return "allow"
+	}
+}
+
+type ModerationReason string
+
+const (
+	ReasonContactInfo    ModerationReason = "contact_info"
+	ReasonPaymentLink    ModerationReason = "payment_link"
+	ReasonProfanity      ModerationReason = "profanity"
+	ReasonFlaggedContent ModerationReason = "flagged_content"
+)
+
+// ModerationContext describes the conversation a message is being sent into.
+type ModerationContext struct {
+	ChatId   string
+	SenderId string
+	// Unlocked is true once the match has revealed photos to each other.
+	Unlocked bool
+}
+
+type ModerationVerdict struct {
+	Action  ModerationAction
+	Reason  ModerationReason
+	Content string
+}
+
+// ModerationRule inspects message content. Rules that mask return the
+// rewritten content in the verdict; it is passed on to the next rule.
+type ModerationRule interface {
+	Check(mc ModerationContext, content string) (ModerationVerdict, error)
+}
+
+// ModerationError is returned by SendMessage when moderation changed the
+// outcome of a send. Masked messages are still delivered.
+type ModerationError struct {
+	Action ModerationAction
+	Reason ModerationReason
+}
+
+func (e *ModerationError) Error() string {
+	switch e.Action {
+	case ModerationMask:
+		return fmt.Sprintf("message was delivered with parts hidden (%s)", e.Reason)
+	case ModerationHold:
+		return fmt.Sprintf("message is held for review (%s)", e.Reason)
+	default:
+		return fmt.Sprintf("message was rejected (%s)", e.Reason)
+	}
+}
+
+func (e *ModerationError) Delivered() bool {
+	return e.Action == ModerationMask
+}
+
+type ModerationChain struct {
+	rules []ModerationRule
+}
+
+func NewModerationChain(rules ...ModerationRule) *ModerationChain {
+	return &ModerationChain{rules: rules}
+}
+
+// Use appends rules to the end of the chain.
+func (c *ModerationChain) Use(rules ...ModerationRule) {
+	c.rules = append(c.rules, rules...)
+}
+
+// Run applies every rule in order. Hold and reject stop the chain; masks
+// accumulate. A failing rule is logged and skipped so an unavailable
+// classifier never blocks chat.
+func (c *ModerationChain) Run(mc ModerationContext, content string) ModerationVerdict {
+	result := ModerationVerdict{Action: ModerationAllow, Content: content}
+	if c == nil {
+		return result
+	}
+
+	for _, rule := range c.rules {
+		v, err := rule.Check(mc, result.Content)
+		if err != nil {
+			log.Printf("[%s] moderation rule %T failed: %v", mc.ChatId, rule, err)
+			continue
+		}
+
+		if v.Action == ModerationMask {
+			result.Content = v.Content
+		}
+		if v.Action > result.Action {
+			result.Action = v.Action
+			result.Reason = v.Reason
+		}
+		if result.Action >= ModerationHold {
+			break
+		}
+	}
+
+	return result
+}
+
+// DefaultModeration is used by every Store unless replaced with SetModeration.
+var DefaultModeration = NewModerationChain(
+	NewContactInfoRule(),
+	NewProfanityRule(ModerationMask, defaultProfanity...),
+)
+
+var (
+	emailPattern   = regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}\b`)
+	phonePattern   = regexp.MustCompile(`\+?\d[\d\s().-]{8,}\d`)
+	urlPattern     = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+	handlePattern  = regexp.MustCompile(`(?i)(?:\b(?:insta(?:gram)?|ig|snap(?:chat)?|sc|telegram|tg|whatsapp|wa|twitter)\s*(?::\s*@?|@)\s*|(?:^|\s)@)[a-z0-9._]{3,30}\b`)
+	paymentPattern = regexp.MustCompile(`(?i)\b(?:paypal\.me|venmo\.com|cash\.app|buymeacoffee\.com|ko-fi\.com)/\S+|\$[a-z][a-z0-9_]{2,}\b|\b[a-z0-9._-]+@(?:ok(?:axis|hdfcbank|icici|sbi)|ybl|paytm|upi|ibl|axl)\b`)
+)
+
+// ContactInfoRule hides phone numbers, emails, links and social handles until
+// the match is unlocked, and holds payment requests for review at any stage.
+type ContactInfoRule struct{}
+
+func NewContactInfoRule() *ContactInfoRule {
+	return &ContactInfoRule{}
+}
+
+func (r *ContactInfoRule) Check(mc ModerationContext, content string) (ModerationVerdict, error) {
+	if paymentPattern.MatchString(content) {
+		return ModerationVerdict{Action: ModerationHold, Reason: ReasonPaymentLink, Content: content}, nil
+	}
+
+	if mc.Unlocked {
+		return ModerationVerdict{Action: ModerationAllow, Content: content}, nil
+	}
+
+	masked := content
+	for _, p := range []*regexp.Regexp{emailPattern, urlPattern, handlePattern} {
+		masked = p.ReplaceAllStringFunc(masked, maskRunes)
+	}
+	masked = phonePattern.ReplaceAllStringFunc(masked, func(m string) string {
+		if n := countDigits(m); n < 9 || n > 15 {
+			return m
+		}
+		return maskRunes(m)
+	})
+	if masked == content {
+		return ModerationVerdict{Action: ModerationAllow, Content: content}, nil
+	}
+
+	return ModerationVerdict{Action: ModerationMask, Reason: ReasonContactInfo, Content: masked}, nil
+}
+
+var defaultProfanity = []string{"fuck", "fucking", "shit", "bitch", "bastard", "asshole", "dick", "cunt", "slut", "whore"}
+
+// ProfanityRule matches whole words from a list and applies the configured
+// action to them.
+type ProfanityRule struct {
+	action ModerationAction
+	words  map[string]struct{}
+}
+
+func NewProfanityRule(action ModerationAction, words ...string) *ProfanityRule {
+	r := &ProfanityRule{action: action, words: make(map[string]struct{}, len(words))}
+	for _, w := range words {
+		r.words[strings.ToLower(w)] = struct{}{}
+	}
+	return r
+}
+
+func (r *ProfanityRule) Check(mc ModerationContext, content string) (ModerationVerdict, error) {
+	runes := []rune(content)
+	found := false
+
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		inWord := i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsNumber(runes[i]))
+		if inWord {
+			if start == -1 {
+				start = i
+			}
+			continue
+		}
+		if start == -1 {
+			continue
+		}
+		if _, ok := r.words[strings.ToLower(string(runes[start:i]))]; ok {
+			found = true
+			for j := start; j < i; j++ {
+				runes[j] = '*'
+			}
+		}
+		start = -1
+	}
+
+	if !found {
+		return ModerationVerdict{Action: ModerationAllow, Content: content}, nil
+	}
+
+	v := ModerationVerdict{Action: r.action, Reason: ReasonProfanity, Content: content}
+	if r.action == ModerationMask {
+		v.Content = string(runes)
+	}
+	return v, nil
+}
+
+// Classifier scores content for abuse; Score is in [0, 1].
+type Classifier interface {
+	Classify(content string) (label string, score float64, err error)
+}
+
+// ClassifierRule holds or rejects content once the classifier's score crosses
+// the configured thresholds. A zero threshold disables that action.
+type ClassifierRule struct {
+	classifier      Classifier
+	holdThreshold   float64
+	rejectThreshold float64
+}
+
+func NewClassifierRule(classifier Classifier, holdThreshold float64, rejectThreshold float64) *ClassifierRule {
+	return &ClassifierRule{
+		classifier:      classifier,
+		holdThreshold:   holdThreshold,
+		rejectThreshold: rejectThreshold,
+	}
+}
+
+func (r *ClassifierRule) Check(mc ModerationContext, content string) (ModerationVerdict, error) {
+	label, score, err := r.classifier.Classify(content)
+	if err != nil {
+		return ModerationVerdict{}, fmt.Errorf("classifier failed: %w", err)
+	}
+
+	v := ModerationVerdict{Action: ModerationAllow, Content: content}
+	switch {
+	case r.rejectThreshold > 0 && score >= r.rejectThreshold:
+		v.Action = ModerationReject
+	case r.holdThreshold > 0 && score >= r.holdThreshold:
+		v.Action = ModerationHold
+	default:
+		return v, nil
+	}
+
+	v.Reason = ReasonFlaggedContent
+	if label != "" {
+		log.Printf("[%s] classifier flagged message from %s as %s (%.2f)", mc.ChatId, mc.SenderId, label, score)
+	}
+	return v, nil
+}
+
+func maskRunes(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return r
+		}
+		return '*'
+	}, s)
+}
+
+func countDigits(s string) int {
+	n := 0
+	for _, r := range s {
+		if unicode.IsDigit(r) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,154 @@
+package chatservice
+
+import (
+	"blindly/internal/helpers/socketstate"
+	"blindly/internal/models"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+// PresenceEvent is the payload of presence events published to a user's chats.
+type PresenceEvent struct {
+	UserId     string     `json:"user_id"`
+	IsOnline   bool       `json:"is_online"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+}
+
+// PresenceSession keeps a user online for the lifetime of one socket. A user
+// goes offline only when their last session closes or stops heartbeating.
+type PresenceSession struct {
+	userId string
+	connId string
+	hidden bool
+	done   chan struct{}
+	once   sync.Once
+}
+
+// TrackPresence marks connId as a live connection of userId, heartbeats it in
+// the background and tells the user's matches when they come online, unless
+// the user hides their online status.
+func TrackPresence(userId string, connId string) *PresenceSession {
+	p := &PresenceSession{
+		userId: userId,
+		connId: connId,
+		hidden: hidesOnline(userId),
+		done:   make(chan struct{}),
+	}
+
+	cameOnline, err := socketstate.Connect(userId, connId)
+	if err != nil {
+		log.Printf("[presence] failed to connect %s: %v", userId, err)
+	}
+	if cameOnline {
+		p.announce(true, nil)
+	}
+
+	go func() {
+		ticker := time.NewTicker(socketstate.HeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.done:
+				return
+			case <-ticker.C:
+				p.Heartbeat()
+			}
+		}
+	}()
+
+	return p
+}
+
+// Heartbeat refreshes the session outside the regular interval.
+func (p *PresenceSession) Heartbeat() {
+	cameOnline, err := socketstate.Heartbeat(p.userId, p.connId)
+	if err != nil {
+		log.Printf("[presence] heartbeat failed for %s: %v", p.userId, err)
+		return
+	}
+	if cameOnline {
+		p.announce(true, nil)
+	}
+}
+
+// Close ends the session and, if it was the user's last, records their last
+// seen time and tells their matches they went offline.
+func (p *PresenceSession) Close() {
+	p.once.Do(func() {
+		close(p.done)
+
+		wentOffline, at, err := socketstate.Disconnect(p.userId, p.connId)
+		if err != nil {
+			log.Printf("[presence] failed to disconnect %s: %v", p.userId, err)
+		}
+		if wentOffline {
+			p.announce(false, &at)
+		}
+	})
+}
+
+func (p *PresenceSession) announce(online bool, lastSeen *time.Time) {
+	if p.hidden {
+		return
+	}
+	if err := PublishPresence(p.userId, online, lastSeen); err != nil {
+		log.Printf("[presence] failed to publish for %s: %v", p.userId, err)
+	}
+}
+
+func hidesOnline(userId string) bool {
+	userORM := orm.Load(&models.User{})
+	defer userORM.Close()
+
+	var users []models.User
+	if err := userORM.GetByFieldEquals("Id", userId).Scan(&users); err != nil || len(users) == 0 {
+		return false
+	}
+	return users[0].HideOnline
+}
+
+// PublishPresence sends a presence event to every chat userId takes part in,
+// so only their matches learn about it.
+func PublishPresence(userId string, online bool, lastSeen *time.Time) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	var chatIds []string
+	if err := db.Select(&chatIds, `
+		SELECT c.id FROM chats c
+		JOIN matches m ON c.match_id = m.id::text
+		WHERE m.she_id = $1 OR m.he_id = $1
+	`, userId); err != nil {
+		return fmt.Errorf("failed to get chats: %w", err)
+	}
+	if len(chatIds) == 0 {
+		return nil
+	}
+
+	data, _ := json.Marshal(PresenceEvent{
+		UserId:     userId,
+		IsOnline:   online,
+		LastSeenAt: lastSeen,
+	})
+	eventJSON, _ := json.Marshal(PubSubEvent{
+		Type: MessageEventPresence,
+		Data: data,
+	})
+
+	backend := openBackend()
+	defer backend.Close()
+
+	if err := backend.Publish(string(eventJSON), chatIds...); err != nil {
+		return fmt.Errorf("failed to publish presence: %w", err)
+	}
+	return nil
+}
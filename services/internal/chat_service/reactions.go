@@ -0,0 +1,172 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MelloB1989/karma/utils"
+)
+
+// AddReaction sets userId's reaction on a message, replacing any reaction
+// they had already left on it.
+func (s *Store) AddReaction(messageId string, userId string, content string) (*models.Message, error) {
+	if content == "" {
+		return nil, fmt.Errorf("reaction is required")
+	}
+	return s.setReaction(messageId, userId, content)
+}
+
+// RemoveReaction clears userId's reaction from a message.
+func (s *Store) RemoveReaction(messageId string, userId string) (*models.Message, error) {
+	return s.setReaction(messageId, userId, "")
+}
+
+func (s *Store) setReaction(messageId string, userId string, content string) (*models.Message, error) {
+	s.ensureBackend()
+
+	msg, changed, err := s.setReactionInBuffer(messageId, userId, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update buffered reaction: %w", err)
+	}
+
+	if msg == nil {
+		msg, changed, err = s.setReactionInDB(messageId, userId, content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if changed {
+		s.publishUpdateEvent(msg, ChangeReaction)
+	}
+
+	return msg, nil
+}
+
+// setReactionInBuffer applies the reaction as an optimistic update so a
+// concurrent update or flush of the buffer makes it retry instead of
+// overwriting. A nil message means it is not buffered.
+func (s *Store) setReactionInBuffer(messageId string, userId string, content string) (*models.Message, bool, error) {
+	var result *models.Message
+	var changed bool
+
+	err := s.backend.UpdateBuffered(s.chatId, func(buffered []string) (map[int]string, error) {
+		result, changed = nil, false
+
+		for i, str := range buffered {
+			var msg models.Message
+			if err := json.Unmarshal([]byte(str), &msg); err != nil || msg.Id != messageId {
+				continue
+			}
+
+			changed = applyReaction(&msg, userId, content, time.Now())
+			// Reactions are not sealed, so the message is written back as it
+			// was stored and only the returned copy is opened.
+			opened := msg
+			if err := OpenMessage(s.chatId, &opened); err != nil {
+				return nil, err
+			}
+			result = &opened
+			if !changed {
+				return nil, nil
+			}
+
+			data, err := json.Marshal(msg)
+			if err != nil {
+				return nil, err
+			}
+			return map[int]string{i: string(data)}, nil
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return result, changed, nil
+}
+
+func (s *Store) setReactionInDB(messageId string, userId string, content string) (*models.Message, bool, error) {
+	var result *models.Message
+	var changed bool
+
+	err := s.modifyMessagesInDB(func(messages []models.Message) ([]models.Message, bool, error) {
+		for i := range messages {
+			if messages[i].Id != messageId {
+				continue
+			}
+			changed = applyReaction(&messages[i], userId, content, time.Now())
+			msg := messages[i]
+			result = &msg
+			return messages, changed, nil
+		}
+		return nil, false, fmt.Errorf("message not found: %s", messageId)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return result, changed, nil
+}
+
+// applyReaction replaces userId's reaction on msg with content, or removes it
+// when content is empty, and refreshes the per-emoji counts. It reports
+// whether anything changed.
+func applyReaction(msg *models.Message, userId string, content string, now time.Time) bool {
+	reactions := make([]models.Reaction, 0, len(msg.Reactions)+1)
+	removed := false
+	for _, r := range msg.Reactions {
+		if r.SenderId != userId {
+			reactions = append(reactions, r)
+			continue
+		}
+		if r.Content == content {
+			// Same reaction again is a no-op.
+			return false
+		}
+		removed = true
+	}
+
+	if content == "" && !removed {
+		return false
+	}
+
+	if content != "" {
+		reactions = append(reactions, models.Reaction{
+			Id:        utils.GenerateID(10),
+			SenderId:  userId,
+			Content:   content,
+			CreatedAt: now,
+		})
+	}
+
+	// Removing the last reaction leaves the message serialized like one that
+	// never had any: reactions null and reaction_counts left out.
+	if len(reactions) == 0 {
+		reactions = nil
+	}
+	msg.Reactions = reactions
+	msg.ReactionCounts = countReactions(reactions)
+
+	return true
+}
+
+// countReactions groups reactions by emoji, in order of first use.
+func countReactions(reactions []models.Reaction) []models.ReactionCount {
+	if len(reactions) == 0 {
+		return nil
+	}
+
+	counts := make([]models.ReactionCount, 0)
+	index := make(map[string]int)
+	for _, r := range reactions {
+		if i, ok := index[r.Content]; ok {
+			counts[i].Count++
+			continue
+		}
+		index[r.Content] = len(counts)
+		counts = append(counts, models.ReactionCount{Content: r.Content, Count: 1})
+	}
+	return counts
+}
@@ -0,0 +1,450 @@
+package chatservice
+
+import (
+	"blindly/internal/helpers/users"
+	"blindly/internal/mailer"
+	"blindly/internal/models"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/utils"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+const (
+	CheckInScheduled = "scheduled"
+	CheckInCheckedIn = "checked_in"
+	CheckInMissed    = "missed"
+	CheckInCancelled = "cancelled"
+
+	MaxTrustedContacts = 5
+	// CheckInGrace is how long after a missed check-in contacts are alerted.
+	CheckInGrace = 15 * time.Minute
+	// MaxCheckInAfterDate bounds how long after the date a check-in can be set.
+	MaxCheckInAfterDate = 24 * time.Hour
+)
+
+var (
+	ErrTooManyContacts   = fmt.Errorf("at most %d trusted contacts can be added", MaxTrustedContacts)
+	ErrContactExists     = errors.New("this email is already a trusted contact")
+	ErrContactNotFound   = errors.New("trusted contact not found")
+	ErrNoTrustedContacts = errors.New("add a trusted contact first")
+	ErrDateNotFound      = errors.New("planned date not found")
+	ErrCheckInNotFound   = errors.New("check-in not found or no longer scheduled")
+	ErrCheckInTime       = fmt.Errorf("check_in_at must be in the future and within %s of the date", MaxCheckInAfterDate)
+)
+
+type CheckInDueRequest struct {
+	Id string `json:"id"`
+}
+
+func TrustedContacts(userId string) ([]models.TrustedContact, error) {
+	contactORM := orm.Load(&models.TrustedContact{})
+	defer contactORM.Close()
+
+	var contacts []models.TrustedContact
+	if err := contactORM.GetByFieldEquals("UserId", userId).Scan(&contacts); err != nil {
+		return nil, fmt.Errorf("failed to get trusted contacts: %w", err)
+	}
+	slices.SortFunc(contacts, func(a, b models.TrustedContact) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return contacts, nil
+}
+
+func AddTrustedContact(userId string, name string, email string) (*models.TrustedContact, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	addr, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil {
+		return nil, fmt.Errorf("invalid email: %w", err)
+	}
+	email = strings.ToLower(addr.Address)
+
+	contacts, err := TrustedContacts(userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(contacts) >= MaxTrustedContacts {
+		return nil, ErrTooManyContacts
+	}
+	for _, c := range contacts {
+		if c.Email == email {
+			return nil, ErrContactExists
+		}
+	}
+
+	contact := &models.TrustedContact{
+		Id:        strings.ToUpper(utils.GenerateID(20)),
+		UserId:    userId,
+		Name:      name,
+		Email:     email,
+		CreatedAt: time.Now(),
+	}
+
+	contactORM := orm.Load(&models.TrustedContact{})
+	defer contactORM.Close()
+
+	if err := contactORM.Insert(contact); err != nil {
+		return nil, fmt.Errorf("failed to add trusted contact: %w", err)
+	}
+	return contact, nil
+}
+
+func RemoveTrustedContact(userId string, id string) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	res, err := db.Exec(`DELETE FROM trusted_contacts WHERE id = $1 AND user_id = $2`, id, userId)
+	if err != nil {
+		return fmt.Errorf("failed to remove trusted contact: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrContactNotFound
+	}
+	return nil
+}
+
+// plannedDateFor returns one of userId's planned dates.
+func plannedDateFor(userId string, dateId string) (*models.PlannedDate, error) {
+	dateORM := orm.Load(&models.PlannedDate{})
+	defer dateORM.Close()
+
+	var dates []models.PlannedDate
+	if err := dateORM.GetByFieldEquals("Id", dateId).Scan(&dates); err != nil {
+		return nil, fmt.Errorf("failed to get planned date: %w", err)
+	}
+	if len(dates) == 0 || (dates[0].ProposedBy != userId && dates[0].AcceptedBy != userId) {
+		return nil, ErrDateNotFound
+	}
+	return &dates[0], nil
+}
+
+func dateDetails(userId string, date *models.PlannedDate) (mailer.DateDetails, error) {
+	user, err := users.GetUserById(userId)
+	if err != nil {
+		return mailer.DateDetails{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	return mailer.DateDetails{
+		UserName: strings.TrimSpace(user.FirstName + " " + user.LastName),
+		Venue:    date.Venue,
+		StartsAt: date.StartsAt,
+		Note:     date.Note,
+	}, nil
+}
+
+// sendToContacts mails every contact, carrying on past failures so one bad
+// address does not stop the others from hearing.
+func sendToContacts(contacts []models.TrustedContact, build func(c models.TrustedContact) *mailer.Template) (int, error) {
+	var errs []error
+	sent := 0
+	for _, c := range contacts {
+		if err := build(c).Send(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.Email, err))
+			continue
+		}
+		sent++
+	}
+	return sent, errors.Join(errs...)
+}
+
+// ShareDate emails the details of a planned date to userId's trusted
+// contacts and returns how many were reached.
+func ShareDate(userId string, dateId string) (int, error) {
+	date, err := plannedDateFor(userId, dateId)
+	if err != nil {
+		return 0, err
+	}
+	contacts, err := TrustedContacts(userId)
+	if err != nil {
+		return 0, err
+	}
+	if len(contacts) == 0 {
+		return 0, ErrNoTrustedContacts
+	}
+	details, err := dateDetails(userId, date)
+	if err != nil {
+		return 0, err
+	}
+
+	sent, err := sendToContacts(contacts, func(c models.TrustedContact) *mailer.Template {
+		return mailer.BuildDateShared(c.Email, c.Name, details)
+	})
+	if err != nil {
+		log.Printf("failed to share date %s with some contacts of %s: %v", dateId, userId, err)
+		if sent == 0 {
+			return 0, fmt.Errorf("failed to share date: %w", err)
+		}
+	}
+	return sent, nil
+}
+
+func validateCheckInTime(date *models.PlannedDate, at time.Time, now time.Time) error {
+	if !at.After(now) || at.After(date.StartsAt.Add(MaxCheckInAfterDate)) {
+		return ErrCheckInTime
+	}
+	return nil
+}
+
+// ScheduleCheckIn sets a time by which userId will check in after a date. If
+// they have not by CheckInGrace later, their trusted contacts are alerted.
+func ScheduleCheckIn(userId string, dateId string, at time.Time) (*models.DateCheckIn, error) {
+	date, err := plannedDateFor(userId, dateId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := validateCheckInTime(date, at, now); err != nil {
+		return nil, err
+	}
+	contacts, err := TrustedContacts(userId)
+	if err != nil {
+		return nil, err
+	}
+	if len(contacts) == 0 {
+		return nil, ErrNoTrustedContacts
+	}
+
+	checkIn := &models.DateCheckIn{
+		Id:        strings.ToUpper(utils.GenerateID(20)),
+		UserId:    userId,
+		DateId:    dateId,
+		CheckInAt: at.UTC(),
+		Status:    CheckInScheduled,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	checkInORM := orm.Load(&models.DateCheckIn{})
+	defer checkInORM.Close()
+
+	if err := checkInORM.Insert(checkIn); err != nil {
+		return nil, fmt.Errorf("failed to schedule check-in: %w", err)
+	}
+
+	if err := publishQStashJob(
+		config.GetEnvRaw("QSTASH_TOKEN"),
+		"/v1/chat/checkins/due",
+		CheckInDueRequest{Id: checkIn.Id},
+		at.Add(CheckInGrace).Sub(now),
+		fmt.Sprintf("checkin--%s", checkIn.Id),
+	); err != nil {
+		log.Printf("failed to queue check-in %s, leaving it to the sweep: %v", checkIn.Id, err)
+	}
+
+	return checkIn, nil
+}
+
+// ListCheckIns returns userId's check-ins, soonest first.
+func ListCheckIns(userId string) ([]models.DateCheckIn, error) {
+	checkInORM := orm.Load(&models.DateCheckIn{})
+	defer checkInORM.Close()
+
+	var checkIns []models.DateCheckIn
+	if err := checkInORM.GetByFieldEquals("UserId", userId).Scan(&checkIns); err != nil {
+		return nil, fmt.Errorf("failed to get check-ins: %w", err)
+	}
+	slices.SortFunc(checkIns, func(a, b models.DateCheckIn) int {
+		return a.CheckInAt.Compare(b.CheckInAt)
+	})
+	return checkIns, nil
+}
+
+// CheckIn marks a check-in done. Checking in after contacts were alerted
+// tells them the user is safe.
+func CheckIn(userId string, id string) (*models.DateCheckIn, error) {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	var previous string
+	now := time.Now()
+	err = db.QueryRow(`
+		UPDATE date_checkins c SET status = $3, checked_in_at = $4, updated_at = $4
+		FROM (SELECT id, status FROM date_checkins WHERE id = $1 AND user_id = $2 FOR UPDATE) old
+		WHERE c.id = old.id AND old.status IN ('scheduled', 'missed')
+		RETURNING old.status
+	`, id, userId, CheckInCheckedIn, now).Scan(&previous)
+	db.Close()
+	if err == sql.ErrNoRows {
+		return nil, ErrCheckInNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to check in: %w", err)
+	}
+
+	if previous == CheckInMissed {
+		if err := sendAllClear(userId); err != nil {
+			log.Printf("failed to send all-clear for check-in %s: %v", id, err)
+		}
+	}
+
+	return getCheckIn(id)
+}
+
+func sendAllClear(userId string) error {
+	contacts, err := TrustedContacts(userId)
+	if err != nil {
+		return err
+	}
+	user, err := users.GetUserById(userId)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	name := strings.TrimSpace(user.FirstName + " " + user.LastName)
+	_, err = sendToContacts(contacts, func(c models.TrustedContact) *mailer.Template {
+		return mailer.BuildCheckInAllClear(c.Email, c.Name, name)
+	})
+	return err
+}
+
+func CancelCheckIn(userId string, id string) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	res, err := db.Exec(`
+		UPDATE date_checkins SET status = $3, updated_at = $4
+		WHERE id = $1 AND user_id = $2 AND status = 'scheduled'
+	`, id, userId, CheckInCancelled, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to cancel check-in: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrCheckInNotFound
+	}
+	return nil
+}
+
+func getCheckIn(id string) (*models.DateCheckIn, error) {
+	checkInORM := orm.Load(&models.DateCheckIn{})
+	defer checkInORM.Close()
+
+	var checkIns []models.DateCheckIn
+	if err := checkInORM.GetByFieldEquals("Id", id).Scan(&checkIns); err != nil {
+		return nil, fmt.Errorf("failed to get check-in: %w", err)
+	}
+	if len(checkIns) == 0 {
+		return nil, ErrCheckInNotFound
+	}
+	return &checkIns[0], nil
+}
+
+// AlertMissedCheckIn alerts the trusted contacts of a check-in that was not
+// made in time. Claiming the row first means the QStash job and the sweep
+// never both alert.
+func AlertMissedCheckIn(id string) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	var checkIn models.DateCheckIn
+	now := time.Now()
+	err = db.QueryRow(`
+		UPDATE date_checkins SET status = $2, alerted_at = $3, updated_at = $3
+		WHERE id = $1 AND status = 'scheduled' AND check_in_at <= $4
+		RETURNING id, user_id, date_id, check_in_at
+	`, id, CheckInMissed, now, now.UTC().Add(-CheckInGrace)).Scan(
+		&checkIn.Id, &checkIn.UserId, &checkIn.DateId, &checkIn.CheckInAt,
+	)
+	if err == sql.ErrNoRows {
+		// Checked in, cancelled, already alerted or not due yet.
+		db.Close()
+		return nil
+	}
+	if err != nil {
+		db.Close()
+		return fmt.Errorf("failed to claim check-in: %w", err)
+	}
+	defer db.Close()
+
+	// Nobody was told, so put it back for the next attempt.
+	release := func(cause error) error {
+		if _, err := db.Exec(`
+			UPDATE date_checkins SET status = 'scheduled', alerted_at = NULL, updated_at = $2
+			WHERE id = $1 AND status = $3
+		`, id, time.Now(), CheckInMissed); err != nil {
+			log.Printf("failed to release check-in %s: %v", id, err)
+		}
+		return cause
+	}
+
+	date, err := plannedDateFor(checkIn.UserId, checkIn.DateId)
+	if err != nil {
+		return release(err)
+	}
+	details, err := dateDetails(checkIn.UserId, date)
+	if err != nil {
+		return release(err)
+	}
+	contacts, err := TrustedContacts(checkIn.UserId)
+	if err != nil {
+		return release(err)
+	}
+
+	sent, err := sendMissedCheckInAlerts(contacts, details, &checkIn)
+	if err != nil && sent == 0 {
+		return release(err)
+	}
+	return err
+}
+
+func sendMissedCheckInAlerts(contacts []models.TrustedContact, details mailer.DateDetails, checkIn *models.DateCheckIn) (int, error) {
+	if len(contacts) == 0 {
+		log.Printf("check-in %s was missed but %s has no trusted contacts left", checkIn.Id, checkIn.UserId)
+		return 0, nil
+	}
+	sent, err := sendToContacts(contacts, func(c models.TrustedContact) *mailer.Template {
+		return mailer.BuildMissedCheckIn(c.Email, c.Name, details, checkIn.CheckInAt)
+	})
+	log.Printf("check-in %s missed, alerted %d of %d contacts of %s", checkIn.Id, sent, len(contacts), checkIn.UserId)
+	if err != nil {
+		return sent, fmt.Errorf("failed to alert trusted contacts: %w", err)
+	}
+	return sent, nil
+}
+
+// AlertMissedCheckIns alerts for every check-in past its grace period. It
+// backs up the per-check-in QStash jobs.
+func AlertMissedCheckIns() error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	var ids []string
+	err = db.Select(&ids, `
+		SELECT id FROM date_checkins
+		WHERE status = 'scheduled' AND check_in_at <= $1
+		ORDER BY check_in_at
+		LIMIT 100
+	`, time.Now().UTC().Add(-CheckInGrace))
+	db.Close()
+	if err != nil {
+		return fmt.Errorf("failed to get missed check-ins: %w", err)
+	}
+
+	for _, id := range ids {
+		if err := AlertMissedCheckIn(id); err != nil {
+			log.Printf("check-in %s: %v", id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/utils"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+const (
+	ScheduledPending   = "pending"
+	ScheduledSending   = "sending"
+	ScheduledSent      = "sent"
+	ScheduledHeld      = "held"
+	ScheduledCancelled = "cancelled"
+	ScheduledFailed    = "failed"
+
+	// MinScheduleLead keeps scheduling from being used as a plain send.
+	MinScheduleLead = 30 * time.Second
+	// MaxScheduleAhead stays within the longest delay QStash accepts.
+	MaxScheduleAhead = 7 * 24 * time.Hour
+	// MaxScheduledDeliveryAttempts bounds retries of transient send failures.
+	MaxScheduledDeliveryAttempts = 5
+	// MaxPendingScheduled caps pending messages per sender per chat.
+	MaxPendingScheduled = 20
+
+	// SchedulerInterval is how often due messages are swept up in case their
+	// QStash job was lost.
+	SchedulerInterval = time.Minute
+	// scheduleSlack lets a job that fires slightly early still deliver.
+	scheduleSlack = 5 * time.Second
+	// staleSendingAfter returns rows left in sending by a crashed instance to
+	// pending.
+	staleSendingAfter = 5 * time.Minute
+)
+
+var (
+	ErrScheduleTooSoon       = fmt.Errorf("deliver_at must be at least %s in the future", MinScheduleLead)
+	ErrScheduleTooFar        = fmt.Errorf("deliver_at must be within %s", MaxScheduleAhead)
+	ErrTooManyScheduled      = fmt.Errorf("at most %d scheduled messages can be pending per chat", MaxPendingScheduled)
+	ErrScheduledNotCancelled = errors.New("scheduled message not found or no longer pending")
+)
+
+type ScheduledDeliveryRequest struct {
+	Id string `json:"id"`
+}
+
+// ScheduleMessage stores msg for delivery at deliverAt and queues a delivery
+// job. The row is the source of truth, so a lost job is picked up by the
+// scheduler sweep instead.
+func (s *Store) ScheduleMessage(msg *models.Message, deliverAt time.Time) (*models.ScheduledMessage, error) {
+	if !s.IsParticipant(msg.SenderId) {
+		return nil, ErrUnauthorized
+	}
+
+	now := time.Now()
+	lead := deliverAt.Sub(now)
+	if lead < MinScheduleLead {
+		return nil, ErrScheduleTooSoon
+	}
+	if lead > MaxScheduleAhead {
+		return nil, ErrScheduleTooFar
+	}
+
+	// Rejections would be rejected again at delivery; say so now.
+	if msg.Content != "" {
+		if verdict := s.Moderate(msg.SenderId, msg.Content); verdict.Action == ModerationReject {
+			return nil, &ModerationError{Action: verdict.Action, Reason: verdict.Reason}
+		}
+	}
+
+	pending, err := s.ListScheduledMessages(msg.SenderId)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) >= MaxPendingScheduled {
+		return nil, ErrTooManyScheduled
+	}
+
+	stored := *msg
+	if err := s.sealForStorage(&stored); err != nil {
+		return nil, fmt.Errorf("failed to seal scheduled message: %w", err)
+	}
+
+	scheduled := &models.ScheduledMessage{
+		Id:        strings.ToUpper(utils.GenerateID(20)),
+		ChatId:    s.chatId,
+		SenderId:  msg.SenderId,
+		Message:   stored,
+		DeliverAt: deliverAt.UTC(),
+		Status:    ScheduledPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	scheduledORM := orm.Load(&models.ScheduledMessage{})
+	defer scheduledORM.Close()
+
+	if err := scheduledORM.Insert(scheduled); err != nil {
+		return nil, fmt.Errorf("failed to schedule message: %w", err)
+	}
+	scheduled.Message = *msg
+
+	if err := publishQStashJob(
+		config.GetEnvRaw("QSTASH_TOKEN"),
+		"/v1/chat/scheduled/deliver",
+		ScheduledDeliveryRequest{Id: scheduled.Id},
+		lead,
+		fmt.Sprintf("chat--%s--scheduled--%s", s.chatId, scheduled.Id),
+	); err != nil {
+		log.Printf("[%s] failed to queue scheduled message %s, leaving it to the sweep: %v", s.chatId, scheduled.Id, err)
+	}
+
+	return scheduled, nil
+}
+
+// ListScheduledMessages returns senderId's pending messages in this chat,
+// soonest first.
+func (s *Store) ListScheduledMessages(senderId string) ([]models.ScheduledMessage, error) {
+	if !s.IsParticipant(senderId) {
+		return nil, ErrUnauthorized
+	}
+
+	scheduledORM := orm.Load(&models.ScheduledMessage{})
+	defer scheduledORM.Close()
+
+	var rows []models.ScheduledMessage
+	if err := scheduledORM.GetByFieldEquals("ChatId", s.chatId).Scan(&rows); err != nil {
+		return nil, fmt.Errorf("failed to get scheduled messages: %w", err)
+	}
+
+	pending := make([]models.ScheduledMessage, 0, len(rows))
+	for _, row := range rows {
+		if row.SenderId == senderId && row.Status == ScheduledPending {
+			if err := OpenMessage(s.chatId, &row.Message); err != nil {
+				return nil, fmt.Errorf("failed to open scheduled message %s: %w", row.Id, err)
+			}
+			pending = append(pending, row)
+		}
+	}
+	slices.SortFunc(pending, func(a, b models.ScheduledMessage) int {
+		return a.DeliverAt.Compare(b.DeliverAt)
+	})
+
+	return pending, nil
+}
+
+// CancelScheduledMessage cancels one of senderId's pending messages. A
+// message already being delivered can no longer be cancelled.
+func (s *Store) CancelScheduledMessage(id string, senderId string) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	res, err := db.Exec(`
+		UPDATE scheduled_messages SET status = $4, updated_at = $5
+		WHERE id = $1 AND chat_id = $2 AND sender_id = $3 AND status = 'pending'
+	`, id, s.chatId, senderId, ScheduledCancelled, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled message: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrScheduledNotCancelled
+	}
+	return nil
+}
+
+// DeliverScheduledMessage sends a due scheduled message through SendMessage.
+// Claiming the row first means the QStash job and the sweep never both send
+// it. Transient failures put it back to pending so a later attempt retries.
+func DeliverScheduledMessage(id string) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	var (
+		chatId, senderId string
+		raw              []byte
+		attempts         int
+	)
+	err = db.QueryRow(`
+		UPDATE scheduled_messages
+		SET status = 'sending', attempts = attempts + 1, updated_at = $2
+		WHERE id = $1 AND status = 'pending' AND deliver_at <= $3
+		RETURNING chat_id, sender_id, message, attempts
+	`, id, time.Now(), time.Now().UTC().Add(scheduleSlack)).Scan(&chatId, &senderId, &raw, &attempts)
+	if err == sql.ErrNoRows {
+		// Cancelled, already handled or not due yet.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to claim scheduled message: %w", err)
+	}
+
+	finish := func(status string, reason string) {
+		if _, err := db.Exec(`
+			UPDATE scheduled_messages SET status = $2, error = $3, updated_at = $4 WHERE id = $1
+		`, id, status, reason, time.Now()); err != nil {
+			log.Printf("failed to record scheduled message %s as %s: %v", id, status, err)
+		}
+	}
+
+	var msg models.Message
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		finish(ScheduledFailed, "invalid message")
+		return fmt.Errorf("failed to decode scheduled message: %w", err)
+	}
+	// Moderation and subscribers need the content; SendMessage seals it again.
+	if err := OpenMessage(chatId, &msg); err != nil {
+		return retryScheduled(finish, attempts, err)
+	}
+
+	store, err := NewStore(chatId, senderId)
+	if err != nil {
+		if errors.Is(err, ErrUnauthorized) {
+			finish(ScheduledFailed, err.Error())
+			return nil
+		}
+		return retryScheduled(finish, attempts, err)
+	}
+	defer store.Close()
+
+	// A crash after sending but before recording it must not send twice.
+	if _, err := store.GetMessageById(msg.Id); err == nil {
+		finish(ScheduledSent, "")
+		return nil
+	}
+
+	now := time.Now()
+	msg.CreatedAt = now
+	msg.UpdatedAt = now
+
+	if err := store.SendMessage(&msg); err != nil {
+		var modErr *ModerationError
+		if errors.As(err, &modErr) {
+			switch {
+			case modErr.Delivered():
+				finish(ScheduledSent, "")
+			case modErr.Action == ModerationHold:
+				finish(ScheduledHeld, string(modErr.Reason))
+			default:
+				finish(ScheduledFailed, string(modErr.Reason))
+			}
+			return nil
+		}
+		if errors.Is(err, ErrReplyTargetNotFound) {
+			finish(ScheduledFailed, err.Error())
+			return nil
+		}
+		return retryScheduled(finish, attempts, err)
+	}
+
+	finish(ScheduledSent, "")
+	return nil
+}
+
+func retryScheduled(finish func(string, string), attempts int, cause error) error {
+	if attempts >= MaxScheduledDeliveryAttempts {
+		finish(ScheduledFailed, cause.Error())
+		return nil
+	}
+	finish(ScheduledPending, cause.Error())
+	return fmt.Errorf("failed to deliver scheduled message: %w", cause)
+}
+
+// DeliverDueScheduledMessages delivers every pending message whose time has
+// come. It backs up the per-message QStash jobs.
+func DeliverDueScheduledMessages() error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if _, err := db.Exec(`
+		UPDATE scheduled_messages SET status = 'pending'
+		WHERE status = 'sending' AND updated_at < $1
+	`, time.Now().Add(-staleSendingAfter)); err != nil {
+		log.Printf("failed to reclaim stale scheduled messages: %v", err)
+	}
+
+	var ids []string
+	err = db.Select(&ids, `
+		SELECT id FROM scheduled_messages
+		WHERE status = 'pending' AND deliver_at <= $1
+		ORDER BY deliver_at
+		LIMIT 100
+	`, time.Now().UTC())
+	db.Close()
+	if err != nil {
+		return fmt.Errorf("failed to get due scheduled messages: %w", err)
+	}
+
+	for _, id := range ids {
+		if err := DeliverScheduledMessage(id); err != nil {
+			log.Printf("scheduled message %s: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// RunScheduler sweeps for due scheduled messages and missed check-ins, and
+// less often for chats to archive, until ctx is done.
+func RunScheduler(ctx context.Context) {
+	ticker := time.NewTicker(SchedulerInterval)
+	defer ticker.Stop()
+
+	var lastArchive time.Time
+	for {
+		if err := DeliverDueScheduledMessages(); err != nil {
+			log.Printf("scheduled message sweep failed: %v", err)
+		}
+		if err := AlertMissedCheckIns(); err != nil {
+			log.Printf("check-in sweep failed: %v", err)
+		}
+		if time.Since(lastArchive) >= ArchiveInterval {
+			lastArchive = time.Now()
+			if _, err := ArchiveOldChats(); err != nil {
+				log.Printf("archive sweep failed: %v", err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
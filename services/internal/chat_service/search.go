@@ -0,0 +1,188 @@
+package chatservice
+
+import (
+	"blindly/internal/models"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 50
+	DefaultContextSize = 10
+
+	snippetRadius = 40
+)
+
+// Highlight marks a matched term inside a snippet, as rune offsets.
+type Highlight struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+type SearchHit struct {
+	Message    models.Message `json:"message"`
+	Snippet    string         `json:"snippet"`
+	Highlights []Highlight    `json:"highlights"`
+}
+
+type SearchResult struct {
+	Hits       []SearchHit `json:"hits"`
+	Total      int         `json:"total"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+}
+
+// SearchMessages runs a case-insensitive search over the chat's flushed and
+// buffered history. Every query term must appear in a message for it to match.
+// Hits are ordered newest first; cursor is the id of the last hit of the
+// previous page.
+func (s *Store) SearchMessages(query string, limit int, cursor string) (*SearchResult, error) {
+	if !s.IsParticipant(s.userId) {
+		return nil, ErrUnauthorized
+	}
+
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("search query is empty")
+	}
+
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	limit = min(limit, MaxSearchLimit)
+
+	messages, err := s.GetMessages(0, "")
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]SearchHit, 0)
+	for i := len(messages) - 1; i >= 0; i-- {
+		if hit, ok := matchMessage(messages[i], terms); ok {
+			hits = append(hits, hit)
+		}
+	}
+
+	result := &SearchResult{Total: len(hits)}
+
+	start := 0
+	if cursor != "" {
+		idx := slices.IndexFunc(hits, func(h SearchHit) bool { return h.Message.Id == cursor })
+		if idx == -1 {
+			return nil, fmt.Errorf("invalid search cursor: %s", cursor)
+		}
+		start = idx + 1
+	}
+
+	end := min(start+limit, len(hits))
+	result.Hits = hits[start:end]
+	if end < len(hits) {
+		result.NextCursor = hits[end-1].Message.Id
+	}
+
+	return result, nil
+}
+
+// GetMessageContext returns up to before messages preceding messageId, the
+// message itself and up to after messages following it, so a client can jump
+// from a search hit into the conversation.
+func (s *Store) GetMessageContext(messageId string, before int, after int) ([]models.Message, error) {
+	if !s.IsParticipant(s.userId) {
+		return nil, ErrUnauthorized
+	}
+
+	if before < 0 || after < 0 {
+		return nil, fmt.Errorf("context size cannot be negative")
+	}
+
+	messages, err := s.GetMessages(0, "")
+	if err != nil {
+		return nil, err
+	}
+
+	idx := slices.IndexFunc(messages, func(m models.Message) bool { return m.Id == messageId })
+	if idx == -1 {
+		return nil, fmt.Errorf("message not found: %s", messageId)
+	}
+
+	from := max(idx-before, 0)
+	to := min(idx+after+1, len(messages))
+
+	return messages[from:to], nil
+}
+
+func searchTerms(query string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	terms := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if !slices.Contains(terms, f) {
+			terms = append(terms, f)
+		}
+	}
+	return terms
+}
+
+// matchMessage reports whether every term occurs in the message content and
+// builds a highlighted snippet around the first occurrence.
+func matchMessage(msg models.Message, terms []string) (SearchHit, bool) {
+	content := []rune(msg.Content)
+	lower := make([]rune, len(content))
+	for i, r := range content {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	var matches []Highlight
+	for _, term := range terms {
+		found := findAll(lower, []rune(term))
+		if len(found) == 0 {
+			return SearchHit{}, false
+		}
+		matches = append(matches, found...)
+	}
+
+	slices.SortFunc(matches, func(a, b Highlight) int { return a.Start - b.Start })
+
+	from := max(matches[0].Start-snippetRadius, 0)
+	to := min(matches[0].End+snippetRadius, len(content))
+
+	var snippet strings.Builder
+	offset := from
+	if from > 0 {
+		snippet.WriteString("…")
+		offset--
+	}
+	snippet.WriteString(string(content[from:to]))
+	if to < len(content) {
+		snippet.WriteString("…")
+	}
+
+	highlights := make([]Highlight, 0, len(matches))
+	for _, m := range matches {
+		if m.Start < from || m.End > to {
+			continue
+		}
+		highlights = append(highlights, Highlight{Start: m.Start - offset, End: m.End - offset})
+	}
+
+	return SearchHit{
+		Message:    msg,
+		Snippet:    snippet.String(),
+		Highlights: highlights,
+	}, true
+}
+
+func findAll(haystack []rune, needle []rune) []Highlight {
+	var found []Highlight
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		if slices.Equal(haystack[i:i+len(needle)], needle) {
+			found = append(found, Highlight{Start: i, End: i + len(needle)})
+			i += len(needle) - 1
+		}
+	}
+	return found
+}
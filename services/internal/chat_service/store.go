@@ -10,17 +10,22 @@ import (
 	"slices"
 	"time"
 
-	"github.com/MelloB1989/karma/config"
 	"github.com/MelloB1989/karma/utils"
 	"github.com/MelloB1989/karma/v2/orm"
-	"github.com/redis/go-redis/v9"
 )
 
-var ErrUnauthorized = errors.New("unauthorized: user is not a participant of this chat")
+var (
+	ErrUnauthorized        = errors.New("unauthorized: user is not a participant of this chat")
+	ErrReplyTargetNotFound = errors.New("replied message not found in this chat")
+	ErrSystemMessage       = errors.New("system messages can only be sent by the server")
+)
 
 const (
 	BatchSize   = 50
 	IdleTimeout = 60 * time.Second
+
+	// ReplyPreviewLength caps the quoted content stored with a reply.
+	ReplyPreviewLength = 120
 )
 
 var ctx = context.Background()
@@ -37,30 +42,59 @@ func chatActiveKey() string { return "blindly:chat:active" }
 type MessageEvents string
 
 const (
-	MessageEventMessage MessageEvents = "message"
-	MessageEventUpdate  MessageEvents = "update"
-	MessageEventSeen    MessageEvents = "seen"
-	MessageEventTyping  MessageEvents = "typing"
+	MessageEventMessage  MessageEvents = "message"
+	MessageEventUpdate   MessageEvents = "update"
+	MessageEventSeen     MessageEvents = "seen"
+	MessageEventReceived MessageEvents = "received"
+	MessageEventTyping   MessageEvents = "typing"
+	MessageEventPresence MessageEvents = "presence"
+	MessageEventCall     MessageEvents = "call"
+)
+
+// MessageChange says what an update event changed about its message.
+type MessageChange string
+
+const (
+	ChangeEdit       MessageChange = "edit"
+	ChangeReaction   MessageChange = "reaction"
+	ChangeDateAnswer MessageChange = "date_answer"
+	ChangeViewOnce   MessageChange = "view_once"
 )
 
 type Store struct {
 	chatId       string
 	userId       string
+	matchId      string
 	participants []string
-	rc           *redis.Client
+	unlocked     bool
+	origin       string
+	moderation   *ModerationChain
+	backend      Backend
 }
 
 type PubSubEvent struct {
 	Type    MessageEvents   `json:"type"`
 	Message *models.Message `json:"message,omitempty"`
 	Data    json.RawMessage `json:"data,omitempty"`
+	// Change accompanies update events.
+	Change MessageChange `json:"change,omitempty"`
+	// Origin is the connection that produced the event, empty for server-side
+	// and GraphQL writes.
+	Origin string `json:"origin,omitempty"`
+}
+
+// IsEcho reports whether the event was produced by connection connId, which
+// already applied it locally. Other connections of the same user still need it.
+func (e *PubSubEvent) IsEcho(connId string) bool {
+	return connId != "" && e.Origin == connId
 }
 
 func NewStore(chatId string, userId string) (*Store, error) {
 	s := &Store{
-		chatId: chatId,
-		userId: userId,
-		rc:     utils.RedisConnect(),
+		chatId:     chatId,
+		userId:     userId,
+		moderation: DefaultModeration,
+		backend:    openBackend(),
 	}
 
 	if err := s.loadParticipants(); err != nil {
@@ -79,13 +113,14 @@ func NewStore(chatId string, userId string) (*Store, error) {
 // NewStoreWithoutAuth creates a store without authorization check (for internal use like flush)
 func NewStoreWithoutAuth(chatId string) *Store {
 	return &Store{
-		chatId: chatId,
-		rc:     utils.RedisConnect(),
+		chatId:     chatId,
+		moderation: DefaultModeration,
+		backend:    openBackend(),
 	}
 }
 
 func (s *Store) loadParticipants() error {
-	s.ensureRedis()
+	s.ensureBackend()
 
 	chat, err := s.GetChat()
 	if err != nil {
@@ -105,7 +140,9 @@ func (s *Store) loadParticipants() error {
 	}
 
 	match := matches[0]
+	s.matchId = match.Id
 	s.participants = []string{match.SheId, match.HeId}
+	s.unlocked = match.IsUnlocked
 
 	return nil
 }
@@ -118,25 +155,53 @@ func (s *Store) GetParticipants() []string {
 	return s.participants
 }
 
+// SetOrigin tags every event this store publishes with the connection that
+// owns it, so subscribers can drop only that connection's echo.
+func (s *Store) SetOrigin(connId string) {
+	s.origin = connId
+}
+
 func (s *Store) GetUserId() string {
 	return s.userId
 }
 
-func (s *Store) ensureRedis() {
-	if s.rc == nil {
-		s.rc = utils.RedisConnect()
+// SetModeration replaces the moderation chain for this store; nil disables it.
+func (s *Store) SetModeration(chain *ModerationChain) {
+	s.moderation = chain
+}
+
+// Moderate runs content from senderId through the store's moderation chain.
+func (s *Store) Moderate(senderId string, content string) ModerationVerdict {
+	return s.moderation.Run(ModerationContext{
+		ChatId:   s.chatId,
+		SenderId: senderId,
+		Unlocked: s.unlocked,
+	}, content)
+}
+
+func (s *Store) ensureBackend() {
+	if s.backend == nil {
+		s.backend = openBackend()
 	}
 }
 
 func (s *Store) Close() error {
-	if s.rc != nil {
-		return s.rc.Close()
+	if s.backend != nil {
+		return s.backend.Close()
 	}
 	return nil
 }
 
 func (s *Store) SendMessage(msg *models.Message) error {
-	s.ensureRedis()
+	s.ensureBackend()
+
+	system := msg.SenderId == models.SystemSenderId
+	if (msg.Type == models.SYSTEM) != system {
+		return ErrSystemMessage
+	}
+	if (msg.Type == models.CALL) != (msg.Call != nil) {
+		return ErrCallLogMessage
+	}
 
 	if msg.Id == "" {
 		msg.Id = utils.GenerateID()
@@ -146,82 +211,172 @@ func (s *Store) SendMessage(msg *models.Message) error {
 	}
 	msg.UpdatedAt = msg.CreatedAt
 
-	msgJSON, err := json.Marshal(msg)
-	if err != nil {
-		return fmt.Errorf("failed to marshal message: %w", err)
+	if msg.ReplyToId != "" {
+		preview, err := s.replyPreview(msg.ReplyToId)
+		if err != nil {
+			return err
+		}
+		msg.ReplyTo = preview
 	}
 
-	pipe := s.rc.Pipeline()
+	var modErr *ModerationError
+	if msg.Type == models.DATE_PROPOSAL {
+		masked, err := s.prepareDateProposal(msg)
+		if err != nil {
+			return err
+		}
+		modErr = masked
+	} else {
+		msg.DateProposal = nil
+	}
+
+	if msg.Content != "" && !system {
+		verdict := s.Moderate(msg.SenderId, msg.Content)
+		switch verdict.Action {
+		case ModerationReject:
+			return &ModerationError{Action: verdict.Action, Reason: verdict.Reason}
+		case ModerationHold:
+			if err := s.holdMessage(msg, verdict.Reason); err != nil {
+				return err
+			}
+			return &ModerationError{Action: verdict.Action, Reason: verdict.Reason}
+		case ModerationMask:
+			msg.Content = verdict.Content
+			modErr = &ModerationError{Action: verdict.Action, Reason: verdict.Reason}
+		}
+	}
 
-	msgsKey := chatMsgsKey(s.chatId)
-	pipe.RPush(ctx, msgsKey, msgJSON)
+	// Only the buffered copy is sealed; subscribers get the message as sent.
+	stored := *msg
+	if err := s.sealForStorage(&stored); err != nil {
+		return fmt.Errorf("failed to seal message: %w", err)
+	}
+	msgJSON, err := json.Marshal(&stored)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
 
 	pubEvent := PubSubEvent{
 		Type:    MessageEventMessage,
 		Message: msg,
+		Origin:  s.origin,
 	}
 	pubJSON, _ := json.Marshal(pubEvent)
-	pipe.Publish(ctx, chatPubKey(s.chatId), pubJSON)
 
-	metaKey := chatMetaKey(s.chatId)
-	now := time.Now().Unix()
-	pipe.HSet(ctx, metaKey, "last_activity_ts", now)
-
-	pipe.ZAdd(ctx, chatActiveKey(), redis.Z{
-		Score:  float64(now),
-		Member: s.chatId,
-	})
+	if err := s.backend.AppendMessage(s.chatId, string(msgJSON), string(pubJSON), time.Now()); err != nil {
+		return err
+	}
 
-	_, err = pipe.Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("redis pipeline failed: %w", err)
+	if err := s.scheduleFlush(); err != nil {
+		return err
 	}
 
-	return s.scheduleFlush()
+	if modErr != nil {
+		return modErr
+	}
+	return nil
 }
 
-func (s *Store) GetMessages(limit int, beforeId string) ([]models.Message, error) {
-	s.ensureRedis()
+// holdMessage parks a message for manual review instead of delivering it.
+func (s *Store) holdMessage(msg *models.Message, reason ModerationReason) error {
+	heldORM := orm.Load(&models.HeldMessage{})
+	defer heldORM.Close()
 
-	chat, err := s.GetChat()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get chat: %w", err)
+	stored := *msg
+	if err := s.sealForStorage(&stored); err != nil {
+		return fmt.Errorf("failed to seal held message: %w", err)
 	}
 
-	bufferedMsgs, err := s.getBufferedMessages()
+	now := time.Now()
+	held := &models.HeldMessage{
+		Id:        utils.GenerateID(),
+		ChatId:    s.chatId,
+		SenderId:  msg.SenderId,
+		Message:   stored,
+		Reason:    string(reason),
+		Status:    "pending",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := heldORM.Insert(held); err != nil {
+		return fmt.Errorf("failed to hold message: %w", err)
+	}
+	return nil
+}
+
+// replyPreview snapshots the message being replied to. Lookups are scoped to
+// this chat, so a reply can never quote another conversation.
+func (s *Store) replyPreview(messageId string) (*models.MessagePreview, error) {
+	target, err := s.GetMessageById(messageId)
 	if err != nil {
-		log.Printf("failed to get buffered messages: %v", err)
-		bufferedMsgs = []models.Message{}
+		return nil, ErrReplyTargetNotFound
 	}
 
-	allMessages := append(chat.Messages, bufferedMsgs...)
+	content := target.Content
+	if runes := []rune(content); len(runes) > ReplyPreviewLength {
+		content = string(runes[:ReplyPreviewLength]) + "…"
+	}
+
+	return &models.MessagePreview{
+		Id:       target.Id,
+		SenderId: target.SenderId,
+		Content:  content,
+		Type:     target.Type,
+	}, nil
+}
+
+// GetMessages returns up to limit messages preceding beforeId, or the latest
+// ones without it, oldest first. A limit of zero returns the whole history.
+// Pages reaching past the chats row continue into the archive.
+func (s *Store) GetMessages(limit int, beforeId string) ([]models.Message, error) {
+	s.ensureBackend()
+
+	messages, err := s.hotMessages()
+	if err != nil {
+		return nil, err
+	}
 
 	if beforeId != "" {
-		filtered := make([]models.Message, 0)
-		found := false
-		for _, msg := range allMessages {
-			if msg.Id == beforeId {
-				found = true
-				continue
+		idx := slices.IndexFunc(messages, func(m models.Message) bool { return m.Id == beforeId })
+		if idx != -1 {
+			messages = messages[:idx]
+		} else {
+			page, err := s.archivedPageBefore(limit, beforeId)
+			if err != nil {
+				return nil, err
 			}
-			if found {
-				filtered = append(filtered, msg)
+			if page != nil {
+				return page, nil
 			}
+			// Unknown ids get the latest page.
 		}
-		if found {
-			allMessages = filtered
-		}
 	}
 
-	if limit > 0 && len(allMessages) > limit {
-		allMessages = allMessages[len(allMessages)-limit:]
+	if limit > 0 && len(messages) >= limit {
+		return messages[len(messages)-limit:], nil
+	}
+	return s.prependArchived(messages, limit, allArchived)
+}
+
+// hotMessages returns the flushed and buffered messages, leaving out the
+// archive.
+func (s *Store) hotMessages() ([]models.Message, error) {
+	chat, err := s.GetChat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat: %w", err)
+	}
+
+	bufferedMsgs, err := s.getBufferedMessages()
+	if err != nil {
+		log.Printf("failed to get buffered messages: %v", err)
+		bufferedMsgs = []models.Message{}
 	}
 
-	return allMessages, nil
+	return append(chat.Messages, bufferedMsgs...), nil
 }
 
 func (s *Store) GetMessageById(messageId string) (*models.Message, error) {
-	s.ensureRedis()
+	s.ensureBackend()
 
 	bufferedMsgs, err := s.getBufferedMessages()
 	if err == nil {
@@ -243,15 +398,25 @@ func (s *Store) GetMessageById(messageId string) (*models.Message, error) {
 		}
 	}
 
+	archived, err := s.archivedMessage(messageId)
+	if err != nil {
+		return nil, err
+	}
+	if archived != nil {
+		return archived, nil
+	}
+
 	return nil, fmt.Errorf("message not found: %s", messageId)
 }
 
-func (s *Store) UpdateMessage(messageId string, updates *models.Message) (*models.Message, error) {
-	s.ensureRedis()
+// UpdateMessage applies updates to a message, buffered or flushed, and
+// publishes it as an update of kind change.
+func (s *Store) UpdateMessage(messageId string, updates *models.Message, change MessageChange) (*models.Message, error) {
+	s.ensureBackend()
 
 	updated, err := s.updateMessageInBuffer(messageId, updates)
 	if err == nil && updated != nil {
-		s.publishUpdateEvent(updated)
+		s.publishUpdateEvent(updated, change)
 		return updated, nil
 	}
 
@@ -260,18 +425,20 @@ func (s *Store) UpdateMessage(messageId string, updates *models.Message) (*model
 		return nil, fmt.Errorf("failed to update message: %w", err)
 	}
 
-	s.publishUpdateEvent(updated)
+	s.publishUpdateEvent(updated, change)
 
 	return updated, nil
 }
 
-func (s *Store) publishUpdateEvent(msg *models.Message) {
+func (s *Store) publishUpdateEvent(msg *models.Message, change MessageChange) {
 	event := PubSubEvent{
 		Type:    MessageEventUpdate,
 		Message: msg,
+		Change:  change,
+		Origin:  s.origin,
 	}
 	eventJSON, _ := json.Marshal(event)
-	s.rc.Publish(ctx, chatPubKey(s.chatId), eventJSON)
+	s.backend.Publish(string(eventJSON), s.chatId)
 }
 
 type TypingEvent struct {
@@ -281,10 +448,11 @@ type TypingEvent struct {
 }
 
 func (s *Store) SendTypingEvent(userId string) error {
-	s.ensureRedis()
+	s.ensureBackend()
 
 	event := PubSubEvent{
-		Type: MessageEventTyping,
+		Type:   MessageEventTyping,
+		Origin: s.origin,
 	}
 	data, _ := json.Marshal(TypingEvent{
 		UserId:    userId,
@@ -294,14 +462,15 @@ func (s *Store) SendTypingEvent(userId string) error {
 	event.Data = data
 	eventJSON, _ := json.Marshal(event)
 
-	return s.rc.Publish(ctx, chatPubKey(s.chatId), eventJSON).Err()
+	return s.backend.Publish(string(eventJSON), s.chatId)
 }
 
 func (s *Store) StopTypingEvent(userId string) error {
-	s.ensureRedis()
+	s.ensureBackend()
 
 	event := PubSubEvent{
-		Type: MessageEventTyping,
+		Type:   MessageEventTyping,
+		Origin: s.origin,
 	}
 	data, _ := json.Marshal(TypingEvent{
 		UserId:    userId,
@@ -311,39 +480,97 @@ func (s *Store) StopTypingEvent(userId string) error {
 	event.Data = data
 	eventJSON, _ := json.Marshal(event)
 
-	return s.rc.Publish(ctx, chatPubKey(s.chatId), eventJSON).Err()
+	return s.backend.Publish(string(eventJSON), s.chatId)
 }
 
+// ReceiptEvent is the payload of seen and received events.
+type ReceiptEvent struct {
+	MessageIds []string  `json:"message_ids"`
+	UserId     string    `json:"user_id"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// MarkMessagesSeen flags buffered messages as seen, advances userId's read
+// cursor past the latest of them and publishes a seen receipt.
 func (s *Store) MarkMessagesSeen(messageIds []string, userId string) error {
-	s.ensureRedis()
+	s.ensureBackend()
 
 	for _, msgId := range messageIds {
 		s.updateMessageInBuffer(msgId, &models.Message{Seen: true, Received: true})
 	}
 
-	event := PubSubEvent{
-		Type: MessageEventSeen,
+	if _, err := s.advanceReadCursor(messageIds, userId); err != nil {
+		log.Printf("[%s] failed to advance read cursor for %s: %v", s.chatId, userId, err)
 	}
-	data, _ := json.Marshal(map[string]any{
-		"message_ids": messageIds,
-		"user_id":     userId,
-		"timestamp":   time.Now(),
+
+	return s.publishReceipt(MessageEventSeen, messageIds, userId)
+}
+
+// MarkMessagesReceived flags messages as delivered to userId, in the buffer or
+// in flushed history, and publishes a single received event for the ones that
+// were not already marked.
+func (s *Store) MarkMessagesReceived(messageIds []string, userId string) error {
+	s.ensureBackend()
+
+	if len(messageIds) == 0 {
+		return nil
+	}
+
+	found, changed, err := s.markReceivedInBuffer(messageIds, userId)
+	if err != nil {
+		return fmt.Errorf("failed to mark buffered messages received: %w", err)
+	}
+
+	remaining := make([]string, 0, len(messageIds))
+	for _, id := range messageIds {
+		if !slices.Contains(found, id) {
+			remaining = append(remaining, id)
+		}
+	}
+
+	if len(remaining) > 0 {
+		flushed, err := s.markReceivedInDB(remaining, userId)
+		if err != nil {
+			return fmt.Errorf("failed to mark messages received: %w", err)
+		}
+		changed = append(changed, flushed...)
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	return s.publishReceipt(MessageEventReceived, changed, userId)
+}
+
+func (s *Store) publishReceipt(eventType MessageEvents, messageIds []string, userId string) error {
+	data, _ := json.Marshal(ReceiptEvent{
+		MessageIds: messageIds,
+		UserId:     userId,
+		Timestamp:  time.Now(),
 	})
-	event.Data = data
+	event := PubSubEvent{
+		Type:   eventType,
+		Data:   data,
+		Origin: s.origin,
+	}
 	eventJSON, _ := json.Marshal(event)
-	s.rc.Publish(ctx, chatPubKey(s.chatId), eventJSON)
 
-	return nil
+	return s.backend.Publish(string(eventJSON), s.chatId)
 }
 
 func (s *Store) GetChat() (*models.Chat, error) {
-	s.ensureRedis()
+	s.ensureBackend()
 
+	// The cache holds the chat as stored, so messages stay sealed in Redis.
 	key := chatCacheKey(s.chatId)
-	data, err := s.rc.Get(ctx, key).Bytes()
+	data, err := s.backend.Get(key)
 	if err == nil && len(data) > 0 {
 		var chat models.Chat
-		if err := json.Unmarshal(data, &chat); err != nil {
+		if err := json.Unmarshal([]byte(data), &chat); err != nil {
+			return nil, err
+		}
+		if err := s.openAll(chat.Messages); err != nil {
 			return nil, err
 		}
 		return &chat, nil
@@ -364,19 +591,22 @@ func (s *Store) GetChat() (*models.Chat, error) {
 	if err != nil {
 		return nil, err
 	}
-	if err := s.rc.Set(ctx, key, marshaled, 5*time.Minute).Err(); err != nil {
-		log.Printf("redis set cache failed: %v", err)
+	if err := s.backend.Set(key, string(marshaled), 5*time.Minute); err != nil {
+		log.Printf("failed to cache chat: %v", err)
 	}
 
+	if err := s.openAll(c[0].Messages); err != nil {
+		return nil, err
+	}
 	return &c[0], nil
 }
 
 func (s *Store) FlushMessages(flushToken string) error {
-	s.ensureRedis()
+	s.ensureBackend()
 
 	tokenKey := chatFlushTokenKey(s.chatId)
-	currentToken, err := s.rc.Get(ctx, tokenKey).Result()
-	if err != nil && err != redis.Nil {
+	currentToken, err := s.backend.Get(tokenKey)
+	if err != nil {
 		return fmt.Errorf("failed to get flush token: %w", err)
 	}
 
@@ -385,23 +615,11 @@ func (s *Store) FlushMessages(flushToken string) error {
 		return nil
 	}
 
-	msgsKey := chatMsgsKey(s.chatId)
-
-	luaScript := redis.NewScript(`
-		local msgs = redis.call('LRANGE', KEYS[1], 0, ARGV[1] - 1)
-		if #msgs > 0 then
-			redis.call('LTRIM', KEYS[1], ARGV[1], -1)
-		end
-		return msgs
-	`)
-
-	result, err := luaScript.Run(ctx, s.rc, []string{msgsKey}, BatchSize).Result()
+	msgStrings, err := s.backend.PopBuffered(s.chatId, BatchSize)
 	if err != nil {
 		return fmt.Errorf("failed to pop messages: %w", err)
 	}
-
-	msgStrings, ok := result.([]any)
-	if !ok || len(msgStrings) == 0 {
+	if len(msgStrings) == 0 {
 		return nil
 	}
 
@@ -409,13 +627,11 @@ func (s *Store) FlushMessages(flushToken string) error {
 	messages := make([]models.Message, 0, len(msgStrings))
 	for _, msgStr := range msgStrings {
 		var msg models.Message
-		if str, ok := msgStr.(string); ok {
-			if err := json.Unmarshal([]byte(str), &msg); err != nil {
-				log.Printf("failed to unmarshal message: %v", err)
-				continue
-			}
-			messages = append(messages, msg)
+		if err := json.Unmarshal([]byte(msgStr), &msg); err != nil {
+			log.Printf("failed to unmarshal message: %v", err)
+			continue
 		}
+		messages = append(messages, msg)
 	}
 
 	if len(messages) == 0 {
@@ -430,21 +646,20 @@ func (s *Store) FlushMessages(flushToken string) error {
 	}
 
 	newToken := utils.GenerateID()
-	s.rc.Set(ctx, tokenKey, newToken, IdleTimeout+10*time.Second)
+	s.backend.Set(tokenKey, newToken, IdleTimeout+10*time.Second)
 
-	remaining, err := s.rc.LLen(ctx, msgsKey).Result()
+	remaining, err := s.backend.BufferLen(s.chatId)
 	if err != nil {
 		log.Printf("failed to check remaining messages: %v", err)
 		return nil
 	}
 
 	if remaining > 0 {
-		bearer := config.GetEnvRaw("QSTASH_TOKEN")
 		delay := time.Duration(0)
 		if remaining < BatchSize {
 			delay = IdleTimeout
 		}
-		if err := publishQStashFlush(bearer, s.chatId, delay, newToken); err != nil {
+		if err := s.publishFlush(delay, newToken); err != nil {
 			log.Printf("failed to schedule follow-up flush: %v", err)
 		}
 	}
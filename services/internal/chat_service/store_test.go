@@ -1,79 +1,44 @@
 package chatservice
 
 import (
+	"blindly/internal/mailer"
 	"blindly/internal/models"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 )
 
-type MockRedisClient struct {
-	data     map[string]any
-	lists    map[string][]string
-	pubsub   map[string][]string
-	mu       sync.RWMutex
-	subChans map[string]chan string
+// memoryStore returns a store for chatId on a fresh memory backend, shared
+// with every store and helper the test opens.
+func memoryStore(t *testing.T, chatId string) (*Store, Backend) {
+	t.Helper()
+	backend := NewMemoryBackend()
+	UseBackend(backend)
+	t.Cleanup(func() { UseBackend(nil) })
+
+	s := NewStoreWithoutAuth(chatId)
+	t.Cleanup(func() { s.Close() })
+	return s, backend
 }
 
-func NewMockRedis() *MockRedisClient {
-	return &MockRedisClient{
-		data:     make(map[string]any),
-		lists:    make(map[string][]string),
-		pubsub:   make(map[string][]string),
-		subChans: make(map[string]chan string),
-	}
-}
-
-func (m *MockRedisClient) Publish(channel string, message string) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	m.pubsub[channel] = append(m.pubsub[channel], message)
-	if ch, ok := m.subChans[channel]; ok {
-		select {
-		case ch <- message:
-		default:
+// bufferMessages appends messages to the chat's buffer the way SendMessage
+// does, without scheduling a flush.
+func bufferMessages(t *testing.T, backend Backend, chatId string, messages ...models.Message) {
+	t.Helper()
+	for _, msg := range messages {
+		data, _ := json.Marshal(msg)
+		event, _ := json.Marshal(PubSubEvent{Type: MessageEventMessage, Message: &msg})
+		if err := backend.AppendMessage(chatId, string(data), string(event), msg.CreatedAt); err != nil {
+			t.Fatalf("AppendMessage failed: %v", err)
 		}
 	}
 }
 
-func (m *MockRedisClient) Subscribe(channel string) chan string {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	ch := make(chan string, 100)
-	m.subChans[channel] = ch
-	return ch
-}
-
-func (m *MockRedisClient) RPush(key string, value string) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	m.lists[key] = append(m.lists[key], value)
-}
-
-func (m *MockRedisClient) LRange(key string, start, stop int) []string {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-	list := m.lists[key]
-	if start < 0 {
-		start = 0
-	}
-	if stop < 0 || stop >= len(list) {
-		stop = len(list) - 1
-	}
-	if start > stop || start >= len(list) {
-		return []string{}
-	}
-	return list[start : stop+1]
-}
-
-func (m *MockRedisClient) GetPublishedMessages(channel string) []string {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-	return m.pubsub[channel]
-}
-
 func TestMessageCreation(t *testing.T) {
 	msg := &models.Message{
 		Id:        "test-msg-001",
@@ -327,6 +292,49 @@ func TestMessageWithReactions(t *testing.T) {
 	}
 }
 
+func TestMessageWithReplyPreview(t *testing.T) {
+	msg := &models.Message{
+		Id:        "test-msg-011",
+		SenderId:  "user-005",
+		Content:   "Replying to you",
+		Type:      models.TEXT,
+		ReplyToId: "test-msg-006",
+		ReplyTo: &models.MessagePreview{
+			Id:       "test-msg-006",
+			SenderId: "user-006",
+			Content:  "Original message",
+			Type:     models.TEXT,
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	t.Logf("DEBUG: Serialized reply: %s", string(data))
+
+	var decoded models.Message
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if decoded.ReplyToId != msg.ReplyToId {
+		t.Errorf("ReplyToId mismatch: expected %s, got %s", msg.ReplyToId, decoded.ReplyToId)
+	}
+	if decoded.ReplyTo == nil || decoded.ReplyTo.SenderId != "user-006" {
+		t.Errorf("Expected reply preview from user-006, got %+v", decoded.ReplyTo)
+	}
+
+	plain, _ := json.Marshal(&models.Message{Id: "test-msg-012"})
+	var fields map[string]any
+	json.Unmarshal(plain, &fields)
+	if _, ok := fields["reply_to"]; ok {
+		t.Errorf("reply_to should be omitted when the message is not a reply")
+	}
+}
+
 func TestConcurrentMessageCreation(t *testing.T) {
 	var wg sync.WaitGroup
 	messages := make([]*models.Message, 100)
@@ -391,83 +399,121 @@ func TestConcurrentMessageCreation(t *testing.T) {
 	}
 }
 
-func TestMockRedisPubSub(t *testing.T) {
-	redis := NewMockRedis()
-	channel := "test-channel"
+func TestStorePubSub(t *testing.T) {
+	s, _ := memoryStore(t, "chat-pubsub")
+	s.SetOrigin("conn-1")
 
-	t.Log("DEBUG: Testing mock Redis pub/sub")
-
-	subChan := redis.Subscribe(channel)
-	t.Logf("DEBUG: Subscribed to channel: %s", channel)
-
-	go func() {
-		time.Sleep(10 * time.Millisecond)
-		redis.Publish(channel, "test-message-1")
-		t.Log("DEBUG: Published message 1")
-		redis.Publish(channel, "test-message-2")
-		t.Log("DEBUG: Published message 2")
-	}()
+	sub := s.Subscribe()
+	other := NewStoreWithoutAuth("chat-other")
+	defer other.Close()
 
-	received := make([]string, 0)
-	timeout := time.After(100 * time.Millisecond)
+	if err := other.SendTypingEvent("user-2"); err != nil {
+		t.Fatalf("SendTypingEvent failed: %v", err)
+	}
+	if err := s.SendTypingEvent("user-1"); err != nil {
+		t.Fatalf("SendTypingEvent failed: %v", err)
+	}
+	if err := s.StopTypingEvent("user-1"); err != nil {
+		t.Fatalf("StopTypingEvent failed: %v", err)
+	}
 
-	for i := 0; i < 2; i++ {
-		select {
-		case msg := <-subChan:
-			t.Logf("DEBUG: Received: %s", msg)
-			received = append(received, msg)
-		case <-timeout:
-			t.Log("DEBUG: Timeout waiting for messages")
-			break
+	for _, want := range []bool{true, false} {
+		event, err := sub.ReceiveEvent()
+		if err != nil {
+			t.Fatalf("ReceiveEvent failed: %v", err)
+		}
+		var typing TypingEvent
+		json.Unmarshal(event.Data, &typing)
+		if event.Type != MessageEventTyping || typing.UserId != "user-1" || typing.IsTyping != want {
+			t.Errorf("expected typing=%v from user-1, got %+v / %+v", want, event, typing)
+		}
+		if !event.IsEcho("conn-1") {
+			t.Errorf("expected the event to carry its origin, got %q", event.Origin)
 		}
 	}
 
-	t.Logf("DEBUG: Total received: %d messages", len(received))
-
-	if len(received) != 2 {
-		t.Errorf("Expected 2 messages, got %d", len(received))
+	sub.Close()
+	if _, err := sub.ReceiveEvent(); err == nil {
+		t.Error("expected a closed subscription to stop")
 	}
-
-	published := redis.GetPublishedMessages(channel)
-	t.Logf("DEBUG: Published messages in store: %d", len(published))
-
-	if len(published) != 2 {
-		t.Errorf("Expected 2 published messages in store, got %d", len(published))
+	if err := sub.Close(); err != nil {
+		t.Errorf("expected closing twice to be harmless, got %v", err)
 	}
 }
 
-func TestMockRedisList(t *testing.T) {
-	redis := NewMockRedis()
-	key := "test-list"
-
-	t.Log("DEBUG: Testing mock Redis list operations")
+func TestStoreBuffer(t *testing.T) {
+	s, backend := memoryStore(t, "chat-buffer")
+	now := time.Now()
+	bufferMessages(t, backend, "chat-buffer",
+		models.Message{Id: "m1", SenderId: "user-1", Content: "hi", CreatedAt: now},
+		models.Message{Id: "m2", SenderId: "user-2", Content: "hey", CreatedAt: now},
+		models.Message{Id: "m3", SenderId: "user-1", Content: "how are you?", CreatedAt: now},
+	)
 
-	for i := 0; i < 5; i++ {
-		msg := fmt.Sprintf("message-%d", i)
-		redis.RPush(key, msg)
-		t.Logf("DEBUG: Pushed: %s", msg)
+	updated, err := s.updateMessageInBuffer("m2", &models.Message{Content: "hello"})
+	if err != nil || updated == nil || updated.Content != "hello" {
+		t.Fatalf("expected the buffered message to be edited, got %+v, %v", updated, err)
+	}
+	if missing, err := s.updateMessageInBuffer("flushed", &models.Message{Content: "x"}); missing != nil || err != nil {
+		t.Errorf("expected no update for an unbuffered message, got %+v, %v", missing, err)
 	}
 
-	all := redis.LRange(key, 0, -1)
-	t.Logf("DEBUG: LRange(0, -1) returned %d items", len(all))
+	found, changed, err := s.markReceivedInBuffer([]string{"m1", "m2", "flushed"}, "user-2")
+	if err != nil {
+		t.Fatalf("markReceivedInBuffer failed: %v", err)
+	}
+	if strings.Join(found, ",") != "m1,m2" || strings.Join(changed, ",") != "m1" {
+		t.Errorf("expected m1,m2 found and m1 changed, got %v / %v", found, changed)
+	}
+	if _, changed, _ := s.markReceivedInBuffer([]string{"m1"}, "user-2"); len(changed) != 0 {
+		t.Errorf("expected marking twice to change nothing, got %v", changed)
+	}
 
-	if len(all) != 5 {
-		t.Errorf("Expected 5 items, got %d", len(all))
+	reacted, ok, err := s.setReactionInBuffer("m3", "user-2", "❤️")
+	if err != nil || !ok || len(reacted.ReactionCounts) != 1 {
+		t.Fatalf("expected the reaction to apply, got %+v, %v, %v", reacted, ok, err)
 	}
 
-	first3 := redis.LRange(key, 0, 2)
-	t.Logf("DEBUG: LRange(0, 2) returned %d items: %v", len(first3), first3)
+	messages, err := s.getBufferedMessages()
+	if err != nil || len(messages) != 3 {
+		t.Fatalf("expected 3 buffered messages, got %d, %v", len(messages), err)
+	}
+	if messages[0].Id != "m1" || !messages[0].Received || messages[1].Content != "hello" || len(messages[2].Reactions) != 1 {
+		t.Errorf("expected every change to be buffered in order, got %+v", messages)
+	}
 
-	if len(first3) != 3 {
-		t.Errorf("Expected 3 items, got %d", len(first3))
+	activity, err := LastActivity([]models.Chat{{Id: "chat-buffer"}, {Id: "chat-idle", CreatedAt: now.Add(-time.Hour)}})
+	if err != nil {
+		t.Fatalf("LastActivity failed: %v", err)
+	}
+	if activity["chat-buffer"].Unix() != now.Unix() || !activity["chat-idle"].Equal(now.Add(-time.Hour)) {
+		t.Errorf("expected activity from the buffer and the fallback, got %v", activity)
 	}
+}
 
-	last2 := redis.LRange(key, 3, 4)
-	t.Logf("DEBUG: LRange(3, 4) returned %d items: %v", len(last2), last2)
+func TestSendMessageInProcess(t *testing.T) {
+	t.Setenv("QSTASH_URL", "")
+	t.Setenv("QSTASH_TOKEN", "")
+	s, backend := memoryStore(t, "chat-local")
+	s.participants = []string{"user-1", "user-2"}
 
-	if len(last2) != 2 {
-		t.Errorf("Expected 2 items, got %d", len(last2))
+	// Without QStash the flush is scheduled here, so sending still succeeds.
+	msg := &models.Message{Id: "m1", SenderId: "user-1", Type: models.TEXT, Content: "hi", CreatedAt: time.Now()}
+	if err := s.SendMessage(msg); err != nil {
+		t.Fatalf("SendMessage failed: %v", err)
+	}
+	if n, err := backend.BufferLen("chat-local"); err != nil || n != 1 {
+		t.Errorf("expected the message to be buffered once, got %d, %v", n, err)
 	}
+
+	token, _ := backend.Get(chatFlushTokenKey("chat-local"))
+	localFlushMu.Lock()
+	timer, ok := localFlushes[fmt.Sprintf("chat--%s--flush--%s", "chat-local", token)]
+	localFlushMu.Unlock()
+	if !ok {
+		t.Fatal("expected a flush to be waiting on the chat's token")
+	}
+	timer.Stop()
 }
 
 func TestEventTypeConstants(t *testing.T) {
@@ -594,6 +640,52 @@ func TestMessageSeenEventSerialization(t *testing.T) {
 	}
 }
 
+func TestReceiptEventSerialization(t *testing.T) {
+	receipt := ReceiptEvent{
+		MessageIds: []string{"msg-101", "msg-102"},
+		UserId:     "user-010",
+		Timestamp:  time.Now(),
+	}
+
+	data, err := json.Marshal(receipt)
+	if err != nil {
+		t.Fatalf("Failed to marshal receipt: %v", err)
+	}
+	t.Logf("DEBUG: Serialized receipt: %s", string(data))
+
+	event := PubSubEvent{
+		Type: MessageEventReceived,
+		Data: data,
+	}
+
+	eventData, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Failed to marshal event: %v", err)
+	}
+
+	var decoded PubSubEvent
+	if err := json.Unmarshal(eventData, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal event: %v", err)
+	}
+
+	if decoded.Type != MessageEventReceived {
+		t.Errorf("Expected type %s, got %s", MessageEventReceived, decoded.Type)
+	}
+
+	var decodedReceipt ReceiptEvent
+	if err := json.Unmarshal(decoded.Data, &decodedReceipt); err != nil {
+		t.Fatalf("Failed to unmarshal receipt: %v", err)
+	}
+	t.Logf("DEBUG: Decoded receipt: %+v", decodedReceipt)
+
+	if len(decodedReceipt.MessageIds) != 2 {
+		t.Errorf("Expected 2 message IDs, got %d", len(decodedReceipt.MessageIds))
+	}
+	if decodedReceipt.UserId != receipt.UserId {
+		t.Errorf("UserId mismatch: expected %s, got %s", receipt.UserId, decodedReceipt.UserId)
+	}
+}
+
 func TestMessageUpdateFields(t *testing.T) {
 	original := &models.Message{
 		Id:        "test-msg-007",
@@ -648,96 +740,131 @@ func TestMessageUpdateFields(t *testing.T) {
 }
 
 func TestConcurrentPubSub(t *testing.T) {
-	redis := NewMockRedis()
-	channel := "concurrent-test-channel"
-
-	t.Log("DEBUG: Starting concurrent pub/sub test")
+	s, _ := memoryStore(t, "chat-concurrent")
+	sub := s.Subscribe()
+	defer sub.Close()
 
-	subChan := redis.Subscribe(channel)
-
-	var wg sync.WaitGroup
 	numPublishers := 10
 	messagesPerPublisher := 10
+	expectedTotal := numPublishers * messagesPerPublisher
 
-	t.Logf("DEBUG: %d publishers, %d messages each", numPublishers, messagesPerPublisher)
+	received := make(chan string, expectedTotal)
+	go func() {
+		for range expectedTotal {
+			event, err := sub.ReceiveEvent()
+			if err != nil {
+				return
+			}
+			received <- event.Message.Id
+		}
+	}()
 
+	var wg sync.WaitGroup
 	for i := 0; i < numPublishers; i++ {
 		wg.Add(1)
 		go func(publisherID int) {
 			defer wg.Done()
 			for j := 0; j < messagesPerPublisher; j++ {
-				msg := fmt.Sprintf("publisher-%d-msg-%d", publisherID, j)
-				redis.Publish(channel, msg)
+				s.publishUpdateEvent(&models.Message{Id: fmt.Sprintf("publisher-%d-msg-%d", publisherID, j)}, ChangeEdit)
 			}
 		}(i)
 	}
-
 	wg.Wait()
-	t.Log("DEBUG: All publishers done")
-
-	time.Sleep(50 * time.Millisecond)
 
-	published := redis.GetPublishedMessages(channel)
-	t.Logf("DEBUG: Total published messages: %d", len(published))
-
-	expectedTotal := numPublishers * messagesPerPublisher
-	if len(published) != expectedTotal {
-		t.Errorf("Expected %d published messages, got %d", expectedTotal, len(published))
-	}
-
-	received := 0
-	timeout := time.After(100 * time.Millisecond)
-	done := false
-	for !done {
+	seen := make(map[string]bool)
+	timeout := time.After(time.Second)
+	for len(seen) < expectedTotal {
 		select {
-		case <-subChan:
-			received++
+		case id := <-received:
+			seen[id] = true
 		case <-timeout:
-			done = true
+			t.Fatalf("expected %d events, got %d", expectedTotal, len(seen))
 		}
 	}
-	t.Logf("DEBUG: Received via subscription: %d", received)
 }
 
 func TestMessageOrdering(t *testing.T) {
-	redis := NewMockRedis()
-	listKey := "message-order-test"
-
-	t.Log("DEBUG: Testing message ordering")
+	s, backend := memoryStore(t, "chat-order")
 
-	for i := 0; i < 10; i++ {
-		msg := &models.Message{
+	messages := make([]models.Message, 0, BatchSize+10)
+	for i := range BatchSize + 10 {
+		messages = append(messages, models.Message{
 			Id:        fmt.Sprintf("order-msg-%02d", i),
 			SenderId:  "user-010",
 			Content:   fmt.Sprintf("Message %d", i),
 			Type:      models.TEXT,
 			CreatedAt: time.Now().Add(time.Duration(i) * time.Second),
-			UpdatedAt: time.Now().Add(time.Duration(i) * time.Second),
+		})
+	}
+	bufferMessages(t, backend, "chat-order", messages...)
+
+	popped, err := backend.PopBuffered("chat-order", BatchSize)
+	if err != nil || len(popped) != BatchSize {
+		t.Fatalf("expected a batch of %d, got %d, %v", BatchSize, len(popped), err)
+	}
+	if n, _ := backend.BufferLen("chat-order"); n != 10 {
+		t.Errorf("expected 10 messages left, got %d", n)
+	}
+
+	// A failed flush puts its batch back in front, in order.
+	if err := s.pushMessagesBack(messages[:BatchSize]); err != nil {
+		t.Fatalf("pushMessagesBack failed: %v", err)
+	}
+	all, err := s.getBufferedMessages()
+	if err != nil || len(all) != len(messages) {
+		t.Fatalf("expected %d messages, got %d, %v", len(messages), len(all), err)
+	}
+	for i, msg := range all {
+		if expectedId := fmt.Sprintf("order-msg-%02d", i); msg.Id != expectedId {
+			t.Errorf("Message %d: expected ID '%s', got '%s'", i, expectedId, msg.Id)
 		}
-		data, _ := json.Marshal(msg)
-		redis.RPush(listKey, string(data))
-		t.Logf("DEBUG: Pushed message %d", i)
 	}
+}
 
-	all := redis.LRange(listKey, 0, -1)
-	t.Logf("DEBUG: Retrieved %d messages", len(all))
+func TestMemoryBackendValues(t *testing.T) {
+	_, backend := memoryStore(t, "chat-values")
 
-	if len(all) != 10 {
-		t.Fatalf("Expected 10 messages, got %d", len(all))
+	if ok, _ := backend.SetNX("lock", "a", 20*time.Millisecond); !ok {
+		t.Fatal("expected the first SetNX to win")
+	}
+	if ok, _ := backend.SetNX("lock", "b", time.Minute); ok {
+		t.Error("expected SetNX on a held key to lose")
+	}
+	time.Sleep(30 * time.Millisecond)
+	if v, _ := backend.Get("lock"); v != "" {
+		t.Errorf("expected the lock to expire, got %q", v)
+	}
+	if ok, _ := backend.SetNX("lock", "b", time.Minute); !ok {
+		t.Error("expected SetNX to win once the key expired")
 	}
 
-	for i, msgData := range all {
-		var msg models.Message
-		err := json.Unmarshal([]byte(msgData), &msg)
-		if err != nil {
-			t.Errorf("Failed to unmarshal message %d: %v", i, err)
-			continue
+	// A write that lands between read and write makes the update run again
+	// on the new value, as under WATCH.
+	runs := 0
+	err := backend.UpdateValue("call", func(current string) (string, time.Duration, error) {
+		runs++
+		if runs == 1 {
+			backend.Set("call", "ringing", time.Minute)
 		}
-		expectedId := fmt.Sprintf("order-msg-%02d", i)
-		if msg.Id != expectedId {
-			t.Errorf("Message %d: expected ID '%s', got '%s'", i, expectedId, msg.Id)
-		}
-		t.Logf("DEBUG: Message %d verified: ID=%s", i, msg.Id)
+		return current + "+answered", time.Minute, nil
+	})
+	if v, _ := backend.Get("call"); err != nil || runs != 2 || v != "ringing+answered" {
+		t.Errorf("expected a retry on the new value, got %q after %d runs, %v", v, runs, err)
+	}
+	backend.UpdateValue("call", func(string) (string, time.Duration, error) { return "", 0, nil })
+	if v, _ := backend.Get("call"); v != "" {
+		t.Errorf("expected an empty update to delete, got %q", v)
+	}
+
+	err = backend.UpdateField("cursors", "user-1", func(current string) (string, error) {
+		backend.Del("cursors")
+		return "m2", nil
+	})
+	if !errors.Is(err, ErrContended) {
+		t.Errorf("expected endless contention to give up, got %v", err)
+	}
+	if values, _ := backend.Fields("user-1", "cursors", "missing"); values[0] != "" || values[1] != "" {
+		t.Errorf("expected no cursor to be written, got %v", values)
 	}
 }
 
@@ -888,6 +1015,953 @@ func TestBatchSizeConstant(t *testing.T) {
 	}
 }
 
+func TestSearchMatching(t *testing.T) {
+	terms := searchTerms("  Coffee, SUNDAY coffee! ")
+	if len(terms) != 2 || terms[0] != "coffee" || terms[1] != "sunday" {
+		t.Fatalf("Expected [coffee sunday], got %v", terms)
+	}
+	t.Logf("DEBUG: Parsed terms %v", terms)
+
+	msg := models.Message{Id: "msg-1", Content: "Want to grab Coffee on Sunday? I know a great coffee place"}
+	hit, ok := matchMessage(msg, terms)
+	if !ok {
+		t.Fatal("Expected message to match all terms")
+	}
+
+	snippet := []rune(hit.Snippet)
+	if len(hit.Highlights) != 3 {
+		t.Fatalf("Expected 3 highlights, got %d (%v)", len(hit.Highlights), hit.Highlights)
+	}
+	for _, h := range hit.Highlights {
+		word := strings.ToLower(string(snippet[h.Start:h.End]))
+		if word != "coffee" && word != "sunday" {
+			t.Errorf("Highlight %v covers %q", h, word)
+		}
+	}
+	t.Logf("DEBUG: Snippet %q highlights %v", hit.Snippet, hit.Highlights)
+
+	if _, ok := matchMessage(models.Message{Content: "just coffee"}, terms); ok {
+		t.Error("Expected message missing a term not to match")
+	}
+}
+
+func TestSearchSnippetTruncation(t *testing.T) {
+	long := strings.Repeat("a ", 60) + "needle" + strings.Repeat(" b", 60)
+	hit, ok := matchMessage(models.Message{Content: long}, []string{"needle"})
+	if !ok {
+		t.Fatal("Expected long message to match")
+	}
+	if !strings.HasPrefix(hit.Snippet, "…") || !strings.HasSuffix(hit.Snippet, "…") {
+		t.Errorf("Expected snippet to be elided on both sides, got %q", hit.Snippet)
+	}
+
+	snippet := []rune(hit.Snippet)
+	h := hit.Highlights[0]
+	if string(snippet[h.Start:h.End]) != "needle" {
+		t.Errorf("Highlight %v does not cover the match in %q", h, hit.Snippet)
+	}
+	t.Logf("DEBUG: Truncated snippet length %d", len(snippet))
+}
+
+type stubClassifier struct {
+	score float64
+	err   error
+}
+
+func (c stubClassifier) Classify(content string) (string, float64, error) {
+	return "harassment", c.score, c.err
+}
+
+func TestModerationContactInfo(t *testing.T) {
+	rule := NewContactInfoRule()
+	locked := ModerationContext{ChatId: "chat-1", SenderId: "user-1"}
+
+	cases := []struct {
+		content string
+		action  ModerationAction
+	}{
+		{"call me on +91 98765 43210", ModerationMask},
+		{"mail me at someone@example.com", ModerationMask},
+		{"my insta: blind.date_01", ModerationMask},
+		{"see www.example.com/profile", ModerationMask},
+		{"send it to paypal.me/someone", ModerationHold},
+		{"upi is someone@okaxis", ModerationHold},
+		{"let's meet on 2024-10-18 at 7", ModerationAllow},
+		{"I ignore school on sundays", ModerationAllow},
+	}
+
+	for _, c := range cases {
+		v, err := rule.Check(locked, c.content)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if v.Action != c.action {
+			t.Errorf("%q: expected %s, got %s (%q)", c.content, c.action, v.Action, v.Content)
+		}
+		t.Logf("DEBUG: %q -> %s %q", c.content, v.Action, v.Content)
+	}
+
+	v, _ := rule.Check(ModerationContext{Unlocked: true}, "call me on +91 98765 43210")
+	if v.Action != ModerationAllow {
+		t.Errorf("Expected contact info to be allowed once unlocked, got %s", v.Action)
+	}
+}
+
+func TestModerationChain(t *testing.T) {
+	chain := NewModerationChain(NewContactInfoRule(), NewProfanityRule(ModerationMask, "damn"))
+	v := chain.Run(ModerationContext{}, "Damn, text me at someone@example.com")
+	if v.Action != ModerationMask || v.Reason != ReasonContactInfo {
+		t.Fatalf("Expected contact_info mask, got %s/%s", v.Action, v.Reason)
+	}
+	if strings.Contains(v.Content, "Damn") || strings.Contains(v.Content, "example.com") {
+		t.Errorf("Expected both rules to mask content, got %q", v.Content)
+	}
+	t.Logf("DEBUG: Masked content %q", v.Content)
+
+	chain.Use(NewClassifierRule(stubClassifier{score: 0.95}, 0.6, 0.9))
+	v = chain.Run(ModerationContext{}, "hello")
+	if v.Action != ModerationReject || v.Reason != ReasonFlaggedContent {
+		t.Errorf("Expected classifier rejection, got %s/%s", v.Action, v.Reason)
+	}
+
+	failing := NewModerationChain(NewClassifierRule(stubClassifier{err: fmt.Errorf("timeout")}, 0.6, 0.9))
+	if v := failing.Run(ModerationContext{}, "hello"); v.Action != ModerationAllow {
+		t.Errorf("Expected failing classifier to allow, got %s", v.Action)
+	}
+
+	var modErr error = &ModerationError{Action: ModerationMask, Reason: ReasonProfanity}
+	if !modErr.(*ModerationError).Delivered() {
+		t.Error("Expected masked messages to count as delivered")
+	}
+}
+
+func TestReactionReplaceAndRemove(t *testing.T) {
+	msg := &models.Message{Id: "msg-1", SenderId: "user-1"}
+	now := time.Now()
+
+	if !applyReaction(msg, "user-2", "👍", now) {
+		t.Fatal("Expected first reaction to change the message")
+	}
+	if applyReaction(msg, "user-2", "👍", now) {
+		t.Error("Expected repeating the same reaction to be a no-op")
+	}
+	if !applyReaction(msg, "user-2", "❤️", now) {
+		t.Fatal("Expected a different reaction to replace the old one")
+	}
+	if len(msg.Reactions) != 1 || msg.Reactions[0].Content != "❤️" {
+		t.Fatalf("Expected a single ❤️ reaction, got %+v", msg.Reactions)
+	}
+
+	applyReaction(msg, "user-1", "❤️", now)
+	if len(msg.ReactionCounts) != 1 || msg.ReactionCounts[0].Count != 2 {
+		t.Errorf("Expected ❤️ x2, got %+v", msg.ReactionCounts)
+	}
+	t.Logf("DEBUG: Reaction counts %+v", msg.ReactionCounts)
+
+	if !applyReaction(msg, "user-2", "", now) || !applyReaction(msg, "user-1", "", now) {
+		t.Fatal("Expected removals to change the message")
+	}
+	if applyReaction(msg, "user-1", "", now) {
+		t.Error("Expected removing a missing reaction to be a no-op")
+	}
+	if msg.Reactions != nil || msg.ReactionCounts != nil {
+		t.Errorf("Expected empty reactions to be nil, got %+v / %+v", msg.Reactions, msg.ReactionCounts)
+	}
+
+	data, _ := json.Marshal(msg)
+	if strings.Contains(string(data), "reaction_counts") {
+		t.Errorf("Expected reaction_counts to be omitted when empty: %s", data)
+	}
+}
+
+func TestVoiceNoteMetadataSerialization(t *testing.T) {
+	msg := models.Message{
+		Id:   "voice-1",
+		Type: models.AUDIO,
+		Media: []models.Media{{
+			Id:     "m1",
+			Type:   "audio",
+			Url:    "blindly/user_files/u1/note.m4a",
+			FileId: "file-1",
+			Metadata: &models.MediaMetadata{
+				DurationMs: 4200,
+				Waveform:   []int{0, 40, 100, 60},
+				Codec:      "aac",
+				Size:       52311,
+			},
+		}},
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	t.Logf("DEBUG: Serialized voice note: %s", data)
+
+	var decoded models.Message
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	meta := decoded.Media[0].Metadata
+	if meta == nil || meta.DurationMs != 4200 || meta.Codec != "aac" || len(meta.Waveform) != 4 {
+		t.Errorf("Metadata did not round-trip: %+v", meta)
+	}
+}
+
+func TestViewOnceTombstoneSerialization(t *testing.T) {
+	plain, _ := json.Marshal(models.Media{Id: "m0", Type: "image", Url: "https://example.com/a.jpg"})
+	if strings.Contains(string(plain), "view_once") || strings.Contains(string(plain), "opened_at") {
+		t.Errorf("Expected regular media to omit view-once fields: %s", plain)
+	}
+
+	openedAt := time.Now()
+	media := models.Media{Id: "m1", Type: "image", FileId: "file-1", ViewOnce: true, OpenedAt: &openedAt}
+	data, err := json.Marshal(media)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	t.Logf("DEBUG: Tombstone: %s", data)
+
+	var decoded models.Media
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if !decoded.ViewOnce || decoded.OpenedAt == nil || decoded.Url != "" {
+		t.Errorf("Expected an opened view-once tombstone without url, got %+v", decoded)
+	}
+}
+
+func TestOpenViewOnce(t *testing.T) {
+	s, backend := memoryStore(t, "chat-viewonce")
+	bufferMessages(t, backend, "chat-viewonce",
+		models.Message{Id: "m1", SenderId: "user-1", Media: []models.Media{{Id: "v1", Type: "image", FileId: "file-1", ViewOnce: true}}},
+		models.Message{Id: "m2", SenderId: "user-1", Media: []models.Media{{Id: "v2", Type: "image", FileId: "file-2", ViewOnce: true}}},
+	)
+
+	presign := viewOnceURL
+	viewOnceURL = func(fileId string) (string, error) { return "https://signed/" + fileId, nil }
+	t.Cleanup(func() { viewOnceURL = presign })
+
+	if _, err := s.OpenViewOnce("m1", "v1", "user-1"); !errors.Is(err, ErrViewOnceOwnMedia) {
+		t.Errorf("expected the sender to be refused, got %v", err)
+	}
+
+	grant, err := s.OpenViewOnce("m1", "v1", "user-2")
+	if err != nil {
+		t.Fatalf("OpenViewOnce failed: %v", err)
+	}
+	if grant.Url != "https://signed/file-1" || grant.MessageId != "m1" || grant.MediaId != "v1" {
+		t.Errorf("unexpected grant %+v", grant)
+	}
+	if _, err := s.OpenViewOnce("m1", "v1", "user-2"); !errors.Is(err, ErrViewOnceOpened) {
+		t.Errorf("expected a second open to fail, got %v", err)
+	}
+
+	msg, err := s.GetMessageById("m1")
+	if err != nil {
+		t.Fatalf("GetMessageById failed: %v", err)
+	}
+	if media := msg.Media[0]; media.OpenedAt == nil || media.Url != "" || !msg.Received {
+		t.Errorf("expected an opened tombstone, got %+v", msg)
+	}
+
+	// Racing opens, as from two devices, grant the media exactly once.
+	var wg sync.WaitGroup
+	results := make(chan error, 2)
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.OpenViewOnce("m2", "v2", "user-2")
+			results <- err
+		}()
+	}
+	wg.Wait()
+	close(results)
+	granted := 0
+	for err := range results {
+		switch {
+		case err == nil:
+			granted++
+		case !errors.Is(err, ErrViewOnceOpened):
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if granted != 1 {
+		t.Errorf("expected exactly one grant, got %d", granted)
+	}
+}
+
+func TestUnreadCountFromCursor(t *testing.T) {
+	messages := []models.Message{
+		{Id: "m1", SenderId: "other", Seen: true},
+		{Id: "m2", SenderId: "me"},
+		{Id: "m3", SenderId: "other"},
+		{Id: "m4", SenderId: "me"},
+		{Id: "m5", SenderId: "other"},
+		{Id: "m6", SenderId: "other"},
+	}
+
+	// Interleaved replies must not hide earlier unread messages.
+	if got := countUnread(messages, "me", "m2"); got != 3 {
+		t.Errorf("expected 3 unread after m2, got %d", got)
+	}
+	if got := countUnread(messages, "me", "m6"); got != 0 {
+		t.Errorf("expected 0 unread at the last message, got %d", got)
+	}
+
+	// Without a cursor the seen flags are used.
+	if got := countUnread(messages, "me", ""); got != 3 {
+		t.Errorf("expected 3 unread without cursor, got %d", got)
+	}
+	if got := countUnread(messages, "me", "gone"); got != 3 {
+		t.Errorf("expected 3 unread with a missing cursor, got %d", got)
+	}
+	if got := countUnread(messages, "other", "m1"); got != 2 {
+		t.Errorf("expected 2 unread for the other participant, got %d", got)
+	}
+	t.Logf("DEBUG: unread counts verified for %d messages", len(messages))
+}
+
+func TestPubSubEventOrigin(t *testing.T) {
+	event := PubSubEvent{
+		Type:    MessageEventMessage,
+		Message: &models.Message{Id: "m1", SenderId: "me"},
+		Origin:  "CONN1",
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Failed to marshal event: %v", err)
+	}
+	var decoded PubSubEvent
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal event: %v", err)
+	}
+
+	if !decoded.IsEcho("CONN1") {
+		t.Error("expected the sending connection to see its own echo")
+	}
+	if decoded.IsEcho("CONN2") {
+		t.Error("expected another device of the same user to receive the event")
+	}
+
+	// Events without an origin come from GraphQL or the server and reach
+	// every connection.
+	server := PubSubEvent{Type: MessageEventUpdate}
+	if server.IsEcho("") || server.IsEcho("CONN1") {
+		t.Error("expected events without origin to never be treated as echo")
+	}
+	if data, _ := json.Marshal(server); strings.Contains(string(data), "origin") {
+		t.Errorf("expected empty origin to be omitted, got %s", data)
+	}
+	t.Logf("DEBUG: event with origin: %s", data)
+}
+
+func TestPresenceEventSerialization(t *testing.T) {
+	online, err := json.Marshal(PresenceEvent{UserId: "u1", IsOnline: true})
+	if err != nil {
+		t.Fatalf("Failed to marshal presence: %v", err)
+	}
+	if strings.Contains(string(online), "last_seen_at") {
+		t.Errorf("expected no last_seen_at while online, got %s", online)
+	}
+
+	seen := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	data, _ := json.Marshal(PubSubEvent{Type: MessageEventPresence, Data: mustJSON(t, PresenceEvent{UserId: "u1", LastSeenAt: &seen})})
+
+	var event PubSubEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		t.Fatalf("Failed to unmarshal event: %v", err)
+	}
+	var decoded PresenceEvent
+	if err := json.Unmarshal(event.Data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal presence: %v", err)
+	}
+	if event.Type != MessageEventPresence || decoded.IsOnline || decoded.LastSeenAt == nil || !decoded.LastSeenAt.Equal(seen) {
+		t.Errorf("unexpected presence round trip: %s %+v", event.Type, decoded)
+	}
+	t.Logf("DEBUG: presence event: %s", data)
+}
+
+func mustJSON(t *testing.T, v any) json.RawMessage {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	return data
+}
+
+func TestScheduleMessageValidation(t *testing.T) {
+	s := &Store{chatId: "chat1", participants: []string{"me", "other"}, moderation: DefaultModeration}
+
+	msg := &models.Message{Id: "m1", SenderId: "me", Content: "good morning"}
+	if _, err := s.ScheduleMessage(msg, time.Now().Add(5*time.Second)); err != ErrScheduleTooSoon {
+		t.Errorf("expected ErrScheduleTooSoon, got %v", err)
+	}
+	if _, err := s.ScheduleMessage(msg, time.Now().Add(MaxScheduleAhead+time.Hour)); err != ErrScheduleTooFar {
+		t.Errorf("expected ErrScheduleTooFar, got %v", err)
+	}
+
+	stranger := &models.Message{Id: "m2", SenderId: "stranger", Content: "hi"}
+	if _, err := s.ScheduleMessage(stranger, time.Now().Add(time.Hour)); err != ErrUnauthorized {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+
+	s.SetModeration(NewModerationChain(NewProfanityRule(ModerationReject, "darn")))
+	rude := &models.Message{Id: "m3", SenderId: "me", Content: "darn it"}
+	_, err := s.ScheduleMessage(rude, time.Now().Add(time.Hour))
+	var modErr *ModerationError
+	if !errors.As(err, &modErr) || modErr.Action != ModerationReject {
+		t.Errorf("expected a rejection at scheduling time, got %v", err)
+	}
+	t.Logf("DEBUG: schedule validation errors verified")
+}
+
+func TestIcebreakers(t *testing.T) {
+	a := models.User{
+		Id:        "a",
+		Hobbies:   []string{"Hiking", "Cooking", "Chess"},
+		Interests: []string{"Jazz", "Film"},
+		Extra:     models.ExtraMetadata{Languages: []string{"Spanish", "English"}},
+	}
+	b := models.User{
+		Id:          "b",
+		Hobbies:     []string{"hiking ", "Running"},
+		Interests:   []string{"jazz"},
+		UserPrompts: []string{"My ideal weekend? A cabin with no wifi"},
+		Extra:       models.ExtraMetadata{Languages: []string{"spanish"}},
+	}
+
+	shared := SharedIcebreakers(a, b, 3)
+	if len(shared) != 3 {
+		t.Fatalf("expected 3 shared icebreakers, got %d", len(shared))
+	}
+	sources := map[IcebreakerSource]bool{}
+	for _, ib := range shared {
+		sources[ib.Source] = true
+		if ib.Source == IcebreakerPrompt {
+			t.Errorf("shared icebreakers must not quote either profile: %+v", ib)
+		}
+	}
+	if !sources[IcebreakerHobby] || !sources[IcebreakerInterest] || !sources[IcebreakerProfile] {
+		t.Errorf("expected hobby, interest and profile suggestions first, got %+v", shared)
+	}
+
+	// Same pair, same suggestions.
+	again := SharedIcebreakers(a, b, 3)
+	for i := range shared {
+		if shared[i] != again[i] {
+			t.Errorf("expected stable suggestions, got %q then %q", shared[i].Text, again[i].Text)
+		}
+	}
+
+	forA := IcebreakersFor(a, b, MaxIcebreakers)
+	var prompt *Icebreaker
+	for i := range forA {
+		if forA[i].Source == IcebreakerPrompt {
+			prompt = &forA[i]
+		}
+	}
+	if prompt == nil || !strings.Contains(prompt.Text, "A cabin with no wifi") {
+		t.Errorf("expected a suggestion quoting b's prompt answer, got %+v", forA)
+	}
+
+	// Nothing in common still yields generic openers.
+	generic := SharedIcebreakers(models.User{Id: "x"}, models.User{Id: "y"}, 2)
+	if len(generic) != 2 || generic[0].Source != IcebreakerGeneric {
+		t.Errorf("expected generic fallbacks, got %+v", generic)
+	}
+
+	msg := IcebreakerMessage(a, b)
+	if msg.Type != models.SYSTEM || msg.SenderId != models.SystemSenderId || msg.Content != shared[0].Text {
+		t.Errorf("unexpected icebreaker message: %+v", msg)
+	}
+	t.Logf("DEBUG: icebreakers: %+v", forA)
+}
+
+func TestSystemMessagesAreServerOnly(t *testing.T) {
+	s := &Store{chatId: "chat1", participants: []string{"me", "other"}}
+	err := s.SendMessage(&models.Message{Id: "m1", SenderId: "me", Type: models.SYSTEM, Content: "hi"})
+	if err != ErrSystemMessage {
+		t.Errorf("expected ErrSystemMessage for a user-sent system message, got %v", err)
+	}
+	err = s.SendMessage(&models.Message{Id: "m2", SenderId: models.SystemSenderId, Type: models.TEXT, Content: "hi"})
+	if err != ErrSystemMessage {
+		t.Errorf("expected ErrSystemMessage for a non-system message from the server sender, got %v", err)
+	}
+}
+
+func TestEditWindow(t *testing.T) {
+	now := time.Now()
+	window := 15 * time.Minute
+	msg := &models.Message{Id: "m1", SenderId: "me", Content: "hi", CreatedAt: now.Add(-10 * time.Minute)}
+
+	if err := checkEditable(msg, "me", now, window); err != nil {
+		t.Errorf("expected message inside the window to be editable, got %v", err)
+	}
+	if err := checkEditable(msg, "other", now, window); err != ErrNotMessageSender {
+		t.Errorf("expected ErrNotMessageSender, got %v", err)
+	}
+	if err := checkEditable(msg, "me", now.Add(10*time.Minute), window); err != ErrEditWindowClosed {
+		t.Errorf("expected ErrEditWindowClosed, got %v", err)
+	}
+
+	t.Setenv("CHAT_EDIT_WINDOW", "30m")
+	if got := EditWindow(); got != 30*time.Minute {
+		t.Errorf("expected configured window of 30m, got %s", got)
+	}
+	t.Setenv("CHAT_EDIT_WINDOW", "soon")
+	if got := EditWindow(); got != DefaultEditWindow {
+		t.Errorf("expected invalid window to fall back to %s, got %s", DefaultEditWindow, got)
+	}
+
+	edited := now
+	msg.EditedAt = &edited
+	var decoded models.Message
+	if err := json.Unmarshal(mustJSON(t, msg), &decoded); err != nil {
+		t.Fatalf("failed to decode edited message: %v", err)
+	}
+	if decoded.EditedAt == nil || !decoded.EditedAt.Equal(edited) {
+		t.Errorf("expected edited_at to round-trip, got %v", decoded.EditedAt)
+	}
+	t.Logf("DEBUG: edited message JSON: %s", mustJSON(t, msg))
+}
+
+func TestDateProposals(t *testing.T) {
+	now := time.Now()
+
+	valid := &models.DateProposal{
+		StartsAt:    now.Add(48 * time.Hour),
+		Venue:       "  Blue Tokai, Indiranagar ",
+		Status:      models.DateAccepted,
+		RespondedBy: "someone",
+	}
+	if err := validateDateProposal(valid, now); err != nil {
+		t.Fatalf("expected valid proposal, got %v", err)
+	}
+	if valid.Venue != "Blue Tokai, Indiranagar" || valid.Status != models.DatePending || valid.RespondedBy != "" {
+		t.Errorf("expected trimmed venue and reset response fields, got %+v", valid)
+	}
+
+	cases := map[string]*models.DateProposal{
+		"missing":   nil,
+		"no venue":  {StartsAt: now.Add(time.Hour)},
+		"past":      {StartsAt: now.Add(-time.Hour), Venue: "Cafe"},
+		"too far":   {StartsAt: now.Add(MaxDateAhead + time.Hour), Venue: "Cafe"},
+		"long note": {StartsAt: now.Add(time.Hour), Venue: "Cafe", Note: strings.Repeat("a", MaxDateNoteLength+1)},
+	}
+	for name, p := range cases {
+		if err := validateDateProposal(p, now); err == nil {
+			t.Errorf("%s: expected proposal to be rejected", name)
+		}
+	}
+
+	s := &Store{chatId: "chat1", participants: []string{"me", "other"}, moderation: DefaultModeration}
+	if err := s.SendMessage(&models.Message{Id: "m1", SenderId: "me", Type: models.DATE_PROPOSAL}); err == nil {
+		t.Error("expected DATE_PROPOSAL without a proposal to be rejected")
+	}
+
+	starts := time.Date(2026, 3, 14, 18, 30, 0, 0, time.UTC)
+	ics := string(DatesICS([]models.PlannedDate{{
+		Id:       "D1",
+		StartsAt: starts,
+		Venue:    "Cafe; Rooftop, Floor 3",
+		Note:     "Bring the book\nyou mentioned\n" + strings.Repeat("x", 100),
+	}}, starts.Add(-time.Hour)))
+	t.Logf("DEBUG: ics:\n%s", ics)
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:D1@blindly\r\n",
+		"DTSTART:20260314T183000Z\r\n",
+		"DTEND:20260314T203000Z\r\n",
+		"LOCATION:Cafe\\; Rooftop\\, Floor 3\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(ics, want) {
+			t.Errorf("expected ics to contain %q", want)
+		}
+	}
+	for _, line := range strings.Split(ics, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("expected lines to be folded at 75 octets, got %d: %q", len(line), line)
+		}
+	}
+	if !strings.Contains(ics, "DESCRIPTION:Bring the book\\nyou mentioned\\n") {
+		t.Error("expected newlines in the note to be escaped")
+	}
+}
+
+// lockRace runs beforeLock once, just before the next SetNX.
+type lockRace struct {
+	Backend
+	beforeLock func()
+}
+
+func (b *lockRace) SetNX(key string, value string, ttl time.Duration) (bool, error) {
+	if f := b.beforeLock; f != nil {
+		b.beforeLock = nil
+		f()
+	}
+	return b.Backend.SetNX(key, value, ttl)
+}
+
+func TestDateAnswerRace(t *testing.T) {
+	_, backend := memoryStore(t, "chat-date")
+	now := time.Now()
+	bufferMessages(t, backend, "chat-date", models.Message{
+		Id:           "m1",
+		SenderId:     "me",
+		Type:         models.DATE_PROPOSAL,
+		DateProposal: &models.DateProposal{StartsAt: now.Add(48 * time.Hour), Venue: "Cafe", Status: models.DatePending},
+		CreatedAt:    now,
+	})
+
+	// The phone reads the proposal as pending, then the laptop answers and
+	// releases the lock before the phone takes it.
+	laptop := &Store{chatId: "chat-date", participants: []string{"me", "other"}, moderation: DefaultModeration, backend: backend}
+	phone := &Store{chatId: "chat-date", participants: []string{"me", "other"}, moderation: DefaultModeration}
+	phone.backend = &lockRace{Backend: backend, beforeLock: func() {
+		if _, err := laptop.RespondToDateProposal("other", "m1", DateDecline, nil); err != nil {
+			t.Errorf("expected the laptop's answer to be recorded, got %v", err)
+		}
+	}}
+
+	if _, err := phone.RespondToDateProposal("other", "m1", DateDecline, nil); !errors.Is(err, ErrProposalAnswered) {
+		t.Errorf("expected ErrProposalAnswered, got %v", err)
+	}
+	msg, err := laptop.GetMessageById("m1")
+	if err != nil {
+		t.Fatalf("GetMessageById failed: %v", err)
+	}
+	if msg.DateProposal.Status != models.DateDeclined {
+		t.Errorf("expected the proposal to stay declined, got %s", msg.DateProposal.Status)
+	}
+}
+
+type failingTransport struct{ to string }
+
+func (f failingTransport) Send(t *mailer.Template) error {
+	if t.ToEmail == f.to {
+		return errors.New("mailbox unavailable")
+	}
+	return nil
+}
+
+func TestMissedCheckInAlerts(t *testing.T) {
+	sink := &mailer.Sink{}
+	mailer.SetTransport(sink)
+	defer mailer.SetTransport(nil)
+
+	starts := time.Date(2026, 3, 14, 18, 30, 0, 0, time.UTC)
+	date := &models.PlannedDate{Id: "D1", StartsAt: starts, Venue: "Cafe <Rooftop>"}
+	now := starts.Add(-time.Hour)
+
+	if err := validateCheckInTime(date, starts.Add(3*time.Hour), now); err != nil {
+		t.Errorf("expected check-in after the date to be valid, got %v", err)
+	}
+	if err := validateCheckInTime(date, now.Add(-time.Minute), now); err != ErrCheckInTime {
+		t.Errorf("expected past check-in to be rejected, got %v", err)
+	}
+	if err := validateCheckInTime(date, starts.Add(MaxCheckInAfterDate+time.Hour), now); err != ErrCheckInTime {
+		t.Errorf("expected check-in too long after the date to be rejected, got %v", err)
+	}
+
+	contacts := []models.TrustedContact{
+		{Id: "C1", Name: "Asha", Email: "asha@example.com"},
+		{Id: "C2", Name: "Ravi", Email: "ravi@example.com"},
+	}
+	details := mailer.DateDetails{UserName: "Mia", Venue: date.Venue, StartsAt: date.StartsAt}
+	checkIn := &models.DateCheckIn{Id: "K1", UserId: "me", DateId: "D1", CheckInAt: starts.Add(3 * time.Hour)}
+
+	sent, err := sendMissedCheckInAlerts(contacts, details, checkIn)
+	if err != nil || sent != 2 {
+		t.Fatalf("expected 2 alerts, got %d (%v)", sent, err)
+	}
+
+	mails := sink.Sent()
+	if len(mails) != 2 {
+		t.Fatalf("expected 2 mails in the sink, got %d", len(mails))
+	}
+	for i, m := range mails {
+		if m.ToEmail != contacts[i].Email {
+			t.Errorf("expected mail %d to go to %s, got %s", i, contacts[i].Email, m.ToEmail)
+		}
+		if !strings.Contains(m.Subject, "Mia missed a safety check-in") {
+			t.Errorf("unexpected subject %q", m.Subject)
+		}
+		if !strings.Contains(m.Text, "Cafe <Rooftop>") || !strings.Contains(m.HTML, "Cafe &lt;Rooftop&gt;") {
+			t.Errorf("expected venue in text and escaped in HTML")
+		}
+	}
+	t.Logf("DEBUG: alert text: %s", mails[0].Text)
+
+	// One bad address does not stop the other contacts from being alerted.
+	mailer.SetTransport(failingTransport{to: "asha@example.com"})
+	sent, err = sendMissedCheckInAlerts(contacts, details, checkIn)
+	if sent != 1 || err == nil {
+		t.Errorf("expected 1 alert and an error, got %d (%v)", sent, err)
+	}
+}
+
+func TestCallLog(t *testing.T) {
+	started := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+	answered := started.Add(10 * time.Second)
+	ended := answered.Add(95 * time.Second)
+
+	state := &callState{CallId: "CALL1", CallerId: "me", Media: CallVideo, StartedAt: started}
+
+	entry := callLogFor(state, "", CallRingTimeout, ended)
+	if entry.Status != models.CallMissed || entry.DurationSec != 0 {
+		t.Errorf("expected unanswered ring timeout to be missed, got %+v", entry)
+	}
+	if entry := callLogFor(state, "me", CallHangup, ended); entry.Status != models.CallCancelled {
+		t.Errorf("expected caller hangup before answer to be cancelled, got %s", entry.Status)
+	}
+	if entry := callLogFor(state, "other", CallHangup, ended); entry.Status != models.CallDeclined {
+		t.Errorf("expected callee hangup before answer to be declined, got %s", entry.Status)
+	}
+
+	state.AnsweredAt = &answered
+	entry = callLogFor(state, "other", CallHangup, ended)
+	if entry.Status != models.CallCompleted || entry.DurationSec != 95 || entry.EndedBy != "other" {
+		t.Errorf("expected completed 95s call ended by other, got %+v", entry)
+	}
+	t.Logf("DEBUG: call log %s", mustJSON(t, entry))
+
+	s := &Store{chatId: "chat1", participants: []string{"me", "other"}, moderation: DefaultModeration}
+	if err := s.SendMessage(&models.Message{Id: "m1", SenderId: "me", Type: models.CALL}); !errors.Is(err, ErrCallLogMessage) {
+		t.Errorf("expected CALL without a log to be rejected, got %v", err)
+	}
+	if err := s.SendMessage(&models.Message{Id: "m2", SenderId: "me", Type: models.TEXT, Content: "hi", Call: entry}); !errors.Is(err, ErrCallLogMessage) {
+		t.Errorf("expected TEXT with a call log to be rejected, got %v", err)
+	}
+}
+
+func TestTranscriptExport(t *testing.T) {
+	created := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+	stored := []models.Media{
+		{Id: "a", Type: "image", FileId: "file-a", Url: "https://bucket.s3.amazonaws.com/blindly/a.jpg"},
+		{Id: "b", Type: "image", FileId: "file-b", ViewOnce: true},
+		{Id: "c", Type: "file", Url: "https://example.com/c.pdf"},
+	}
+	messages := []models.Message{
+		{Id: "m1", SenderId: "me", Type: models.TEXT, Content: "<script>alert(1)</script>", CreatedAt: created},
+		{Id: "m2", SenderId: "other", Type: models.IMAGE, Media: stored, CreatedAt: created.Add(time.Minute),
+			ReplyTo: &models.MessagePreview{Id: "m1", SenderId: "me", Content: "earlier"}},
+		{Id: "m3", SenderId: "me", Type: models.DATE_PROPOSAL, CreatedAt: created.Add(2 * time.Minute),
+			DateProposal: &models.DateProposal{StartsAt: created.Add(48 * time.Hour), Venue: "Cafe & Co", Status: models.DatePending}},
+		{Id: "m4", SenderId: "me", Type: models.CALL, CreatedAt: created.Add(3 * time.Minute),
+			Call: &models.CallLog{CallId: "CALL1", Media: CallAudio, Status: models.CallCompleted, DurationSec: 42}},
+		{Id: "m5", SenderId: models.SystemSenderId, Type: models.SYSTEM, Content: "You both revealed", CreatedAt: created.Add(4 * time.Minute)},
+	}
+
+	var signed []string
+	err := signTranscriptMedia(messages, func(path string) (string, error) {
+		signed = append(signed, path)
+		return path + "?X-Amz-Signature=abc&X-Amz-Expires=604800", nil
+	})
+	if err != nil {
+		t.Fatalf("signTranscriptMedia failed: %v", err)
+	}
+	if len(signed) != 1 || signed[0] != stored[0].Url {
+		t.Errorf("expected only the uploaded file to be signed, got %v", signed)
+	}
+	if got := messages[1].Media[0].Url; !strings.Contains(got, "X-Amz-Signature") {
+		t.Errorf("expected signed media URL, got %q", got)
+	}
+	if messages[1].Media[1].Url != "" || messages[1].Media[2].Url != stored[2].Url {
+		t.Errorf("expected view-once and client media untouched, got %+v", messages[1].Media)
+	}
+	if strings.Contains(stored[0].Url, "X-Amz-Signature") {
+		t.Error("expected the stored media to be left alone")
+	}
+
+	failing := []models.Message{{Id: "m", Media: []models.Media{{Id: "x", FileId: "f", Url: "u"}}}}
+	if err := signTranscriptMedia(failing, func(string) (string, error) { return "", errors.New("no creds") }); err == nil {
+		t.Error("expected a signing failure to fail the export")
+	}
+
+	transcript := &Transcript{
+		ChatId:     "chat1",
+		ExportedBy: "me",
+		ExportedAt: created.Add(time.Hour),
+		Participants: []TranscriptParticipant{
+			{Id: "me", Name: "Asha"},
+			{Id: "other", Name: "Your match"},
+		},
+		Messages: messages,
+	}
+
+	body, contentType, err := renderTranscript(transcript, ExportJSON)
+	if err != nil || contentType != "application/json" {
+		t.Fatalf("expected JSON transcript, got %q, %v", contentType, err)
+	}
+	var decoded Transcript
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		t.Fatalf("failed to decode JSON transcript: %v", err)
+	}
+	if len(decoded.Messages) != len(messages) || decoded.Messages[3].Call == nil || decoded.Messages[3].Call.DurationSec != 42 {
+		t.Errorf("expected every message to round-trip, got %+v", decoded.Messages)
+	}
+
+	body, contentType, err = renderTranscript(transcript, ExportHTML)
+	if err != nil || !strings.HasPrefix(contentType, "text/html") {
+		t.Fatalf("expected HTML transcript, got %q, %v", contentType, err)
+	}
+	page := string(body)
+	t.Logf("DEBUG: html transcript is %d bytes", len(page))
+	for _, want := range []string{
+		"&lt;script&gt;alert(1)&lt;/script&gt;",
+		"X-Amz-Signature=abc&amp;X-Amz-Expires=604800",
+		"View-once image",
+		"https://example.com/c.pdf",
+		"Your match",
+		"Cafe &amp; Co",
+		"42s",
+		"You both revealed",
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("expected HTML transcript to contain %q", want)
+		}
+	}
+	if strings.Contains(page, "<script>") || strings.Contains(page, "<link") {
+		t.Error("expected a self-contained page with escaped content")
+	}
+
+	if _, _, err := renderTranscript(transcript, "pdf"); !errors.Is(err, ErrUnknownExportFormat) {
+		t.Errorf("expected unknown format to be rejected, got %v", err)
+	}
+}
+
+func TestMessageSealing(t *testing.T) {
+	key := make([]byte, dataKeySize)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	created := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+	msg := models.Message{
+		Id:        "m1",
+		SenderId:  "me",
+		Type:      models.IMAGE,
+		Content:   "see you at eight",
+		Media:     []models.Media{{Id: "a", Type: "image", FileId: "file-a"}},
+		ReplyTo:   &models.MessagePreview{Id: "m0", SenderId: "other", Content: "when?"},
+		CreatedAt: created,
+	}
+
+	sealed := msg
+	if err := sealMessage(&sealed, "chat1", 2, key); err != nil {
+		t.Fatalf("sealMessage failed: %v", err)
+	}
+	if sealed.Content != "" || sealed.Media != nil || sealed.ReplyTo != nil || sealed.Sealed == nil || sealed.Sealed.KeyVersion != 2 {
+		t.Fatalf("expected sealed fields to be cleared, got %+v", sealed)
+	}
+	data, _ := json.Marshal(&sealed)
+	if strings.Contains(string(data), "eight") || strings.Contains(string(data), "file-a") {
+		t.Errorf("expected no plaintext at rest, got %s", data)
+	}
+
+	var stored models.Message
+	if err := json.Unmarshal(data, &stored); err != nil {
+		t.Fatalf("failed to decode sealed message: %v", err)
+	}
+	moved := stored
+	moved.Id = "m2"
+	if err := openMessage(&moved, "chat1", key); err == nil {
+		t.Error("expected a payload moved to another message to fail")
+	}
+	if err := openMessage(&stored, "chat1", key); err != nil {
+		t.Fatalf("openMessage failed: %v", err)
+	}
+	if stored.Sealed != nil || stored.Content != msg.Content || len(stored.Media) != 1 || stored.ReplyTo.Content != "when?" {
+		t.Errorf("expected the message to round-trip, got %+v", stored)
+	}
+
+	wrapped, err := wrapDataKey(key, "chat1", 1, []byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatalf("wrapDataKey failed: %v", err)
+	}
+	if _, err := unwrapDataKey(key, "chat2", 1, wrapped); err == nil {
+		t.Error("expected a data key to be bound to its chat")
+	}
+
+	mk, err := parseMasterKeys("1:" + base64.StdEncoding.EncodeToString(key) + ", 3:" + base64.StdEncoding.EncodeToString(key))
+	if err != nil || mk.current != 3 || len(mk.keys) != 2 {
+		t.Errorf("expected the highest master key to be current, got %+v, %v", mk, err)
+	}
+	if mk, err := parseMasterKeys(""); mk != nil || err != nil {
+		t.Errorf("expected no master keys to disable encryption, got %+v, %v", mk, err)
+	}
+	if _, err := parseMasterKeys("1:c2hvcnQ="); err == nil {
+		t.Error("expected a short master key to be rejected")
+	}
+}
+
+func TestMergeMessageUpdate(t *testing.T) {
+	created := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+	msg := models.Message{Id: "m1", SenderId: "me", Content: "hi", CreatedAt: created, UpdatedAt: created}
+	now := created.Add(time.Minute)
+
+	if err := mergeMessageUpdate(&msg, &models.Message{Id: "other", Seen: true}, now); err != nil {
+		t.Fatalf("mergeMessageUpdate failed: %v", err)
+	}
+	if msg.Id != "m1" || !msg.Seen || msg.Content != "hi" || !msg.UpdatedAt.Equal(created) {
+		t.Errorf("expected only seen to change, got %+v", msg)
+	}
+
+	if err := mergeMessageUpdate(&msg, &models.Message{Content: "hello"}, now); err != nil {
+		t.Fatalf("mergeMessageUpdate failed: %v", err)
+	}
+	if msg.Content != "hello" || !msg.UpdatedAt.Equal(now) {
+		t.Errorf("expected content change to move updated_at, got %+v", msg)
+	}
+}
+
+func TestArchiveSegments(t *testing.T) {
+	now := time.Date(2026, 3, 14, 18, 0, 0, 0, time.UTC)
+	messages := make([]models.Message, 3*ArchiveSegmentSize+50)
+	for i := range messages {
+		messages[i] = models.Message{
+			Id:        fmt.Sprintf("m%d", i),
+			SenderId:  "me",
+			Content:   fmt.Sprintf("message %d", i),
+			CreatedAt: now.Add(time.Duration(i-len(messages)) * time.Minute),
+		}
+	}
+	old := now.Add(-time.Duration(len(messages)) * time.Minute)
+
+	if n := archivableCount(messages, len(messages), old); n != 0 {
+		t.Errorf("expected nothing due, got %d", n)
+	}
+	if n := archivableCount(messages, 100, old); n != 2*ArchiveSegmentSize {
+		t.Errorf("expected whole segments beyond keep, got %d", n)
+	}
+	if n := archivableCount(messages, len(messages), now.Add(-49*time.Minute)); n != 3*ArchiveSegmentSize {
+		t.Errorf("expected whole segments of old messages, got %d", n)
+	}
+	if n := archivableCount(messages[:ArchiveSegmentSize-1], 0, now); n != 0 {
+		t.Errorf("expected a partial segment to stay, got %d", n)
+	}
+
+	data, err := encodeSegment(messages[:ArchiveSegmentSize])
+	if err != nil {
+		t.Fatalf("encodeSegment failed: %v", err)
+	}
+	decoded, err := decodeSegment(data)
+	if err != nil {
+		t.Fatalf("decodeSegment failed: %v", err)
+	}
+	if len(decoded) != ArchiveSegmentSize || decoded[0].Id != "m0" || decoded[len(decoded)-1].Content != messages[ArchiveSegmentSize-1].Content {
+		t.Errorf("expected the segment to round-trip, got %d messages", len(decoded))
+	}
+	if _, err := decodeSegment([]byte("not gzip")); err == nil {
+		t.Error("expected a corrupt segment to fail")
+	}
+}
+
 func BenchmarkMessageSerialization(b *testing.B) {
 	msg := &models.Message{
 		Id:        "bench-msg",
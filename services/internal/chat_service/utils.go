@@ -3,17 +3,19 @@ package chatservice
 import (
 	"blindly/internal/models"
 	"bytes"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/database"
 	"github.com/MelloB1989/karma/utils"
-	"github.com/MelloB1989/karma/v2/orm"
-	"github.com/redis/go-redis/v9"
 	"github.com/upstash/qstash-go"
 )
 
@@ -22,176 +24,299 @@ type FlushRequest struct {
 	FlushToken string `json:"flushToken"`
 }
 
-func (s *Store) insertMessagesToDB(messages []models.Message) error {
-	chatORM := orm.Load(&models.Chat{})
-	defer chatORM.Close()
+// execer is the part of a transaction that modifyMessagesInTx hands out.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
 
-	var chats []models.Chat
-	if err := chatORM.GetByFieldEquals("Id", s.chatId).Scan(&chats); err != nil {
-		return fmt.Errorf("failed to get chat: %w", err)
-	}
+// modifyMessagesInDB loads the chat's flushed messages under a row lock and
+// writes back the slice returned by fn when it reports a change. Every update
+// to the messages column goes through here so concurrent writers cannot
+// overwrite each other.
+func (s *Store) modifyMessagesInDB(fn func(messages []models.Message) ([]models.Message, bool, error)) error {
+	return s.modifyMessagesInTx(func(_ execer, messages []models.Message) ([]models.Message, bool, error) {
+		return fn(messages)
+	})
+}
 
-	if len(chats) == 0 {
-		return fmt.Errorf("chat not found: %s", s.chatId)
+// modifyMessagesInTx is modifyMessagesInDB for changes that also write other
+// rows. Those writes go through tx and commit together with the messages.
+func (s *Store) modifyMessagesInTx(fn func(tx execer, messages []models.Message) ([]models.Message, bool, error)) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
 	}
+	defer db.Close()
 
-	chat := chats[0]
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
 
-	existingIds := make(map[string]bool)
-	for _, msg := range chat.Messages {
-		existingIds[msg.Id] = true
+	var raw []byte
+	if err := tx.QueryRow(`SELECT messages FROM chats WHERE id = $1 FOR UPDATE`, s.chatId).Scan(&raw); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("chat not found: %s", s.chatId)
+		}
+		return fmt.Errorf("failed to get chat: %w", err)
 	}
 
-	for _, msg := range messages {
-		if existingIds[msg.Id] {
-			continue
+	var messages []models.Message
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &messages); err != nil {
+			return fmt.Errorf("failed to decode messages: %w", err)
 		}
-		chat.Messages = append(chat.Messages, msg)
+	}
+	if err := s.openAll(messages); err != nil {
+		return err
 	}
 
-	updateORM := orm.Load(&models.Chat{})
-	defer updateORM.Close()
+	updated, changed, err := fn(tx, messages)
+	if err != nil || !changed {
+		return err
+	}
 
-	if err := updateORM.Update(&chat, s.chatId); err != nil {
+	// Messages still sealed, such as those flushed from the buffer, keep
+	// their seal; everything else is sealed with the current key.
+	if err := s.sealAll(updated); err != nil {
+		return fmt.Errorf("failed to seal messages: %w", err)
+	}
+	data, err := json.Marshal(updated)
+	if err != nil {
+		return fmt.Errorf("failed to encode messages: %w", err)
+	}
+
+	if _, err := tx.Exec(`UPDATE chats SET messages = $1 WHERE id = $2`, string(data), s.chatId); err != nil {
 		return fmt.Errorf("failed to update chat: %w", err)
 	}
 
-	s.rc.Del(ctx, chatCacheKey(s.chatId))
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit chat update: %w", err)
+	}
+
+	s.backend.Del(chatCacheKey(s.chatId))
 
 	return nil
 }
 
-func (s *Store) updateMessageInDB(messageId string, updates *models.Message) (*models.Message, error) {
-	chatORM := orm.Load(&models.Chat{})
-	defer chatORM.Close()
+func (s *Store) insertMessagesToDB(messages []models.Message) error {
+	return s.modifyMessagesInDB(func(existing []models.Message) ([]models.Message, bool, error) {
+		existingIds := make(map[string]bool)
+		for _, msg := range existing {
+			existingIds[msg.Id] = true
+		}
 
-	var chats []models.Chat
-	if err := chatORM.GetByFieldEquals("Id", s.chatId).Scan(&chats); err != nil {
-		return nil, fmt.Errorf("failed to get chat: %w", err)
-	}
+		changed := false
+		for _, msg := range messages {
+			if existingIds[msg.Id] {
+				continue
+			}
+			existing = append(existing, msg)
+			changed = true
+		}
 
-	if len(chats) == 0 {
-		return nil, fmt.Errorf("chat not found: %s", s.chatId)
-	}
+		return existing, changed, nil
+	})
+}
 
-	chat := chats[0]
+func (s *Store) updateMessageInDB(messageId string, updates *models.Message) (*models.Message, error) {
 	var updatedMsg *models.Message
 
-	for i, msg := range chat.Messages {
-		if msg.Id == messageId {
+	err := s.modifyMessagesInDB(func(messages []models.Message) ([]models.Message, bool, error) {
+		for i, msg := range messages {
+			if msg.Id != messageId {
+				continue
+			}
 			contentChanged := false
 			if updates.Content != "" && updates.Content != msg.Content {
-				chat.Messages[i].Content = updates.Content
+				messages[i].Content = updates.Content
 				contentChanged = true
 			}
 			if updates.Type != "" {
-				chat.Messages[i].Type = updates.Type
+				messages[i].Type = updates.Type
 			}
-			chat.Messages[i].Received = updates.Received
-			chat.Messages[i].Seen = updates.Seen
+			messages[i].Received = updates.Received
+			messages[i].Seen = updates.Seen
 			if updates.Media != nil {
-				chat.Messages[i].Media = updates.Media
+				messages[i].Media = updates.Media
 			}
 			if updates.Reactions != nil {
-				chat.Messages[i].Reactions = updates.Reactions
+				messages[i].Reactions = updates.Reactions
+				messages[i].ReactionCounts = countReactions(updates.Reactions)
+			}
+			if updates.DateProposal != nil {
+				messages[i].DateProposal = updates.DateProposal
+			}
+			if updates.EditedAt != nil {
+				messages[i].EditedAt = updates.EditedAt
 			}
 			// Only update UpdatedAt if content was changed
 			if contentChanged {
-				chat.Messages[i].UpdatedAt = time.Now()
+				messages[i].UpdatedAt = time.Now()
 			}
-			updatedMsg = &chat.Messages[i]
-			break
+			msgCopy := messages[i]
+			updatedMsg = &msgCopy
+			return messages, true, nil
 		}
+		return nil, false, fmt.Errorf("message not found: %s", messageId)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if updatedMsg == nil {
-		return nil, fmt.Errorf("message not found: %s", messageId)
-	}
-
-	updateORM := orm.Load(&models.Chat{})
-	defer updateORM.Close()
-
-	if err := updateORM.Update(&chat, s.chatId); err != nil {
-		return nil, fmt.Errorf("failed to update chat: %w", err)
-	}
-
-	s.rc.Del(ctx, chatCacheKey(s.chatId))
-
 	return updatedMsg, nil
 }
 
+// updateMessageInBuffer applies updates to a buffered message and returns it,
+// or nil when the message has already been flushed. Sealed messages have to
+// be opened to be changed, so this runs as an optimistic update in Go rather
+// than as a script.
 func (s *Store) updateMessageInBuffer(messageId string, updates *models.Message) (*models.Message, error) {
-	msgsKey := chatMsgsKey(s.chatId)
-
-	// Lua script to find and update message atomically
-	luaScript := redis.NewScript(`
-		local messages = redis.call('LRANGE', KEYS[1], 0, -1)
-		for i, msgJson in ipairs(messages) do
-			local msg = cjson.decode(msgJson)
-			if msg.id == ARGV[1] then
-				-- Update fields from the updates JSON
-				local updates = cjson.decode(ARGV[2])
-				local contentChanged = false
-				for k, v in pairs(updates) do
-					-- Skip nil values, id, created_at, and updated_at
-					if k == 'id' or k == 'created_at' or k == 'updated_at' then
-						-- never update these directly
-					elseif k == 'content' and type(v) == 'string' and v ~= '' and v ~= msg.content then
-						-- content is being updated
-						msg[k] = v
-						contentChanged = true
-					elseif type(v) == 'string' and v == '' then
-						-- skip empty strings
-					elseif type(v) == 'boolean' then
-						-- always update booleans (received, seen)
-						msg[k] = v
-					elseif v ~= nil and v ~= cjson.null then
-						-- update non-nil, non-null values
-						msg[k] = v
-					end
-				end
-				-- Only update updated_at if content was changed
-				if contentChanged then
-					msg.updated_at = ARGV[3]
-				end
-				local updated = cjson.encode(msg)
-				redis.call('LSET', KEYS[1], i - 1, updated)
-				return updated
-			end
-		end
-		return nil
-	`)
+	var result *models.Message
+	err := s.backend.UpdateBuffered(s.chatId, func(buffered []string) (map[int]string, error) {
+		result = nil
+
+		for i, str := range buffered {
+			var msg models.Message
+			if err := json.Unmarshal([]byte(str), &msg); err != nil || msg.Id != messageId {
+				continue
+			}
+			if err := OpenMessage(s.chatId, &msg); err != nil {
+				return nil, err
+			}
+			if err := mergeMessageUpdate(&msg, updates, time.Now()); err != nil {
+				return nil, err
+			}
 
-	updatesJSON, _ := json.Marshal(updates)
-	nowStr := time.Now().Format(time.RFC3339Nano)
-	result, err := luaScript.Run(ctx, s.rc, []string{msgsKey}, messageId, string(updatesJSON), nowStr).Result()
-	if err != nil {
-		if err == redis.Nil {
-			return nil, nil // Not found in buffer
+			stored := msg
+			if err := s.sealForStorage(&stored); err != nil {
+				return nil, err
+			}
+			data, err := json.Marshal(&stored)
+			if err != nil {
+				return nil, err
+			}
+			result = &msg
+			return map[int]string{i: string(data)}, nil
 		}
+		return nil, nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	return result, nil
+}
+
+// mergeMessageUpdate copies every set field of updates onto msg. Empty
+// strings and nulls are skipped, booleans always apply, and id and
+// timestamps are never taken from updates. UpdatedAt only moves when the
+// content changes.
+func mergeMessageUpdate(msg *models.Message, updates *models.Message, now time.Time) error {
+	var fields, changes map[string]any
+	current, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(current, &fields); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(updates)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, &changes); err != nil {
+		return err
+	}
 
-	if result == nil {
-		return nil, nil // Not found in buffer
+	contentChanged := false
+	for k, v := range changes {
+		if v == nil || k == "id" || k == "created_at" || k == "updated_at" {
+			continue
+		}
+		if str, ok := v.(string); ok {
+			if str == "" {
+				continue
+			}
+			if k == "content" && str != fields["content"] {
+				contentChanged = true
+			}
+		}
+		fields[k] = v
+	}
+	if contentChanged {
+		fields["updated_at"] = now.Format(time.RFC3339Nano)
 	}
 
-	var msg models.Message
-	if str, ok := result.(string); ok {
-		if err := json.Unmarshal([]byte(str), &msg); err != nil {
-			return nil, err
+	merged, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	*msg = models.Message{}
+	return json.Unmarshal(merged, msg)
+}
+
+// markReceivedInBuffer sets received on buffered messages sent by someone
+// other than userId. It returns the ids found in the buffer and the subset
+// whose flag actually flipped.
+func (s *Store) markReceivedInBuffer(messageIds []string, userId string) ([]string, []string, error) {
+	var found, changed []string
+	err := s.backend.UpdateBuffered(s.chatId, func(buffered []string) (map[int]string, error) {
+		found, changed = nil, nil
+
+		updates := make(map[int]string)
+		for i, str := range buffered {
+			// Received is never sealed, so messages are flagged as stored.
+			var msg models.Message
+			if err := json.Unmarshal([]byte(str), &msg); err != nil || !slices.Contains(messageIds, msg.Id) {
+				continue
+			}
+			found = append(found, msg.Id)
+			if msg.SenderId == userId || msg.Received {
+				continue
+			}
+			msg.Received = true
+			data, err := json.Marshal(&msg)
+			if err != nil {
+				return nil, err
+			}
+			updates[i] = string(data)
+			changed = append(changed, msg.Id)
+		}
+		return updates, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return found, changed, nil
+}
+
+func (s *Store) markReceivedInDB(messageIds []string, userId string) ([]string, error) {
+	changed := make([]string, 0, len(messageIds))
+
+	err := s.modifyMessagesInDB(func(messages []models.Message) ([]models.Message, bool, error) {
+		for i, msg := range messages {
+			if msg.SenderId == userId || msg.Received || !slices.Contains(messageIds, msg.Id) {
+				continue
+			}
+			messages[i].Received = true
+			changed = append(changed, msg.Id)
 		}
+		return messages, len(changed) > 0, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return &msg, nil
+	return changed, nil
 }
 
 func (s *Store) getBufferedMessages() ([]models.Message, error) {
-	msgsKey := chatMsgsKey(s.chatId)
-	msgStrings, err := s.rc.LRange(ctx, msgsKey, 0, -1).Result()
+	buffered, err := s.backend.Buffered(s.chatId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get buffered messages: %w", err)
 	}
+	msgStrings := buffered[0]
 
 	messages := make([]models.Message, 0, len(msgStrings))
 	for _, msgStr := range msgStrings {
@@ -200,6 +325,10 @@ func (s *Store) getBufferedMessages() ([]models.Message, error) {
 			log.Printf("failed to unmarshal buffered message: %v", err)
 			continue
 		}
+		if err := OpenMessage(s.chatId, &msg); err != nil {
+			log.Printf("failed to open buffered message: %v", err)
+			continue
+		}
 		messages = append(messages, msg)
 	}
 
@@ -211,19 +340,16 @@ func (s *Store) pushMessagesBack(messages []models.Message) error {
 		return nil
 	}
 
-	msgsKey := chatMsgsKey(s.chatId)
-	pipe := s.rc.Pipeline()
-
-	for i := len(messages) - 1; i >= 0; i-- {
-		msgJSON, err := json.Marshal(messages[i])
+	msgs := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		msgJSON, err := json.Marshal(msg)
 		if err != nil {
 			continue
 		}
-		pipe.LPush(ctx, msgsKey, msgJSON)
+		msgs = append(msgs, string(msgJSON))
 	}
 
-	_, err := pipe.Exec(ctx)
-	return err
+	return s.backend.RestoreBuffered(s.chatId, msgs)
 }
 
 func VerifyQStashSignature(signature string, body []byte, url string) error {
@@ -244,44 +370,90 @@ func VerifyQStashSignature(signature string, body []byte, url string) error {
 }
 
 func (s *Store) scheduleFlush() error {
-	s.ensureRedis()
+	s.ensureBackend()
 
-	msgsKey := chatMsgsKey(s.chatId)
-	length, err := s.rc.LLen(ctx, msgsKey).Result()
+	length, err := s.backend.BufferLen(s.chatId)
 	if err != nil {
 		return fmt.Errorf("failed to get buffer length: %w", err)
 	}
 
 	tokenKey := chatFlushTokenKey(s.chatId)
-	token, err := s.rc.Get(ctx, tokenKey).Result()
-	if err == redis.Nil || token == "" {
-		token = utils.GenerateID()
-		s.rc.Set(ctx, tokenKey, token, IdleTimeout+10*time.Second)
-	} else if err != nil {
+	token, err := s.backend.Get(tokenKey)
+	if err != nil {
 		return fmt.Errorf("failed to get flush token: %w", err)
 	}
-
-	bearer := config.GetEnvRaw("QSTASH_TOKEN")
+	if token == "" {
+		token = utils.GenerateID()
+		s.backend.Set(tokenKey, token, IdleTimeout+10*time.Second)
+	}
 
 	if length >= BatchSize {
-		return publishQStashFlush(bearer, s.chatId, 0, token)
+		return s.publishFlush(0, token)
 	}
 
-	return publishQStashFlush(bearer, s.chatId, IdleTimeout, token)
+	return s.publishFlush(IdleTimeout, token)
 }
 
-func publishQStashFlush(bearer string, chatId string, delay time.Duration, token string) error {
-	baseURL := config.GetEnvRaw("QSTASH_URL")
-	backendURL := config.GetEnvRaw("BACKEND_URL")
-	url := fmt.Sprintf("%s/v2/publish/%s/v1/chat/flush", baseURL, backendURL)
+// publishFlush asks for the buffer to be flushed with token after delay. The
+// memory backend lives in this process, so its flushes run here too instead
+// of going through QStash.
+func (s *Store) publishFlush(delay time.Duration, token string) error {
+	if inProcess(s.backend) {
+		flushLater(s.chatId, delay, token)
+		return nil
+	}
+	return publishQStashFlush(config.GetEnvRaw("QSTASH_TOKEN"), s.chatId, delay, token)
+}
 
+var (
+	localFlushMu sync.Mutex
+	localFlushes = make(map[string]*time.Timer)
+)
+
+// flushLater runs FlushMessages for chatId after delay, once per token like
+// the QStash dedup id. A flush already waiting on token is brought forward
+// when delay is zero.
+func flushLater(chatId string, delay time.Duration, token string) {
+	key := fmt.Sprintf("chat--%s--flush--%s", chatId, token)
+
+	localFlushMu.Lock()
+	defer localFlushMu.Unlock()
+	if timer, ok := localFlushes[key]; ok {
+		if delay == 0 {
+			timer.Reset(0)
+		}
+		return
+	}
+	localFlushes[key] = time.AfterFunc(delay, func() {
+		localFlushMu.Lock()
+		delete(localFlushes, key)
+		localFlushMu.Unlock()
+
+		store := NewStoreWithoutAuth(chatId)
+		defer store.Close()
+		if err := store.FlushMessages(token); err != nil {
+			log.Printf("[%s] in-process flush failed: %v", chatId, err)
+		}
+	})
+}
+
+func publishQStashFlush(bearer string, chatId string, delay time.Duration, token string) error {
 	payload := FlushRequest{
 		ChatId:     chatId,
 		FlushToken: token,
 	}
+	return publishQStashJob(bearer, "/v1/chat/flush", payload, delay, fmt.Sprintf("chat--%s--flush--%s", chatId, token))
+}
+
+// publishQStashJob asks QStash to POST payload to a backend route after delay.
+func publishQStashJob(bearer string, path string, payload any, delay time.Duration, dedupId string) error {
+	baseURL := config.GetEnvRaw("QSTASH_URL")
+	backendURL := config.GetEnvRaw("BACKEND_URL")
+	url := fmt.Sprintf("%s/v2/publish/%s%s", baseURL, backendURL, path)
+
 	body, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("failed to marshal flush request: %w", err)
+		return fmt.Errorf("failed to marshal qstash payload: %w", err)
 	}
 
 	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
@@ -291,7 +463,7 @@ func publishQStashFlush(bearer string, chatId string, delay time.Duration, token
 
 	req.Header.Set("Authorization", "Bearer "+bearer)
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Upstash-Deduplication-Id", fmt.Sprintf("chat--%s--flush--%s", chatId, token))
+	req.Header.Set("Upstash-Deduplication-Id", dedupId)
 
 	if delay > 0 {
 		req.Header.Set("Upstash-Delay", fmt.Sprintf("%ds", int(delay.Seconds())))
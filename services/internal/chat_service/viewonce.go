@@ -0,0 +1,192 @@
+package chatservice
+
+import (
+	"blindly/internal/helpers/storage"
+	"blindly/internal/models"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+const (
+	// ViewOnceGrantTTL is how long the URL handed out on first open stays valid.
+	ViewOnceGrantTTL = 30 * time.Second
+	// viewOncePurgeDelay leaves the grant time to be used before the object goes.
+	viewOncePurgeDelay = ViewOnceGrantTTL + 30*time.Second
+)
+
+var (
+	ErrViewOnceOpened   = errors.New("view-once media has already been opened")
+	ErrNotViewOnce      = errors.New("media is not view-once")
+	ErrViewOnceOwnMedia = errors.New("view-once media can only be opened by the recipient")
+)
+
+func viewOnceClaimKey(chatId string, mediaId string) string {
+	return fmt.Sprintf("blindly:chat:%s:viewonce:%s", chatId, mediaId)
+}
+
+// MediaGrant is a short-lived URL for a single view-once media item.
+type MediaGrant struct {
+	MessageId string    `json:"message_id"`
+	MediaId   string    `json:"media_id"`
+	Url       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type ViewOncePurgeRequest struct {
+	FileId string `json:"fileId"`
+}
+
+// OpenViewOnce hands the recipient a short-lived URL for view-once media the
+// first time it is opened, turns the media into an opened tombstone for both
+// participants and schedules the underlying file for deletion.
+func (s *Store) OpenViewOnce(messageId string, mediaId string, userId string) (*MediaGrant, error) {
+	s.ensureBackend()
+
+	msg, err := s.GetMessageById(messageId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.SenderId == userId {
+		return nil, ErrViewOnceOwnMedia
+	}
+
+	idx := -1
+	for i, m := range msg.Media {
+		if m.Id == mediaId {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return nil, fmt.Errorf("media not found: %s", mediaId)
+	}
+	media := msg.Media[idx]
+	if !media.ViewOnce || media.FileId == "" {
+		return nil, ErrNotViewOnce
+	}
+	if media.OpenedAt != nil {
+		return nil, ErrViewOnceOpened
+	}
+
+	// The claim makes the first open win even across instances.
+	claimed, err := s.backend.SetNX(viewOnceClaimKey(s.chatId, mediaId), userId, 24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim view-once media: %w", err)
+	}
+	if !claimed {
+		return nil, ErrViewOnceOpened
+	}
+
+	grant, err := s.grantViewOnce(msg, idx, userId)
+	if err != nil {
+		s.backend.Del(viewOnceClaimKey(s.chatId, mediaId))
+		return nil, err
+	}
+
+	if err := publishQStashJob(
+		config.GetEnvRaw("QSTASH_TOKEN"),
+		"/v1/chat/view-once/purge",
+		ViewOncePurgeRequest{FileId: media.FileId},
+		viewOncePurgeDelay,
+		fmt.Sprintf("chat--%s--viewonce--%s", s.chatId, media.FileId),
+	); err != nil {
+		log.Printf("[%s] failed to schedule view-once purge, purging in process: %v", s.chatId, err)
+		fileId := media.FileId
+		time.AfterFunc(viewOncePurgeDelay, func() {
+			if err := PurgeViewOnceFile(fileId); err != nil {
+				log.Printf("failed to purge view-once file %s: %v", fileId, err)
+			}
+		})
+	}
+
+	return grant, nil
+}
+
+// viewOnceURL presigns the file behind view-once media for ViewOnceGrantTTL.
+// It is a variable so tests can stand in for S3.
+var viewOnceURL = presignViewOnceFile
+
+func presignViewOnceFile(fileId string) (string, error) {
+	fileORM := orm.Load(&models.UserFiles{})
+	defer fileORM.Close()
+
+	var files []models.UserFiles
+	if err := fileORM.GetByFieldEquals("Id", fileId).Scan(&files); err != nil {
+		return "", fmt.Errorf("failed to get media file: %w", err)
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("media file not found: %s", fileId)
+	}
+
+	return storage.PresignGet(files[0].S3Path, ViewOnceGrantTTL)
+}
+
+func (s *Store) grantViewOnce(msg *models.Message, idx int, userId string) (*MediaGrant, error) {
+	media := msg.Media[idx]
+
+	url, err := viewOnceURL(media.FileId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tombstone := make([]models.Media, len(msg.Media))
+	copy(tombstone, msg.Media)
+	tombstone[idx].OpenedAt = &now
+	tombstone[idx].Url = ""
+
+	// UpdateMessage always writes the flags, so carry the current ones over.
+	if _, err := s.UpdateMessage(msg.Id, &models.Message{
+		Media:    tombstone,
+		Received: true,
+		Seen:     msg.Seen,
+	}, ChangeViewOnce); err != nil {
+		return nil, fmt.Errorf("failed to record view: %w", err)
+	}
+
+	log.Printf("[%s] view-once media %s opened by %s", s.chatId, media.Id, userId)
+
+	return &MediaGrant{
+		MessageId: msg.Id,
+		MediaId:   media.Id,
+		Url:       url,
+		ExpiresAt: now.Add(ViewOnceGrantTTL),
+	}, nil
+}
+
+// PurgeViewOnceFile deletes an opened view-once file from storage and from
+// user_files. Missing files are treated as already purged.
+func PurgeViewOnceFile(fileId string) error {
+	fileORM := orm.Load(&models.UserFiles{})
+	defer fileORM.Close()
+
+	var files []models.UserFiles
+	if err := fileORM.GetByFieldEquals("Id", fileId).Scan(&files); err != nil {
+		return fmt.Errorf("failed to get media file: %w", err)
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	if err := storage.Delete(files[0].S3Path); err != nil {
+		return err
+	}
+
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`DELETE FROM user_files WHERE id = $1`, fileId); err != nil {
+		return fmt.Errorf("failed to delete file record: %w", err)
+	}
+
+	return nil
+}
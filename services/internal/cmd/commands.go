@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	chatservice "blindly/internal/chat_service"
+	"blindly/internal/handlers/ai"
+	"blindly/internal/handlers/chat"
+	"blindly/internal/helpers/wsproto"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+)
+
+// RunCommand runs a one-off maintenance command instead of the servers.
+//
+//	rotate-chat-keys <chatId>...  give each chat a new data key
+//	rewrap-chat-keys              move every data key to the newest master key
+//	archive-chats [chatId...]     archive due messages, of every chat by default
+//	ws-schema <chat|ai>           print the JSON Schema of a socket protocol
+func RunCommand(args []string) error {
+	godotenv.Load()
+
+	switch args[0] {
+	case "rotate-chat-keys":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: rotate-chat-keys <chatId>...")
+		}
+		for _, chatId := range args[1:] {
+			version, err := chatservice.RotateChatKey(chatId)
+			if err != nil {
+				return err
+			}
+			log.Printf("chat %s now uses data key version %d", chatId, version)
+		}
+		return nil
+	case "rewrap-chat-keys":
+		n, err := chatservice.RewrapChatKeys()
+		if err != nil {
+			return err
+		}
+		log.Printf("rewrapped %d chat data keys", n)
+		return nil
+	case "archive-chats":
+		if len(args) == 1 {
+			n, err := chatservice.ArchiveOldChats()
+			if err != nil {
+				return err
+			}
+			log.Printf("archived %d messages", n)
+			return nil
+		}
+		for _, chatId := range args[1:] {
+			n, err := chatservice.ArchiveChat(chatId)
+			if err != nil {
+				return err
+			}
+			log.Printf("archived %d messages of chat %s", n, chatId)
+		}
+		return nil
+	case "ws-schema":
+		protocols := map[string]*wsproto.Protocol{"chat": chat.Protocol(), "ai": ai.Protocol()}
+		if len(args) != 2 || protocols[args[1]] == nil {
+			return fmt.Errorf("usage: ws-schema <chat|ai>")
+		}
+		schema, err := protocols[args[1]].Schema()
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(append(schema, '\n'))
+		return err
+	default:
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
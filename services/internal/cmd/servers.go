@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	chatservice "blindly/internal/chat_service"
 	"blindly/internal/constants"
 	"blindly/internal/graph"
+	"blindly/internal/graph/directives"
 	"blindly/internal/routes"
 	"context"
 	"net/http"
@@ -36,6 +38,13 @@ func StartGoFiber(ctx context.Context) {
 	app.Listen(":8080")
 }
 
+// StartScheduler delivers scheduled chat messages whose QStash job never
+// arrived, including those that fell due while the service was down.
+func StartScheduler(ctx context.Context) {
+	godotenv.Load()
+	chatservice.RunScheduler(ctx)
+}
+
 func StartGraphql(ctx context.Context) error {
 	port := "7777"
 	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: graph.NewResolver()}))
@@ -43,6 +52,7 @@ func StartGraphql(ctx context.Context) error {
 	// Add transports in the correct order
 	srv.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              directives.WebsocketInit,
 		Upgrader: websocket.Upgrader{
 			ReadBufferSize:  1024,
 			WriteBufferSize: 1024,
@@ -6,12 +6,42 @@ package graph
 // Code generated by github.com/99designs/gqlgen version v0.17.84
 
 import (
+	chatservice "blindly/internal/chat_service"
 	"blindly/internal/graph/model"
 	"blindly/internal/models"
 	"context"
 	"fmt"
+	"time"
 )
 
+// DurationSec is the resolver for the duration_sec field.
+func (r *callLogResolver) DurationSec(ctx context.Context, obj *models.CallLog) (int32, error) {
+	if obj == nil {
+		return 0, fmt.Errorf("call log is nil")
+	}
+	return int32(obj.DurationSec), nil
+}
+
+// DownloadURL is the resolver for the download_url field.
+func (r *chatExportResolver) DownloadURL(ctx context.Context, obj *models.ChatExport) (*string, error) {
+	if obj == nil {
+		return nil, fmt.Errorf("chat export is nil")
+	}
+	url, err := chatservice.ExportDownloadURL(obj)
+	if err != nil || url == "" {
+		return nil, err
+	}
+	return &url, nil
+}
+
+// IsMuted is the resolver for the is_muted field.
+func (r *connectionSettingsResolver) IsMuted(ctx context.Context, obj *models.ConnectionSettings) (bool, error) {
+	if obj == nil {
+		return false, fmt.Errorf("connection settings is nil")
+	}
+	return obj.MutedUntil != nil && obj.MutedUntil.After(time.Now()), nil
+}
+
 // Score is the resolver for the score field.
 func (r *matchResolver) Score(ctx context.Context, obj *models.Match) (int32, error) {
 	if obj == nil {
@@ -20,6 +50,86 @@ func (r *matchResolver) Score(ctx context.Context, obj *models.Match) (int32, er
 	return int32(obj.Score), nil
 }
 
+// SendMessage is the resolver for the sendMessage field.
+func (r *mutationResolver) SendMessage(ctx context.Context, input model.SendMessageInput) (*models.Message, error) {
+	return r.ChatsResolver.SendMessage(ctx, input)
+}
+
+// MarkSeen is the resolver for the markSeen field.
+func (r *mutationResolver) MarkSeen(ctx context.Context, chatID string, messageIds []string) (bool, error) {
+	return r.ChatsResolver.MarkSeen(ctx, chatID, messageIds)
+}
+
+// React is the resolver for the react field.
+func (r *mutationResolver) React(ctx context.Context, chatID string, messageID string, reaction string) (*models.Message, error) {
+	return r.ChatsResolver.React(ctx, chatID, messageID, reaction)
+}
+
+// Unreact is the resolver for the unreact field.
+func (r *mutationResolver) Unreact(ctx context.Context, chatID string, messageID string) (*models.Message, error) {
+	return r.ChatsResolver.Unreact(ctx, chatID, messageID)
+}
+
+// OpenViewOnce is the resolver for the openViewOnce field.
+func (r *mutationResolver) OpenViewOnce(ctx context.Context, chatID string, messageID string, mediaID string) (*chatservice.MediaGrant, error) {
+	return r.ChatsResolver.OpenViewOnce(ctx, chatID, messageID, mediaID)
+}
+
+// ScheduleMessage is the resolver for the scheduleMessage field.
+func (r *mutationResolver) ScheduleMessage(ctx context.Context, input model.SendMessageInput, deliverAt time.Time) (*models.ScheduledMessage, error) {
+	return r.ChatsResolver.ScheduleMessage(ctx, input, deliverAt)
+}
+
+// UpdateConnectionSettings is the resolver for the updateConnectionSettings field.
+func (r *mutationResolver) UpdateConnectionSettings(ctx context.Context, matchID string, input model.ConnectionSettingsInput) (*models.ConnectionSettings, error) {
+	return r.ChatsResolver.UpdateConnectionSettings(ctx, matchID, input)
+}
+
+// CancelScheduledMessage is the resolver for the cancelScheduledMessage field.
+func (r *mutationResolver) CancelScheduledMessage(ctx context.Context, chatID string, id string) (bool, error) {
+	return r.ChatsResolver.CancelScheduledMessage(ctx, chatID, id)
+}
+
+// RespondToDateProposal is the resolver for the respondToDateProposal field.
+func (r *mutationResolver) RespondToDateProposal(ctx context.Context, chatID string, messageID string, action chatservice.DateAction, counter *model.DateProposalInput) (*chatservice.DateResponse, error) {
+	return r.ChatsResolver.RespondToDateProposal(ctx, chatID, messageID, action, counter)
+}
+
+// AddTrustedContact is the resolver for the addTrustedContact field.
+func (r *mutationResolver) AddTrustedContact(ctx context.Context, name string, email string) (*models.TrustedContact, error) {
+	return r.ChatsResolver.AddTrustedContact(ctx, name, email)
+}
+
+// RemoveTrustedContact is the resolver for the removeTrustedContact field.
+func (r *mutationResolver) RemoveTrustedContact(ctx context.Context, id string) (bool, error) {
+	return r.ChatsResolver.RemoveTrustedContact(ctx, id)
+}
+
+// ShareDate is the resolver for the shareDate field.
+func (r *mutationResolver) ShareDate(ctx context.Context, dateID string) (int32, error) {
+	return r.ChatsResolver.ShareDate(ctx, dateID)
+}
+
+// ScheduleCheckIn is the resolver for the scheduleCheckIn field.
+func (r *mutationResolver) ScheduleCheckIn(ctx context.Context, dateID string, checkInAt time.Time) (*models.DateCheckIn, error) {
+	return r.ChatsResolver.ScheduleCheckIn(ctx, dateID, checkInAt)
+}
+
+// CheckIn is the resolver for the checkIn field.
+func (r *mutationResolver) CheckIn(ctx context.Context, id string) (*models.DateCheckIn, error) {
+	return r.ChatsResolver.CheckIn(ctx, id)
+}
+
+// CancelCheckIn is the resolver for the cancelCheckIn field.
+func (r *mutationResolver) CancelCheckIn(ctx context.Context, id string) (bool, error) {
+	return r.ChatsResolver.CancelCheckIn(ctx, id)
+}
+
+// ExportChat is the resolver for the exportChat field.
+func (r *mutationResolver) ExportChat(ctx context.Context, chatID string, format model.ChatExportFormat) (*models.ChatExport, error) {
+	return r.ChatsResolver.ExportChat(ctx, chatID, format)
+}
+
 // SheRating is the resolver for the she_rating field.
 func (r *postUnlockRatingResolver) SheRating(ctx context.Context, obj *models.PostUnlockRating) (int32, error) {
 	if obj == nil {
@@ -37,8 +147,67 @@ func (r *postUnlockRatingResolver) HeRating(ctx context.Context, obj *models.Pos
 }
 
 // GetMyConnections is the resolver for the getMyConnections field.
-func (r *queryResolver) GetMyConnections(ctx context.Context) ([]*model.Connection, error) {
-	return r.ChatsResolver.GetMyConnections(ctx)
+func (r *queryResolver) GetMyConnections(ctx context.Context, filter *model.ConnectionFilter, sort *model.ConnectionSort, limit *int32, cursor *string) ([]*model.Connection, error) {
+	return r.ChatsResolver.GetMyConnections(ctx, filter, sort, limit, cursor)
+}
+
+// GetUnreadBadge is the resolver for the getUnreadBadge field.
+func (r *queryResolver) GetUnreadBadge(ctx context.Context) (int32, error) {
+	return r.ChatsResolver.GetUnreadBadge(ctx)
+}
+
+// ScheduledMessages is the resolver for the scheduledMessages field.
+func (r *queryResolver) ScheduledMessages(ctx context.Context, chatID string) ([]*models.ScheduledMessage, error) {
+	return r.ChatsResolver.ScheduledMessages(ctx, chatID)
+}
+
+// MessageRevisions is the resolver for the messageRevisions field.
+func (r *queryResolver) MessageRevisions(ctx context.Context, chatID string, messageID string) ([]*models.MessageRevision, error) {
+	return r.ChatsResolver.MessageRevisions(ctx, chatID, messageID)
+}
+
+// PlannedDates is the resolver for the plannedDates field.
+func (r *queryResolver) PlannedDates(ctx context.Context, matchID *string, upcoming *bool) ([]*models.PlannedDate, error) {
+	return r.ChatsResolver.PlannedDates(ctx, matchID, upcoming)
+}
+
+// TrustedContacts is the resolver for the trustedContacts field.
+func (r *queryResolver) TrustedContacts(ctx context.Context) ([]*models.TrustedContact, error) {
+	return r.ChatsResolver.TrustedContacts(ctx)
+}
+
+// DateCheckIns is the resolver for the dateCheckIns field.
+func (r *queryResolver) DateCheckIns(ctx context.Context) ([]*models.DateCheckIn, error) {
+	return r.ChatsResolver.DateCheckIns(ctx)
+}
+
+// ChatExports is the resolver for the chatExports field.
+func (r *queryResolver) ChatExports(ctx context.Context, chatID string) ([]*models.ChatExport, error) {
+	return r.ChatsResolver.ChatExports(ctx, chatID)
+}
+
+// Count is the resolver for the count field.
+func (r *reactionCountResolver) Count(ctx context.Context, obj *models.ReactionCount) (int32, error) {
+	if obj == nil {
+		return 0, fmt.Errorf("reaction count is nil")
+	}
+	return int32(obj.Count), nil
+}
+
+// ChatEvents is the resolver for the chatEvents field.
+func (r *subscriptionResolver) ChatEvents(ctx context.Context, chatID string) (<-chan *model.ChatEvent, error) {
+	return r.ChatsResolver.ChatEvents(ctx, chatID)
+}
+
+// CallLog returns CallLogResolver implementation.
+func (r *Resolver) CallLog() CallLogResolver { return &callLogResolver{r} }
+
+// ChatExport returns ChatExportResolver implementation.
+func (r *Resolver) ChatExport() ChatExportResolver { return &chatExportResolver{r} }
+
+// ConnectionSettings returns ConnectionSettingsResolver implementation.
+func (r *Resolver) ConnectionSettings() ConnectionSettingsResolver {
+	return &connectionSettingsResolver{r}
 }
 
 // Match returns MatchResolver implementation.
@@ -47,5 +216,12 @@ func (r *Resolver) Match() MatchResolver { return &matchResolver{r} }
 // PostUnlockRating returns PostUnlockRatingResolver implementation.
 func (r *Resolver) PostUnlockRating() PostUnlockRatingResolver { return &postUnlockRatingResolver{r} }
 
+// ReactionCount returns ReactionCountResolver implementation.
+func (r *Resolver) ReactionCount() ReactionCountResolver { return &reactionCountResolver{r} }
+
+type callLogResolver struct{ *Resolver }
+type chatExportResolver struct{ *Resolver }
+type connectionSettingsResolver struct{ *Resolver }
 type matchResolver struct{ *Resolver }
 type postUnlockRatingResolver struct{ *Resolver }
+type reactionCountResolver struct{ *Resolver }
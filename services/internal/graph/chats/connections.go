@@ -0,0 +1,250 @@
+package chats
+
+import (
+	"blindly/internal/anal"
+	"blindly/internal/graph/directives"
+	"blindly/internal/graph/model"
+	"blindly/internal/models"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/utils"
+	"github.com/MelloB1989/karma/v2/orm"
+)
+
+const (
+	MaxConnectionsLimit = 100
+	MaxNicknameLength   = 40
+)
+
+// loadConnectionSettings returns the caller's settings keyed by match id.
+func loadConnectionSettings(userID string) (map[string]models.ConnectionSettings, error) {
+	settingsORM := orm.Load(&models.ConnectionSettings{})
+	defer settingsORM.Close()
+
+	var rows []models.ConnectionSettings
+	if err := settingsORM.GetByFieldEquals("UserId", userID).Scan(&rows); err != nil {
+		return nil, fmt.Errorf("failed to get connection settings: %w", err)
+	}
+
+	settings := make(map[string]models.ConnectionSettings, len(rows))
+	for _, row := range rows {
+		settings[row.MatchId] = row
+	}
+	return settings, nil
+}
+
+func isMuted(s *models.ConnectionSettings, now time.Time) bool {
+	return s != nil && s.MutedUntil != nil && s.MutedUntil.After(now)
+}
+
+func matchesFilter(conn *model.Connection, filter model.ConnectionFilter, now time.Time) bool {
+	s := conn.Settings
+	switch filter {
+	case model.ConnectionFilterAll:
+		return true
+	case model.ConnectionFilterArchived:
+		return s.Archived
+	case model.ConnectionFilterPinned:
+		return s.Pinned && !s.Archived
+	case model.ConnectionFilterUnread:
+		return conn.UnreadMessages > 0 && !s.Archived
+	case model.ConnectionFilterMuted:
+		return isMuted(s, now)
+	default:
+		return !s.Archived
+	}
+}
+
+// connectionSortTime is the time a connection is ordered by.
+func connectionSortTime(conn *model.Connection, sort model.ConnectionSort) time.Time {
+	if sort == model.ConnectionSortLastActivity {
+		return conn.LastActivityAt
+	}
+	if conn.Match != nil {
+		return conn.Match.MatchedAt
+	}
+	return time.Time{}
+}
+
+// connectionKey is a connection's position in a sort: pinned first, then
+// newest first, with the match id breaking ties so the order is total.
+type connectionKey struct {
+	pinned  bool
+	at      int64
+	matchID string
+}
+
+func keyOf(conn *model.Connection, sort model.ConnectionSort) connectionKey {
+	k := connectionKey{
+		pinned: conn.Settings.Pinned,
+		at:     connectionSortTime(conn, sort).UnixNano(),
+	}
+	if conn.Match != nil {
+		k.matchID = conn.Match.Id
+	}
+	return k
+}
+
+func compareKeys(a, b connectionKey) int {
+	if a.pinned != b.pinned {
+		if a.pinned {
+			return -1
+		}
+		return 1
+	}
+	if a.at != b.at {
+		if a.at > b.at {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(b.matchID, a.matchID)
+}
+
+func encodeConnectionCursor(k connectionKey) string {
+	pinned := "0"
+	if k.pinned {
+		pinned = "1"
+	}
+	raw := fmt.Sprintf("%s|%d|%s", pinned, k.at, k.matchID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeConnectionCursor(cursor string) (connectionKey, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return connectionKey{}, fmt.Errorf("invalid cursor")
+	}
+	parts := strings.SplitN(string(raw), "|", 3)
+	if len(parts) != 3 {
+		return connectionKey{}, fmt.Errorf("invalid cursor")
+	}
+	at, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return connectionKey{}, fmt.Errorf("invalid cursor")
+	}
+	return connectionKey{pinned: parts[0] == "1", at: at, matchID: parts[2]}, nil
+}
+
+// organizeConnections filters, sorts and pages conns. The cursor is the
+// position of the last connection already seen, so pages stay consistent
+// when connections before it move.
+func organizeConnections(conns []*model.Connection, filter model.ConnectionFilter, sort model.ConnectionSort, limit int, cursor string) ([]*model.Connection, error) {
+	now := time.Now()
+
+	out := make([]*model.Connection, 0, len(conns))
+	for _, conn := range conns {
+		if matchesFilter(conn, filter, now) {
+			out = append(out, conn)
+		}
+	}
+
+	slices.SortStableFunc(out, func(a, b *model.Connection) int {
+		return compareKeys(keyOf(a, sort), keyOf(b, sort))
+	})
+
+	for _, conn := range out {
+		conn.Cursor = encodeConnectionCursor(keyOf(conn, sort))
+	}
+
+	if cursor != "" {
+		after, err := decodeConnectionCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		start := len(out)
+		for i, conn := range out {
+			if compareKeys(keyOf(conn, sort), after) > 0 {
+				start = i
+				break
+			}
+		}
+		out = out[start:]
+	}
+
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (r *Resolver) UpdateConnectionSettings(ctx context.Context, matchID string, input model.ConnectionSettingsInput) (*models.ConnectionSettings, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	db, err := database.PostgresConn()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	var inMatch bool
+	err = db.QueryRow(`
+		SELECT EXISTS (SELECT 1 FROM matches WHERE id = $1 AND (she_id = $2 OR he_id = $2))
+	`, matchID, claims.UserID).Scan(&inMatch)
+	db.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check match: %w", err)
+	}
+	if !inMatch {
+		return nil, errors.New("match not found")
+	}
+
+	existing, err := loadConnectionSettings(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	settings, found := existing[matchID]
+	if !found {
+		settings = models.ConnectionSettings{
+			Id:      utils.GenerateID(10),
+			UserId:  claims.UserID,
+			MatchId: matchID,
+		}
+	}
+
+	if input.Pinned != nil {
+		settings.Pinned = *input.Pinned
+	}
+	if input.Archived != nil {
+		settings.Archived = *input.Archived
+	}
+	if input.MutedUntil != nil {
+		mutedUntil := *input.MutedUntil
+		settings.MutedUntil = &mutedUntil
+	}
+	if input.Unmute != nil && *input.Unmute {
+		settings.MutedUntil = nil
+	}
+	if input.Nickname != nil {
+		nickname := strings.TrimSpace(*input.Nickname)
+		if len([]rune(nickname)) > MaxNicknameLength {
+			return nil, fmt.Errorf("nickname must be at most %d characters", MaxNicknameLength)
+		}
+		settings.Nickname = nickname
+	}
+	settings.UpdatedAt = time.Now()
+
+	settingsORM := orm.Load(&models.ConnectionSettings{})
+	defer settingsORM.Close()
+
+	if found {
+		err = settingsORM.Update(&settings, settings.Id)
+	} else {
+		err = settingsORM.Insert(&settings)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save connection settings: %w", err)
+	}
+
+	return &settings, nil
+}
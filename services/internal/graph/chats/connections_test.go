@@ -0,0 +1,123 @@
+package chats
+
+import (
+	"blindly/internal/graph/model"
+	"blindly/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var matchedBase = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// connection builds a connection matched hours after matchedBase, with the
+// given settings.
+func connection(matchID string, hours int, settings models.ConnectionSettings) *model.Connection {
+	settings.MatchId = matchID
+	at := matchedBase.Add(time.Duration(hours) * time.Hour)
+	return &model.Connection{
+		Match:          &models.Match{Id: matchID, MatchedAt: at},
+		Settings:       &settings,
+		LastActivityAt: at,
+	}
+}
+
+func matchIDs(conns []*model.Connection) []string {
+	ids := make([]string, len(conns))
+	for i, conn := range conns {
+		ids[i] = conn.Match.Id
+	}
+	return ids
+}
+
+func TestOrganizeConnections(t *testing.T) {
+	mutedUntil := time.Now().Add(time.Hour)
+	mutedBefore := time.Now().Add(-time.Hour)
+	conns := func() []*model.Connection {
+		unread := connection("unread", 2, models.ConnectionSettings{})
+		unread.UnreadMessages = 3
+		quiet := connection("quiet", 6, models.ConnectionSettings{})
+		quiet.LastActivityAt = matchedBase.Add(10 * time.Hour)
+		return []*model.Connection{
+			connection("old", 1, models.ConnectionSettings{}),
+			unread,
+			connection("pinned", 3, models.ConnectionSettings{Pinned: true}),
+			connection("archived", 4, models.ConnectionSettings{Archived: true}),
+			connection("pinned-archived", 5, models.ConnectionSettings{Pinned: true, Archived: true}),
+			quiet,
+			connection("muted", 7, models.ConnectionSettings{MutedUntil: &mutedUntil}),
+			connection("unmuted", 8, models.ConnectionSettings{MutedUntil: &mutedBefore}),
+		}
+	}
+
+	cases := []struct {
+		name   string
+		filter model.ConnectionFilter
+		sort   model.ConnectionSort
+		want   []string
+	}{
+		{"active hides archived", model.ConnectionFilterActive, model.ConnectionSortMatchedAt,
+			[]string{"pinned", "unmuted", "muted", "quiet", "unread", "old"}},
+		{"all includes archived", model.ConnectionFilterAll, model.ConnectionSortMatchedAt,
+			[]string{"pinned-archived", "pinned", "unmuted", "muted", "quiet", "archived", "unread", "old"}},
+		{"archived only", model.ConnectionFilterArchived, model.ConnectionSortMatchedAt,
+			[]string{"pinned-archived", "archived"}},
+		{"pinned skips archived", model.ConnectionFilterPinned, model.ConnectionSortMatchedAt,
+			[]string{"pinned"}},
+		{"unread", model.ConnectionFilterUnread, model.ConnectionSortMatchedAt,
+			[]string{"unread"}},
+		{"muted until later", model.ConnectionFilterMuted, model.ConnectionSortMatchedAt,
+			[]string{"muted"}},
+		{"by last activity", model.ConnectionFilterActive, model.ConnectionSortLastActivity,
+			[]string{"pinned", "quiet", "unmuted", "muted", "unread", "old"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := organizeConnections(conns(), tc.filter, tc.sort, 0, "")
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, matchIDs(out))
+		})
+	}
+}
+
+func TestConnectionCursors(t *testing.T) {
+	conns := []*model.Connection{
+		connection("a", 1, models.ConnectionSettings{}),
+		connection("b", 2, models.ConnectionSettings{}),
+		connection("c", 3, models.ConnectionSettings{Pinned: true}),
+		connection("d", 4, models.ConnectionSettings{}),
+		connection("e", 4, models.ConnectionSettings{}), // ties with d
+		connection("f", 5, models.ConnectionSettings{}),
+	}
+	all, err := organizeConnections(conns, model.ConnectionFilterActive, model.ConnectionSortLastActivity, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "f", "e", "d", "b", "a"}, matchIDs(all))
+
+	// Walking two at a time visits everything once, in order.
+	var paged []string
+	cursor := ""
+	for {
+		page, err := organizeConnections(conns, model.ConnectionFilterActive, model.ConnectionSortLastActivity, 2, cursor)
+		require.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		paged = append(paged, matchIDs(page)...)
+		cursor = page[len(page)-1].Cursor
+	}
+	assert.Equal(t, matchIDs(all), paged)
+
+	// Activity on a connection already paged past moves it to the top, but
+	// the next page still starts right after the cursor.
+	first, err := organizeConnections(conns, model.ConnectionFilterActive, model.ConnectionSortLastActivity, 3, "")
+	require.NoError(t, err)
+	conns[3].LastActivityAt = matchedBase.Add(24 * time.Hour) // d
+	next, err := organizeConnections(conns, model.ConnectionFilterActive, model.ConnectionSortLastActivity, 3, first[2].Cursor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "a"}, matchIDs(next))
+
+	_, err = organizeConnections(conns, model.ConnectionFilterActive, model.ConnectionSortLastActivity, 3, "not a cursor")
+	assert.Error(t, err)
+}
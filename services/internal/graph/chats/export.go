@@ -0,0 +1,47 @@
+package chats
+
+import (
+	"blindly/internal/anal"
+	chatservice "blindly/internal/chat_service"
+	"blindly/internal/graph/directives"
+	"blindly/internal/graph/model"
+	"blindly/internal/models"
+	"context"
+	"fmt"
+	"strings"
+)
+
+func (r *Resolver) ExportChat(ctx context.Context, chatID string, format model.ChatExportFormat) (*models.ChatExport, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	return store.RequestExport(claims.UserID, strings.ToLower(string(format)))
+}
+
+func (r *Resolver) ChatExports(ctx context.Context, chatID string) ([]*models.ChatExport, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	exports, err := chatservice.ChatExports(claims.UserID, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*models.ChatExport, len(exports))
+	for i := range exports {
+		out[i] = &exports[i]
+	}
+	return out, nil
+}
@@ -2,6 +2,7 @@ package chats
 
 import (
 	"blindly/internal/anal"
+	chatservice "blindly/internal/chat_service"
 	"blindly/internal/graph/directives"
 	"blindly/internal/graph/model"
 	"blindly/internal/graph/shared"
@@ -9,29 +10,36 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/utils"
 )
 
 type Resolver struct {
+	// newStore opens a chat for one of its participants. Tests stand in for
+	// the membership lookup, which needs Postgres.
+	newStore func(chatId string, userId string) (*chatservice.Store, error)
 }
 
 func NewResolver() *Resolver {
-	return &Resolver{}
+	return &Resolver{newStore: chatservice.NewStore}
 }
 
 type connRow struct {
 	ChatJSON           json.RawMessage
 	MatchJSON          json.RawMessage
-	LastMessage        sql.NullString
 	PercentageComplete sql.NullFloat64
 	ProfileJSON        json.RawMessage
+	ReadCursor         sql.NullString
 }
 
-func (r *Resolver) GetMyConnections(ctx context.Context) ([]*model.Connection, error) {
+func (r *Resolver) GetMyConnections(ctx context.Context, filter *model.ConnectionFilter, sort *model.ConnectionSort, limit *int32, cursor *string) ([]*model.Connection, error) {
 	claims, ae, err := directives.GetAuthClaims(ctx)
 	if err != nil {
 		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
@@ -50,11 +58,6 @@ func (r *Resolver) GetMyConnections(ctx context.Context) ([]*model.Connection, e
   row_to_json(c) AS chat,
   row_to_json(m) AS match,
 
-  CASE
-    WHEN c.messages IS NULL OR jsonb_array_length(c.messages::jsonb) = 0 THEN NULL
-    ELSE (c.messages::jsonb -> (jsonb_array_length(c.messages::jsonb) - 1)) ->> 'content'
-  END AS last_message,
-
   (
     LEAST(
       (COALESCE(jsonb_array_length(c.messages::jsonb), 0)::float / 500.0) * 100.0,
@@ -62,9 +65,11 @@ func (r *Resolver) GetMyConnections(ctx context.Context) ([]*model.Connection, e
     )
   ) AS percentage_complete,
 
-  row_to_json(u) AS connection_profile
+  row_to_json(u) AS connection_profile,
+  rc.message_id AS read_cursor
 FROM matches m
 LEFT JOIN chats c ON c.match_id = m.id::text
+LEFT JOIN chat_read_cursors rc ON rc.chat_id = c.id AND rc.user_id = $1
 JOIN users u ON u.id = CASE WHEN m.she_id = $1 THEN m.he_id ELSE m.she_id END
 WHERE m.she_id = $1 OR m.he_id = $1
 ORDER BY m.matched_at DESC;
@@ -80,7 +85,7 @@ ORDER BY m.matched_at DESC;
 	var rows []connRow
 	for dbRows.Next() {
 		var row connRow
-		if err := dbRows.Scan(&row.ChatJSON, &row.MatchJSON, &row.LastMessage, &row.PercentageComplete, &row.ProfileJSON); err != nil {
+		if err := dbRows.Scan(&row.ChatJSON, &row.MatchJSON, &row.PercentageComplete, &row.ProfileJSON, &row.ReadCursor); err != nil {
 			log.Printf("[ERROR] Row scan error: %v", err)
 			return nil, fmt.Errorf("row scan error: %w", err)
 		}
@@ -91,7 +96,15 @@ ORDER BY m.matched_at DESC;
 		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
+	settings, err := loadConnectionSettings(claims.UserID)
+	if err != nil {
+		log.Printf("[ERROR] %v", err)
+		return nil, err
+	}
+
 	conns := make([]*model.Connection, 0, len(rows))
+	chats := make([]models.Chat, 0, len(rows))
+	cursors := make(map[string]string, len(rows))
 	for _, rrow := range rows {
 		var chat models.Chat
 		if len(rrow.ChatJSON) > 0 {
@@ -123,20 +136,15 @@ ORDER BY m.matched_at DESC;
 			profile = dbProfile.ToUserPublic()
 		}
 
+		// Messages are sealed at rest; only the last one is needed here.
 		lastMsg := ""
-		if rrow.LastMessage.Valid {
-			lastMsg = rrow.LastMessage.String
-		}
-
-		var unread int32 = 0
-		if len(chat.Messages) > 0 {
-			for i := len(chat.Messages) - 1; i >= 0; i-- {
-				if chat.Messages[i].SenderId != claims.UserID && !chat.Messages[i].Seen {
-					unread++
-				} else {
-					break
-				}
+		if n := len(chat.Messages); n > 0 {
+			last := chat.Messages[n-1]
+			if err := chatservice.OpenMessage(chat.Id, &last); err != nil {
+				log.Printf("[ERROR] Failed to open last message of chat %s: %v", chat.Id, err)
+				return nil, fmt.Errorf("failed to open last message: %w", err)
 			}
+			lastMsg = last.Content
 		}
 
 		var pct float64 = 0
@@ -150,19 +158,546 @@ ORDER BY m.matched_at DESC;
 			Chat:               nil,
 			Match:              nil,
 			LastMessage:        lastMsg,
-			UnreadMessages:     unread,
 			PercentageComplete: pct,
 			ConnectionProfile:  profile,
 		}
 		if chat.Id != "" {
 			conn.Chat = &chat
+			chats = append(chats, chat)
+			if rrow.ReadCursor.Valid {
+				cursors[chat.Id] = rrow.ReadCursor.String
+			}
 		}
 		if match.Id != "" {
 			conn.Match = &match
 		}
+		s, ok := settings[match.Id]
+		if !ok {
+			s = models.ConnectionSettings{UserId: claims.UserID, MatchId: match.Id}
+		}
+		conn.Settings = &s
+		conn.LastActivityAt = match.MatchedAt
 
 		conns = append(conns, conn)
 	}
 
-	return conns, nil
+	unread, err := chatservice.UnreadCounts(claims.UserID, chats, cursors)
+	if err != nil {
+		log.Printf("[ERROR] Failed to count unread messages: %v", err)
+		return nil, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+	activity, err := chatservice.LastActivity(chats)
+	if err != nil {
+		log.Printf("[ERROR] Failed to load chat activity: %v", err)
+		return nil, fmt.Errorf("failed to load chat activity: %w", err)
+	}
+	for _, conn := range conns {
+		if conn.Chat != nil {
+			conn.UnreadMessages = int32(unread[conn.Chat.Id])
+			if at, ok := activity[conn.Chat.Id]; ok {
+				conn.LastActivityAt = at
+			}
+		}
+	}
+
+	f := model.ConnectionFilterActive
+	if filter != nil {
+		f = *filter
+	}
+	order := model.ConnectionSortMatchedAt
+	if sort != nil {
+		order = *sort
+	}
+	n := 0
+	if limit != nil && *limit > 0 {
+		n = min(int(*limit), MaxConnectionsLimit)
+	}
+	after := ""
+	if cursor != nil {
+		after = *cursor
+	}
+
+	return organizeConnections(conns, f, order, n, after)
+}
+
+func (r *Resolver) SendMessage(ctx context.Context, input model.SendMessageInput) (*models.Message, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(input.ChatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	msg, err := buildMessage(claims.UserID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.SendMessage(msg); err != nil {
+		var modErr *chatservice.ModerationError
+		if !errors.As(err, &modErr) || !modErr.Delivered() {
+			return nil, err
+		}
+	}
+
+	return msg, nil
+}
+
+// buildMessage turns a send input into a new message from userId, resolving
+// uploaded media.
+func buildMessage(userId string, input model.SendMessageInput) (*models.Message, error) {
+	now := time.Now()
+	msg := &models.Message{
+		Id:        strings.ToUpper(utils.GenerateID(20)),
+		SenderId:  userId,
+		Type:      input.Type,
+		Content:   input.Content,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if input.ReplyToID != nil {
+		msg.ReplyToId = *input.ReplyToID
+	}
+	if input.Type == models.DATE_PROPOSAL {
+		msg.DateProposal = dateProposalFromInput(input.DateProposal)
+	}
+	for _, media := range input.Media {
+		if media == nil {
+			continue
+		}
+		m := models.Media{
+			Id:        strings.ToUpper(utils.GenerateID(20)),
+			Type:      string(media.Type),
+			Url:       media.URL,
+			CreatedAt: now,
+		}
+		if media.FileID != nil {
+			m.FileId = *media.FileID
+		}
+		if media.ViewOnce != nil {
+			m.ViewOnce = *media.ViewOnce
+		}
+		if err := chatservice.ResolveMediaFile(userId, &m); err != nil {
+			return nil, err
+		}
+		msg.Media = append(msg.Media, m)
+	}
+
+	return msg, nil
+}
+
+func dateProposalFromInput(input *model.DateProposalInput) *models.DateProposal {
+	if input == nil {
+		return nil
+	}
+	p := &models.DateProposal{StartsAt: input.StartsAt, Venue: input.Venue}
+	if input.Note != nil {
+		p.Note = *input.Note
+	}
+	return p
+}
+
+func (r *Resolver) RespondToDateProposal(ctx context.Context, chatID string, messageID string, action chatservice.DateAction, counter *model.DateProposalInput) (*chatservice.DateResponse, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	return store.RespondToDateProposal(claims.UserID, messageID, action, dateProposalFromInput(counter))
+}
+
+func (r *Resolver) PlannedDates(ctx context.Context, matchID *string, upcoming *bool) ([]*models.PlannedDate, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	var match string
+	if matchID != nil {
+		match = *matchID
+	}
+	dates, err := chatservice.PlannedDates(claims.UserID, match, upcoming != nil && *upcoming)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*models.PlannedDate, len(dates))
+	for i := range dates {
+		out[i] = &dates[i]
+	}
+	return out, nil
+}
+
+func (r *Resolver) ScheduleMessage(ctx context.Context, input model.SendMessageInput, deliverAt time.Time) (*models.ScheduledMessage, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(input.ChatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	msg, err := buildMessage(claims.UserID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.ScheduleMessage(msg, deliverAt)
+}
+
+func (r *Resolver) ScheduledMessages(ctx context.Context, chatID string) ([]*models.ScheduledMessage, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	scheduled, err := store.ListScheduledMessages(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*models.ScheduledMessage, len(scheduled))
+	for i := range scheduled {
+		out[i] = &scheduled[i]
+	}
+	return out, nil
+}
+
+func (r *Resolver) MessageRevisions(ctx context.Context, chatID string, messageID string) ([]*models.MessageRevision, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	revisions, err := store.MessageRevisions(claims.UserID, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*models.MessageRevision, len(revisions))
+	for i := range revisions {
+		out[i] = &revisions[i]
+	}
+	return out, nil
+}
+
+func (r *Resolver) CancelScheduledMessage(ctx context.Context, chatID string, id string) (bool, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return false, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return false, err
+	}
+	defer store.Close()
+
+	if err := store.CancelScheduledMessage(id, claims.UserID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *Resolver) MarkSeen(ctx context.Context, chatID string, messageIds []string) (bool, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return false, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	if len(messageIds) == 0 {
+		return false, fmt.Errorf("message_ids is required")
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return false, err
+	}
+	defer store.Close()
+
+	if err := store.MarkMessagesSeen(messageIds, claims.UserID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *Resolver) React(ctx context.Context, chatID string, messageID string, reaction string) (*models.Message, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	if strings.TrimSpace(reaction) == "" {
+		return nil, fmt.Errorf("reaction is required")
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	return store.AddReaction(messageID, claims.UserID, reaction)
+}
+
+func (r *Resolver) Unreact(ctx context.Context, chatID string, messageID string) (*models.Message, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	return store.RemoveReaction(messageID, claims.UserID)
+}
+
+func (r *Resolver) OpenViewOnce(ctx context.Context, chatID string, messageID string, mediaID string) (*chatservice.MediaGrant, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	return store.OpenViewOnce(messageID, mediaID, claims.UserID)
+}
+
+// ChatEvents streams a chat's pub/sub events to a GraphQL subscriber. Like the
+// chat socket, it forwards the user's activity on other devices and
+// acknowledges delivery of incoming messages.
+func (r *Resolver) ChatEvents(ctx context.Context, chatID string) (<-chan *model.ChatEvent, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	store, err := r.newStore(chatID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	connId := strings.ToUpper(utils.GenerateID(12))
+	store.SetOrigin(connId)
+
+	sub := store.Subscribe()
+	events := make(chan *model.ChatEvent, 1)
+
+	go func() {
+		<-ctx.Done()
+		sub.Close()
+	}()
+
+	go func() {
+		defer close(events)
+		defer store.Close()
+
+		for {
+			event, err := sub.ReceiveEvent()
+			if err != nil {
+				return
+			}
+
+			out := toChatEvent(chatID, claims.UserID, connId, event)
+			if out == nil {
+				continue
+			}
+
+			select {
+			case events <- out:
+			case <-ctx.Done():
+				return
+			}
+
+			if out.Type == model.ChatEventTypeMessage && out.Message.SenderId != claims.UserID {
+				if err := store.MarkMessagesReceived([]string{out.Message.Id}, claims.UserID); err != nil {
+					log.Printf("failed to mark message %s received: %v", out.Message.Id, err)
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func toChatEvent(chatID string, userID string, connId string, event *chatservice.PubSubEvent) *model.ChatEvent {
+	if event.IsEcho(connId) {
+		return nil
+	}
+
+	out := &model.ChatEvent{
+		ChatID:    chatID,
+		Timestamp: time.Now(),
+	}
+
+	switch event.Type {
+	case chatservice.MessageEventMessage, chatservice.MessageEventUpdate:
+		if event.Message == nil {
+			return nil
+		}
+		out.Type = model.ChatEventTypeMessage
+		if event.Type == chatservice.MessageEventUpdate {
+			out.Type = model.ChatEventTypeUpdate
+			if event.Change != "" {
+				change := string(event.Change)
+				out.Change = &change
+			}
+		}
+		out.Message = event.Message
+
+	case chatservice.MessageEventSeen, chatservice.MessageEventReceived:
+		var receipt chatservice.ReceiptEvent
+		if err := json.Unmarshal(event.Data, &receipt); err != nil {
+			return nil
+		}
+		if receipt.UserId == userID && event.Type == chatservice.MessageEventReceived {
+			return nil
+		}
+		out.Type = model.ChatEventTypeSeen
+		if event.Type == chatservice.MessageEventReceived {
+			out.Type = model.ChatEventTypeReceived
+		}
+		out.MessageIds = receipt.MessageIds
+		out.UserID = &receipt.UserId
+		out.Timestamp = receipt.Timestamp
+
+	case chatservice.MessageEventTyping:
+		var typing chatservice.TypingEvent
+		if err := json.Unmarshal(event.Data, &typing); err != nil || typing.UserId == userID {
+			return nil
+		}
+		out.Type = model.ChatEventTypeTyping
+		out.UserID = &typing.UserId
+		out.IsTyping = &typing.IsTyping
+		out.Timestamp = typing.Timestamp
+
+	case chatservice.MessageEventPresence:
+		var presence chatservice.PresenceEvent
+		if err := json.Unmarshal(event.Data, &presence); err != nil || presence.UserId == userID {
+			return nil
+		}
+		out.Type = model.ChatEventTypePresence
+		out.UserID = &presence.UserId
+		out.IsOnline = &presence.IsOnline
+		out.LastSeenAt = presence.LastSeenAt
+
+	default:
+		return nil
+	}
+
+	return out
+}
+
+// GetUnreadBadge sums the caller's unread messages across every chat that is
+// not archived or muted.
+func (r *Resolver) GetUnreadBadge(ctx context.Context) (int32, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return 0, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	db, err := database.PostgresConn()
+	if err != nil {
+		log.Printf("[ERROR] Failed to connect to database: %v", err)
+		return 0, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	dbRows, err := db.Query(`
+SELECT row_to_json(c) AS chat, rc.message_id AS read_cursor
+FROM matches m
+JOIN chats c ON c.match_id = m.id::text
+LEFT JOIN chat_read_cursors rc ON rc.chat_id = c.id AND rc.user_id = $1
+LEFT JOIN connection_settings cs ON cs.match_id = m.id::text AND cs.user_id = $1
+WHERE (m.she_id = $1 OR m.he_id = $1)
+  AND NOT COALESCE(cs.archived, false)
+  AND (cs.muted_until IS NULL OR cs.muted_until <= NOW());
+`, claims.UserID)
+	if err != nil {
+		log.Printf("[ERROR] Query error: %v", err)
+		return 0, fmt.Errorf("query error: %w", err)
+	}
+	defer dbRows.Close()
+
+	var chats []models.Chat
+	cursors := make(map[string]string)
+	for dbRows.Next() {
+		var chatJSON json.RawMessage
+		var cursor sql.NullString
+		if err := dbRows.Scan(&chatJSON, &cursor); err != nil {
+			log.Printf("[ERROR] Row scan error: %v", err)
+			return 0, fmt.Errorf("row scan error: %w", err)
+		}
+		var dbChat shared.DBChat
+		if err := json.Unmarshal(chatJSON, &dbChat); err != nil {
+			return 0, fmt.Errorf("unmarshal chat json error: %w", err)
+		}
+		chat := dbChat.ToChat()
+		chats = append(chats, chat)
+		if cursor.Valid {
+			cursors[chat.Id] = cursor.String
+		}
+	}
+	if err := dbRows.Err(); err != nil {
+		log.Printf("[ERROR] Rows iteration error: %v", err)
+		return 0, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	unread, err := chatservice.UnreadCounts(claims.UserID, chats, cursors)
+	if err != nil {
+		log.Printf("[ERROR] Failed to count unread messages: %v", err)
+		return 0, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+
+	var total int32
+	for _, n := range unread {
+		total += int32(n)
+	}
+	return total, nil
 }
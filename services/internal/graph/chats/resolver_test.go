@@ -0,0 +1,171 @@
+package chats
+
+import (
+	analytics "blindly/internal/anal"
+	chatservice "blindly/internal/chat_service"
+	"blindly/internal/graph/directives"
+	"blindly/internal/graph/model"
+	"blindly/internal/models"
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryResolver returns a resolver whose chats live on a fresh memory
+// backend, with members standing in for the participants of each chat.
+func memoryResolver(t *testing.T, members map[string][]string) (*Resolver, chatservice.Backend) {
+	t.Helper()
+	backend := chatservice.NewMemoryBackend()
+	chatservice.UseBackend(backend)
+	t.Cleanup(func() { chatservice.UseBackend(nil) })
+
+	return &Resolver{newStore: func(chatId string, userId string) (*chatservice.Store, error) {
+		if !slices.Contains(members[chatId], userId) {
+			return nil, chatservice.ErrUnauthorized
+		}
+		return chatservice.NewStoreWithoutAuth(chatId), nil
+	}}, backend
+}
+
+func asUser(ctx context.Context, userId string) context.Context {
+	ctx = context.WithValue(ctx, directives.ClaimsContextKey, &models.Claims{UserID: userId})
+	return context.WithValue(ctx, directives.AnalyticsContextKey, (*analytics.AnalyticsEngine)(nil))
+}
+
+func TestSendMessage(t *testing.T) {
+	r, backend := memoryResolver(t, map[string][]string{"chat-1": {"user-1", "user-2"}})
+
+	msg, err := r.SendMessage(asUser(context.Background(), "user-1"), model.SendMessageInput{
+		ChatID:  "chat-1",
+		Type:    models.TEXT,
+		Content: "hi",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, msg.Id)
+	assert.Equal(t, "user-1", msg.SenderId)
+	assert.Equal(t, "hi", msg.Content)
+	assert.Equal(t, msg.CreatedAt, msg.UpdatedAt)
+
+	// Only date proposals keep their proposal.
+	replyTo := "M0"
+	built, err := buildMessage("user-1", model.SendMessageInput{
+		Type:         models.TEXT,
+		Content:      "x",
+		ReplyToID:    &replyTo,
+		DateProposal: &model.DateProposalInput{Venue: "Cafe"},
+		Media:        []*model.ChatMediaInput{nil, {Type: "image", URL: "https://example.com/a.jpg"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "M0", built.ReplyToId)
+	assert.Nil(t, built.DateProposal)
+	require.Len(t, built.Media, 1)
+	assert.Equal(t, "https://example.com/a.jpg", built.Media[0].Url)
+	assert.NotEmpty(t, built.Media[0].Id)
+
+	// A caller outside the chat can neither write to it nor listen in.
+	_, err = r.SendMessage(asUser(context.Background(), "user-3"), model.SendMessageInput{
+		ChatID:  "chat-1",
+		Type:    models.TEXT,
+		Content: "let me in",
+	})
+	assert.ErrorIs(t, err, chatservice.ErrUnauthorized)
+	_, err = r.ChatEvents(asUser(context.Background(), "user-3"), "chat-1")
+	assert.ErrorIs(t, err, chatservice.ErrUnauthorized)
+
+	n, err := backend.BufferLen("chat-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestChatEvents(t *testing.T) {
+	r, _ := memoryResolver(t, map[string][]string{"chat-1": {"user-1", "user-2"}})
+
+	ctx, cancel := context.WithCancel(asUser(context.Background(), "user-1"))
+	defer cancel()
+	events, err := r.ChatEvents(ctx, "chat-1")
+	require.NoError(t, err)
+
+	sent, err := r.SendMessage(asUser(context.Background(), "user-2"), model.SendMessageInput{
+		ChatID:  "chat-1",
+		Type:    models.TEXT,
+		Content: "hey",
+	})
+	require.NoError(t, err)
+
+	select {
+	case event := <-events:
+		assert.Equal(t, model.ChatEventTypeMessage, event.Type)
+		assert.Equal(t, "chat-1", event.ChatID)
+		assert.Equal(t, sent.Id, event.Message.Id)
+	case <-time.After(time.Second):
+		t.Fatal("expected the message to be streamed")
+	}
+
+	// Streaming the message to user-1 delivers it.
+	store := chatservice.NewStoreWithoutAuth("chat-1")
+	defer store.Close()
+	assert.Eventually(t, func() bool {
+		msg, err := store.GetMessageById(sent.Id)
+		return err == nil && msg.Received
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestToChatEvent(t *testing.T) {
+	msg := &models.Message{Id: "m1", SenderId: "user-2"}
+	receipt := func(userId string) json.RawMessage {
+		data, _ := json.Marshal(chatservice.ReceiptEvent{MessageIds: []string{"m1"}, UserId: userId})
+		return data
+	}
+	typing := func(userId string) json.RawMessage {
+		data, _ := json.Marshal(chatservice.TypingEvent{UserId: userId, IsTyping: true})
+		return data
+	}
+	presence, _ := json.Marshal(chatservice.PresenceEvent{UserId: "user-2", IsOnline: true})
+
+	cases := []struct {
+		name  string
+		event chatservice.PubSubEvent
+		want  model.ChatEventType // "" when the event is dropped
+	}{
+		{"message", chatservice.PubSubEvent{Type: chatservice.MessageEventMessage, Message: msg}, model.ChatEventTypeMessage},
+		{"message without body", chatservice.PubSubEvent{Type: chatservice.MessageEventMessage}, ""},
+		{"update", chatservice.PubSubEvent{Type: chatservice.MessageEventUpdate, Message: msg, Change: chatservice.ChangeReaction}, model.ChatEventTypeUpdate},
+		{"echo", chatservice.PubSubEvent{Type: chatservice.MessageEventMessage, Message: msg, Origin: "conn-1"}, ""},
+		{"other device", chatservice.PubSubEvent{Type: chatservice.MessageEventMessage, Message: msg, Origin: "conn-2"}, model.ChatEventTypeMessage},
+		{"seen by peer", chatservice.PubSubEvent{Type: chatservice.MessageEventSeen, Data: receipt("user-2")}, model.ChatEventTypeSeen},
+		{"seen on other device", chatservice.PubSubEvent{Type: chatservice.MessageEventSeen, Data: receipt("user-1")}, model.ChatEventTypeSeen},
+		{"received by peer", chatservice.PubSubEvent{Type: chatservice.MessageEventReceived, Data: receipt("user-2")}, model.ChatEventTypeReceived},
+		{"received by self", chatservice.PubSubEvent{Type: chatservice.MessageEventReceived, Data: receipt("user-1")}, ""},
+		{"peer typing", chatservice.PubSubEvent{Type: chatservice.MessageEventTyping, Data: typing("user-2")}, model.ChatEventTypeTyping},
+		{"own typing", chatservice.PubSubEvent{Type: chatservice.MessageEventTyping, Data: typing("user-1")}, ""},
+		{"presence", chatservice.PubSubEvent{Type: chatservice.MessageEventPresence, Data: presence}, model.ChatEventTypePresence},
+		{"call", chatservice.PubSubEvent{Type: chatservice.MessageEventCall}, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := toChatEvent("chat-1", "user-1", "conn-1", &tc.event)
+			if tc.want == "" {
+				assert.Nil(t, out)
+				return
+			}
+			require.NotNil(t, out)
+			assert.Equal(t, tc.want, out.Type)
+			assert.Equal(t, "chat-1", out.ChatID)
+			if tc.event.Change != "" {
+				require.NotNil(t, out.Change)
+				assert.Equal(t, string(tc.event.Change), *out.Change)
+			} else {
+				assert.Nil(t, out.Change)
+			}
+		})
+	}
+}
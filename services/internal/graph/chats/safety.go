@@ -0,0 +1,119 @@
+package chats
+
+import (
+	"blindly/internal/anal"
+	chatservice "blindly/internal/chat_service"
+	"blindly/internal/graph/directives"
+	"blindly/internal/models"
+	"context"
+	"fmt"
+	"time"
+)
+
+func (r *Resolver) TrustedContacts(ctx context.Context) ([]*models.TrustedContact, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	contacts, err := chatservice.TrustedContacts(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*models.TrustedContact, len(contacts))
+	for i := range contacts {
+		out[i] = &contacts[i]
+	}
+	return out, nil
+}
+
+func (r *Resolver) AddTrustedContact(ctx context.Context, name string, email string) (*models.TrustedContact, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	return chatservice.AddTrustedContact(claims.UserID, name, email)
+}
+
+func (r *Resolver) RemoveTrustedContact(ctx context.Context, id string) (bool, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return false, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	if err := chatservice.RemoveTrustedContact(claims.UserID, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *Resolver) ShareDate(ctx context.Context, dateID string) (int32, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return 0, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	sent, err := chatservice.ShareDate(claims.UserID, dateID)
+	if err != nil {
+		return 0, err
+	}
+	return int32(sent), nil
+}
+
+func (r *Resolver) DateCheckIns(ctx context.Context) ([]*models.DateCheckIn, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	checkIns, err := chatservice.ListCheckIns(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*models.DateCheckIn, len(checkIns))
+	for i := range checkIns {
+		out[i] = &checkIns[i]
+	}
+	return out, nil
+}
+
+func (r *Resolver) ScheduleCheckIn(ctx context.Context, dateID string, checkInAt time.Time) (*models.DateCheckIn, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	return chatservice.ScheduleCheckIn(claims.UserID, dateID, checkInAt)
+}
+
+func (r *Resolver) CheckIn(ctx context.Context, id string) (*models.DateCheckIn, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	return chatservice.CheckIn(claims.UserID, id)
+}
+
+func (r *Resolver) CancelCheckIn(ctx context.Context, id string) (bool, error) {
+	claims, ae, err := directives.GetAuthClaims(ctx)
+	if err != nil {
+		ae.SendRequestError(anal.UNAUTHORIZED_401, err)
+		return false, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	if err := chatservice.CancelCheckIn(claims.UserID, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/MelloB1989/karma/config"
 	"github.com/MelloB1989/karma/utils"
 	"github.com/dgrijalva/jwt-go"
@@ -63,6 +64,26 @@ func AuthDirective(ctx context.Context, obj any, next graphql.Resolver) (any, er
 	return next(ctx)
 }
 
+// WebsocketInit copies the Authorization value from a subscription's
+// connection_init payload onto the request seen by AuthDirective, since
+// browsers cannot set headers on websocket upgrades.
+func WebsocketInit(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+	authHeader := initPayload.Authorization()
+	reqCtx, ok := ctx.Value("httpRequest").(*http.Request)
+	if authHeader == "" || !ok || reqCtx == nil {
+		return ctx, &initPayload, nil
+	}
+
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		authHeader = "Bearer " + authHeader
+	}
+
+	req := reqCtx.Clone(ctx)
+	req.Header.Set("Authorization", authHeader)
+
+	return context.WithValue(ctx, "httpRequest", req), &initPayload, nil
+}
+
 func GetAuthClaims(ctx context.Context) (*models.Claims, *analytics.AnalyticsEngine, error) {
 	claims, ok := ctx.Value(ClaimsContextKey).(*models.Claims)
 	if !ok {
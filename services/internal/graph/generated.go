@@ -3,6 +3,7 @@
 package graph
 
 import (
+	chatservice "blindly/internal/chat_service"
 	"blindly/internal/graph/directives"
 	"blindly/internal/graph/model"
 	"blindly/internal/models"
@@ -42,13 +43,18 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	CallLog() CallLogResolver
+	ChatExport() ChatExportResolver
 	Comment() CommentResolver
+	ConnectionSettings() ConnectionSettingsResolver
 	Match() MatchResolver
 	Media() MediaResolver
 	Mutation() MutationResolver
 	Post() PostResolver
 	PostUnlockRating() PostUnlockRatingResolver
 	Query() QueryResolver
+	ReactionCount() ReactionCountResolver
+	Subscription() SubscriptionResolver
 	User() UserResolver
 	UserProfileActivity() UserProfileActivityResolver
 }
@@ -69,12 +75,66 @@ type ComplexityRoot struct {
 		User        func(childComplexity int) int
 	}
 
+	CallLog struct {
+		AnsweredAt  func(childComplexity int) int
+		CallId      func(childComplexity int) int
+		DurationSec func(childComplexity int) int
+		EndedAt     func(childComplexity int) int
+		EndedBy     func(childComplexity int) int
+		Media       func(childComplexity int) int
+		StartedAt   func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
+
 	Chat struct {
 		CreatedAt func(childComplexity int) int
 		Id        func(childComplexity int) int
 		MatchId   func(childComplexity int) int
 	}
 
+	ChatEvent struct {
+		Change     func(childComplexity int) int
+		ChatID     func(childComplexity int) int
+		IsOnline   func(childComplexity int) int
+		IsTyping   func(childComplexity int) int
+		LastSeenAt func(childComplexity int) int
+		Message    func(childComplexity int) int
+		MessageIds func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+		Type       func(childComplexity int) int
+		UserID     func(childComplexity int) int
+	}
+
+	ChatExport struct {
+		ChatId      func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		DownloadURL func(childComplexity int) int
+		Error       func(childComplexity int) int
+		Format      func(childComplexity int) int
+		Id          func(childComplexity int) int
+		Status      func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+	}
+
+	ChatMessage struct {
+		Call           func(childComplexity int) int
+		Content        func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		DateProposal   func(childComplexity int) int
+		EditedAt       func(childComplexity int) int
+		Id             func(childComplexity int) int
+		Media          func(childComplexity int) int
+		ReactionCounts func(childComplexity int) int
+		Reactions      func(childComplexity int) int
+		Received       func(childComplexity int) int
+		ReplyTo        func(childComplexity int) int
+		ReplyToId      func(childComplexity int) int
+		Seen           func(childComplexity int) int
+		SenderId       func(childComplexity int) int
+		Type           func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
 	Comment struct {
 		Content   func(childComplexity int) int
 		CreatedAt func(childComplexity int) int
@@ -96,12 +156,50 @@ type ComplexityRoot struct {
 	Connection struct {
 		Chat               func(childComplexity int) int
 		ConnectionProfile  func(childComplexity int) int
+		Cursor             func(childComplexity int) int
+		LastActivityAt     func(childComplexity int) int
 		LastMessage        func(childComplexity int) int
 		Match              func(childComplexity int) int
 		PercentageComplete func(childComplexity int) int
+		Settings           func(childComplexity int) int
 		UnreadMessages     func(childComplexity int) int
 	}
 
+	ConnectionSettings struct {
+		Archived   func(childComplexity int) int
+		IsMuted    func(childComplexity int) int
+		MatchId    func(childComplexity int) int
+		MutedUntil func(childComplexity int) int
+		Nickname   func(childComplexity int) int
+		Pinned     func(childComplexity int) int
+	}
+
+	DateCheckIn struct {
+		AlertedAt   func(childComplexity int) int
+		CheckInAt   func(childComplexity int) int
+		CheckedInAt func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		DateId      func(childComplexity int) int
+		Id          func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
+
+	DateProposal struct {
+		CounterId   func(childComplexity int) int
+		Note        func(childComplexity int) int
+		RespondedAt func(childComplexity int) int
+		RespondedBy func(childComplexity int) int
+		StartsAt    func(childComplexity int) int
+		Status      func(childComplexity int) int
+		Venue       func(childComplexity int) int
+	}
+
+	DateProposalResponse struct {
+		Counter  func(childComplexity int) int
+		Date     func(childComplexity int) int
+		Proposal func(childComplexity int) int
+	}
+
 	ExtraMetadata struct {
 		Drinking   func(childComplexity int) int
 		Ethnicity  func(childComplexity int) int
@@ -130,30 +228,72 @@ type ComplexityRoot struct {
 	Media struct {
 		CreatedAt func(childComplexity int) int
 		Id        func(childComplexity int) int
+		OpenedAt  func(childComplexity int) int
 		Type      func(childComplexity int) int
 		Url       func(childComplexity int) int
+		ViewOnce  func(childComplexity int) int
+	}
+
+	MediaGrant struct {
+		ExpiresAt func(childComplexity int) int
+		MediaId   func(childComplexity int) int
+		MessageId func(childComplexity int) int
+		Url       func(childComplexity int) int
+	}
+
+	MessagePreview struct {
+		Content  func(childComplexity int) int
+		Id       func(childComplexity int) int
+		SenderId func(childComplexity int) int
+		Type     func(childComplexity int) int
+	}
+
+	MessageRevision struct {
+		Content    func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		Id         func(childComplexity int) int
+		Media      func(childComplexity int) int
+		MessageId  func(childComplexity int) int
+		ReplacedAt func(childComplexity int) int
+		SenderId   func(childComplexity int) int
 	}
 
 	Mutation struct {
-		CreateComment         func(childComplexity int, input model.CreateCommentInput) int
-		CreatePost            func(childComplexity int, input model.CreatePostInput) int
-		CreateProfileActivity func(childComplexity int, typeArg models.ActivityType, targetUserID string) int
-		CreateReport          func(childComplexity int, input model.CreateReportInput) int
-		CreateUser            func(childComplexity int, input model.CreateUserInput) int
-		CreateVerification    func(childComplexity int, input model.UserVerificationInput) int
-		DeleteComment         func(childComplexity int, commentID string) int
-		DeletePost            func(childComplexity int, postID string) int
-		IncrementPostView     func(childComplexity int, postID string) int
-		LoginWithPassword     func(childComplexity int, email string, password string) int
-		RefreshToken          func(childComplexity int) int
-		RequestEmailLoginCode func(childComplexity int, email string) int
-		Swipe                 func(childComplexity int, targetID string, actionType models.SwipeType) int
-		ToggleCommentLike     func(childComplexity int, commentID string) int
-		TogglePostLike        func(childComplexity int, postID string) int
-		UpdateComment         func(childComplexity int, input model.UpdateCommentInput) int
-		UpdateMe              func(childComplexity int, input model.UpdateUserInput) int
-		UpdatePost            func(childComplexity int, input model.UpdatePostInput) int
-		VerifyEmailLoginCode  func(childComplexity int, email string, code string) int
+		AddTrustedContact        func(childComplexity int, name string, email string) int
+		CancelCheckIn            func(childComplexity int, id string) int
+		CancelScheduledMessage   func(childComplexity int, chatID string, id string) int
+		CheckIn                  func(childComplexity int, id string) int
+		CreateComment            func(childComplexity int, input model.CreateCommentInput) int
+		CreatePost               func(childComplexity int, input model.CreatePostInput) int
+		CreateProfileActivity    func(childComplexity int, typeArg models.ActivityType, targetUserID string) int
+		CreateReport             func(childComplexity int, input model.CreateReportInput) int
+		CreateUser               func(childComplexity int, input model.CreateUserInput) int
+		CreateVerification       func(childComplexity int, input model.UserVerificationInput) int
+		DeleteComment            func(childComplexity int, commentID string) int
+		DeletePost               func(childComplexity int, postID string) int
+		ExportChat               func(childComplexity int, chatID string, format model.ChatExportFormat) int
+		IncrementPostView        func(childComplexity int, postID string) int
+		LoginWithPassword        func(childComplexity int, email string, password string) int
+		MarkSeen                 func(childComplexity int, chatID string, messageIds []string) int
+		OpenViewOnce             func(childComplexity int, chatID string, messageID string, mediaID string) int
+		React                    func(childComplexity int, chatID string, messageID string, reaction string) int
+		RefreshToken             func(childComplexity int) int
+		RemoveTrustedContact     func(childComplexity int, id string) int
+		RequestEmailLoginCode    func(childComplexity int, email string) int
+		RespondToDateProposal    func(childComplexity int, chatID string, messageID string, action chatservice.DateAction, counter *model.DateProposalInput) int
+		ScheduleCheckIn          func(childComplexity int, dateID string, checkInAt time.Time) int
+		ScheduleMessage          func(childComplexity int, input model.SendMessageInput, deliverAt time.Time) int
+		SendMessage              func(childComplexity int, input model.SendMessageInput) int
+		ShareDate                func(childComplexity int, dateID string) int
+		Swipe                    func(childComplexity int, targetID string, actionType models.SwipeType) int
+		ToggleCommentLike        func(childComplexity int, commentID string) int
+		TogglePostLike           func(childComplexity int, postID string) int
+		Unreact                  func(childComplexity int, chatID string, messageID string) int
+		UpdateComment            func(childComplexity int, input model.UpdateCommentInput) int
+		UpdateConnectionSettings func(childComplexity int, matchID string, input model.ConnectionSettingsInput) int
+		UpdateMe                 func(childComplexity int, input model.UpdateUserInput) int
+		UpdatePost               func(childComplexity int, input model.UpdatePostInput) int
+		VerifyEmailLoginCode     func(childComplexity int, email string, code string) int
 	}
 
 	PageInfo struct {
@@ -168,6 +308,19 @@ type ComplexityRoot struct {
 		Value func(childComplexity int) int
 	}
 
+	PlannedDate struct {
+		AcceptedBy func(childComplexity int) int
+		ChatId     func(childComplexity int) int
+		CreatedAt  func(childComplexity int) int
+		Id         func(childComplexity int) int
+		MatchId    func(childComplexity int) int
+		MessageId  func(childComplexity int) int
+		Note       func(childComplexity int) int
+		ProposedBy func(childComplexity int) int
+		StartsAt   func(childComplexity int) int
+		Venue      func(childComplexity int) int
+	}
+
 	Post struct {
 		Comments  func(childComplexity int) int
 		Content   func(childComplexity int) int
@@ -193,21 +346,40 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
+		ChatExports               func(childComplexity int, chatID string) int
+		DateCheckIns              func(childComplexity int) int
 		GetComment                func(childComplexity int, commentID string) int
 		GetComments               func(childComplexity int, filter model.CommentFilterInput, sort *model.SortInput, limit *int32, cursor *string) int
 		GetFeedPosts              func(childComplexity int, limit *int32, cursor *string) int
-		GetMyConnections          func(childComplexity int) int
+		GetMyConnections          func(childComplexity int, filter *model.ConnectionFilter, sort *model.ConnectionSort, limit *int32, cursor *string) int
 		GetPost                   func(childComplexity int, postID string) int
 		GetPosts                  func(childComplexity int, filter *model.PostFilterInput, sort *model.SortInput, limit *int32, cursor *string) int
 		GetTrendingPosts          func(childComplexity int, timeWindow *int32, limit *int32, cursor *string) int
+		GetUnreadBadge            func(childComplexity int) int
 		GetUserVerificationStatus func(childComplexity int) int
 		Me                        func(childComplexity int) int
+		MessageRevisions          func(childComplexity int, chatID string, messageID string) int
 		MySwipes                  func(childComplexity int) int
+		PlannedDates              func(childComplexity int, matchID *string, upcoming *bool) int
 		ProfileActivities         func(childComplexity int, class *model.ActivityClass) int
 		Recommendations           func(childComplexity int, cursor *string, limit *int32) int
+		ScheduledMessages         func(childComplexity int, chatID string) int
+		TrustedContacts           func(childComplexity int) int
 		User                      func(childComplexity int, id string) int
 	}
 
+	Reaction struct {
+		Content   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Id        func(childComplexity int) int
+		SenderId  func(childComplexity int) int
+	}
+
+	ReactionCount struct {
+		Content func(childComplexity int) int
+		Count   func(childComplexity int) int
+	}
+
 	RecommendationsResult struct {
 		FetchedAt  func(childComplexity int) int
 		HasMore    func(childComplexity int) int
@@ -236,6 +408,19 @@ type ComplexityRoot struct {
 		UserId         func(childComplexity int) int
 	}
 
+	ScheduledMessage struct {
+		ChatId    func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		DeliverAt func(childComplexity int) int
+		Id        func(childComplexity int) int
+		Message   func(childComplexity int) int
+		Status    func(childComplexity int) int
+	}
+
+	Subscription struct {
+		ChatEvents func(childComplexity int, chatID string) int
+	}
+
 	Swipe struct {
 		ActionType func(childComplexity int) int
 		CreatedAt  func(childComplexity int) int
@@ -254,6 +439,13 @@ type ComplexityRoot struct {
 		Swipe   func(childComplexity int) int
 	}
 
+	TrustedContact struct {
+		CreatedAt func(childComplexity int) int
+		Email     func(childComplexity int) int
+		Id        func(childComplexity int) int
+		Name      func(childComplexity int) int
+	}
+
 	User struct {
 		Address           func(childComplexity int) int
 		Bio               func(childComplexity int) int
@@ -263,11 +455,13 @@ type ComplexityRoot struct {
 		Extra             func(childComplexity int) int
 		FirstName         func(childComplexity int) int
 		Gender            func(childComplexity int) int
+		HideOnline        func(childComplexity int) int
 		Hobbies           func(childComplexity int) int
 		Id                func(childComplexity int) int
 		Interests         func(childComplexity int) int
 		IsVerified        func(childComplexity int) int
 		LastName          func(childComplexity int) int
+		LastSeenAt        func(childComplexity int) int
 		PersonalityTraits func(childComplexity int) int
 		Pfp               func(childComplexity int) int
 		Photos            func(childComplexity int) int
@@ -296,6 +490,7 @@ type ComplexityRoot struct {
 		IsOnline          func(childComplexity int) int
 		IsPoked           func(childComplexity int) int
 		IsVerified        func(childComplexity int) int
+		LastSeenAt        func(childComplexity int) int
 		Name              func(childComplexity int) int
 		PersonalityTraits func(childComplexity int) int
 		Pfp               func(childComplexity int) int
@@ -313,10 +508,19 @@ type ComplexityRoot struct {
 	}
 }
 
+type CallLogResolver interface {
+	DurationSec(ctx context.Context, obj *models.CallLog) (int32, error)
+}
+type ChatExportResolver interface {
+	DownloadURL(ctx context.Context, obj *models.ChatExport) (*string, error)
+}
 type CommentResolver interface {
 	Likes(ctx context.Context, obj *models.Comment) (int32, error)
 	User(ctx context.Context, obj *models.Comment) (*model.UserPublic, error)
 }
+type ConnectionSettingsResolver interface {
+	IsMuted(ctx context.Context, obj *models.ConnectionSettings) (bool, error)
+}
 type MatchResolver interface {
 	Score(ctx context.Context, obj *models.Match) (int32, error)
 }
@@ -324,6 +528,22 @@ type MediaResolver interface {
 	Type(ctx context.Context, obj *models.Media) (model.MediaType, error)
 }
 type MutationResolver interface {
+	SendMessage(ctx context.Context, input model.SendMessageInput) (*models.Message, error)
+	MarkSeen(ctx context.Context, chatID string, messageIds []string) (bool, error)
+	React(ctx context.Context, chatID string, messageID string, reaction string) (*models.Message, error)
+	Unreact(ctx context.Context, chatID string, messageID string) (*models.Message, error)
+	OpenViewOnce(ctx context.Context, chatID string, messageID string, mediaID string) (*chatservice.MediaGrant, error)
+	ScheduleMessage(ctx context.Context, input model.SendMessageInput, deliverAt time.Time) (*models.ScheduledMessage, error)
+	UpdateConnectionSettings(ctx context.Context, matchID string, input model.ConnectionSettingsInput) (*models.ConnectionSettings, error)
+	CancelScheduledMessage(ctx context.Context, chatID string, id string) (bool, error)
+	RespondToDateProposal(ctx context.Context, chatID string, messageID string, action chatservice.DateAction, counter *model.DateProposalInput) (*chatservice.DateResponse, error)
+	AddTrustedContact(ctx context.Context, name string, email string) (*models.TrustedContact, error)
+	RemoveTrustedContact(ctx context.Context, id string) (bool, error)
+	ShareDate(ctx context.Context, dateID string) (int32, error)
+	ScheduleCheckIn(ctx context.Context, dateID string, checkInAt time.Time) (*models.DateCheckIn, error)
+	CheckIn(ctx context.Context, id string) (*models.DateCheckIn, error)
+	CancelCheckIn(ctx context.Context, id string) (bool, error)
+	ExportChat(ctx context.Context, chatID string, format model.ChatExportFormat) (*models.ChatExport, error)
 	CreatePost(ctx context.Context, input model.CreatePostInput) (*models.Post, error)
 	UpdatePost(ctx context.Context, input model.UpdatePostInput) (*models.Post, error)
 	DeletePost(ctx context.Context, postID string) (bool, error)
@@ -355,7 +575,14 @@ type PostUnlockRatingResolver interface {
 	HeRating(ctx context.Context, obj *models.PostUnlockRating) (int32, error)
 }
 type QueryResolver interface {
-	GetMyConnections(ctx context.Context) ([]*model.Connection, error)
+	GetMyConnections(ctx context.Context, filter *model.ConnectionFilter, sort *model.ConnectionSort, limit *int32, cursor *string) ([]*model.Connection, error)
+	GetUnreadBadge(ctx context.Context) (int32, error)
+	ScheduledMessages(ctx context.Context, chatID string) ([]*models.ScheduledMessage, error)
+	MessageRevisions(ctx context.Context, chatID string, messageID string) ([]*models.MessageRevision, error)
+	PlannedDates(ctx context.Context, matchID *string, upcoming *bool) ([]*models.PlannedDate, error)
+	TrustedContacts(ctx context.Context) ([]*models.TrustedContact, error)
+	DateCheckIns(ctx context.Context) ([]*models.DateCheckIn, error)
+	ChatExports(ctx context.Context, chatID string) ([]*models.ChatExport, error)
 	GetPosts(ctx context.Context, filter *model.PostFilterInput, sort *model.SortInput, limit *int32, cursor *string) (*model.PostsConnection, error)
 	GetPost(ctx context.Context, postID string) (*models.Post, error)
 	GetFeedPosts(ctx context.Context, limit *int32, cursor *string) (*model.PostsConnection, error)
@@ -369,6 +596,12 @@ type QueryResolver interface {
 	User(ctx context.Context, id string) (*model.UserPublic, error)
 	GetUserVerificationStatus(ctx context.Context) (*models.UserVerification, error)
 }
+type ReactionCountResolver interface {
+	Count(ctx context.Context, obj *models.ReactionCount) (int32, error)
+}
+type SubscriptionResolver interface {
+	ChatEvents(ctx context.Context, chatID string) (<-chan *model.ChatEvent, error)
+}
 type UserResolver interface {
 	PersonalityTraits(ctx context.Context, obj *models.User) ([]*model.PersonalityTrait, error)
 }
@@ -438,6 +671,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.AuthPayload.User(childComplexity), true
 
+	case "CallLog.answered_at":
+		if e.complexity.CallLog.AnsweredAt == nil {
+			break
+		}
+
+		return e.complexity.CallLog.AnsweredAt(childComplexity), true
+	case "CallLog.call_id":
+		if e.complexity.CallLog.CallId == nil {
+			break
+		}
+
+		return e.complexity.CallLog.CallId(childComplexity), true
+	case "CallLog.duration_sec":
+		if e.complexity.CallLog.DurationSec == nil {
+			break
+		}
+
+		return e.complexity.CallLog.DurationSec(childComplexity), true
+	case "CallLog.ended_at":
+		if e.complexity.CallLog.EndedAt == nil {
+			break
+		}
+
+		return e.complexity.CallLog.EndedAt(childComplexity), true
+	case "CallLog.ended_by":
+		if e.complexity.CallLog.EndedBy == nil {
+			break
+		}
+
+		return e.complexity.CallLog.EndedBy(childComplexity), true
+	case "CallLog.media":
+		if e.complexity.CallLog.Media == nil {
+			break
+		}
+
+		return e.complexity.CallLog.Media(childComplexity), true
+	case "CallLog.started_at":
+		if e.complexity.CallLog.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.CallLog.StartedAt(childComplexity), true
+	case "CallLog.status":
+		if e.complexity.CallLog.Status == nil {
+			break
+		}
+
+		return e.complexity.CallLog.Status(childComplexity), true
+
 	case "Chat.created_at":
 		if e.complexity.Chat.CreatedAt == nil {
 			break
@@ -457,6 +739,214 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Chat.MatchId(childComplexity), true
 
+	case "ChatEvent.change":
+		if e.complexity.ChatEvent.Change == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.Change(childComplexity), true
+
+	case "ChatEvent.chat_id":
+		if e.complexity.ChatEvent.ChatID == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.ChatID(childComplexity), true
+	case "ChatEvent.is_online":
+		if e.complexity.ChatEvent.IsOnline == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.IsOnline(childComplexity), true
+	case "ChatEvent.is_typing":
+		if e.complexity.ChatEvent.IsTyping == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.IsTyping(childComplexity), true
+	case "ChatEvent.last_seen_at":
+		if e.complexity.ChatEvent.LastSeenAt == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.LastSeenAt(childComplexity), true
+	case "ChatEvent.message":
+		if e.complexity.ChatEvent.Message == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.Message(childComplexity), true
+	case "ChatEvent.message_ids":
+		if e.complexity.ChatEvent.MessageIds == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.MessageIds(childComplexity), true
+	case "ChatEvent.timestamp":
+		if e.complexity.ChatEvent.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.Timestamp(childComplexity), true
+	case "ChatEvent.type":
+		if e.complexity.ChatEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.Type(childComplexity), true
+	case "ChatEvent.user_id":
+		if e.complexity.ChatEvent.UserID == nil {
+			break
+		}
+
+		return e.complexity.ChatEvent.UserID(childComplexity), true
+
+	case "ChatExport.chat_id":
+		if e.complexity.ChatExport.ChatId == nil {
+			break
+		}
+
+		return e.complexity.ChatExport.ChatId(childComplexity), true
+	case "ChatExport.created_at":
+		if e.complexity.ChatExport.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ChatExport.CreatedAt(childComplexity), true
+	case "ChatExport.download_url":
+		if e.complexity.ChatExport.DownloadURL == nil {
+			break
+		}
+
+		return e.complexity.ChatExport.DownloadURL(childComplexity), true
+	case "ChatExport.error":
+		if e.complexity.ChatExport.Error == nil {
+			break
+		}
+
+		return e.complexity.ChatExport.Error(childComplexity), true
+	case "ChatExport.format":
+		if e.complexity.ChatExport.Format == nil {
+			break
+		}
+
+		return e.complexity.ChatExport.Format(childComplexity), true
+	case "ChatExport.id":
+		if e.complexity.ChatExport.Id == nil {
+			break
+		}
+
+		return e.complexity.ChatExport.Id(childComplexity), true
+	case "ChatExport.status":
+		if e.complexity.ChatExport.Status == nil {
+			break
+		}
+
+		return e.complexity.ChatExport.Status(childComplexity), true
+	case "ChatExport.updated_at":
+		if e.complexity.ChatExport.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ChatExport.UpdatedAt(childComplexity), true
+
+	case "ChatMessage.call":
+		if e.complexity.ChatMessage.Call == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.Call(childComplexity), true
+	case "ChatMessage.content":
+		if e.complexity.ChatMessage.Content == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.Content(childComplexity), true
+	case "ChatMessage.created_at":
+		if e.complexity.ChatMessage.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.CreatedAt(childComplexity), true
+	case "ChatMessage.date_proposal":
+		if e.complexity.ChatMessage.DateProposal == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.DateProposal(childComplexity), true
+	case "ChatMessage.edited_at":
+		if e.complexity.ChatMessage.EditedAt == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.EditedAt(childComplexity), true
+	case "ChatMessage.id":
+		if e.complexity.ChatMessage.Id == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.Id(childComplexity), true
+	case "ChatMessage.media":
+		if e.complexity.ChatMessage.Media == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.Media(childComplexity), true
+	case "ChatMessage.reaction_counts":
+		if e.complexity.ChatMessage.ReactionCounts == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.ReactionCounts(childComplexity), true
+	case "ChatMessage.reactions":
+		if e.complexity.ChatMessage.Reactions == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.Reactions(childComplexity), true
+	case "ChatMessage.received":
+		if e.complexity.ChatMessage.Received == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.Received(childComplexity), true
+	case "ChatMessage.reply_to":
+		if e.complexity.ChatMessage.ReplyTo == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.ReplyTo(childComplexity), true
+	case "ChatMessage.reply_to_id":
+		if e.complexity.ChatMessage.ReplyToId == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.ReplyToId(childComplexity), true
+	case "ChatMessage.seen":
+		if e.complexity.ChatMessage.Seen == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.Seen(childComplexity), true
+	case "ChatMessage.sender_id":
+		if e.complexity.ChatMessage.SenderId == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.SenderId(childComplexity), true
+	case "ChatMessage.type":
+		if e.complexity.ChatMessage.Type == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.Type(childComplexity), true
+	case "ChatMessage.updated_at":
+		if e.complexity.ChatMessage.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ChatMessage.UpdatedAt(childComplexity), true
+
 	case "Comment.content":
 		if e.complexity.Comment.Content == nil {
 			break
@@ -543,6 +1033,18 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Connection.ConnectionProfile(childComplexity), true
+	case "Connection.cursor":
+		if e.complexity.Connection.Cursor == nil {
+			break
+		}
+
+		return e.complexity.Connection.Cursor(childComplexity), true
+	case "Connection.last_activity_at":
+		if e.complexity.Connection.LastActivityAt == nil {
+			break
+		}
+
+		return e.complexity.Connection.LastActivityAt(childComplexity), true
 	case "Connection.last_message":
 		if e.complexity.Connection.LastMessage == nil {
 			break
@@ -561,6 +1063,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Connection.PercentageComplete(childComplexity), true
+	case "Connection.settings":
+		if e.complexity.Connection.Settings == nil {
+			break
+		}
+
+		return e.complexity.Connection.Settings(childComplexity), true
 	case "Connection.unread_messages":
 		if e.complexity.Connection.UnreadMessages == nil {
 			break
@@ -568,117 +1076,259 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Connection.UnreadMessages(childComplexity), true
 
-	case "ExtraMetadata.drinking":
-		if e.complexity.ExtraMetadata.Drinking == nil {
+	case "ConnectionSettings.archived":
+		if e.complexity.ConnectionSettings.Archived == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Drinking(childComplexity), true
-	case "ExtraMetadata.ethnicity":
-		if e.complexity.ExtraMetadata.Ethnicity == nil {
+		return e.complexity.ConnectionSettings.Archived(childComplexity), true
+	case "ConnectionSettings.is_muted":
+		if e.complexity.ConnectionSettings.IsMuted == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Ethnicity(childComplexity), true
-	case "ExtraMetadata.excercise":
-		if e.complexity.ExtraMetadata.Excercise == nil {
+		return e.complexity.ConnectionSettings.IsMuted(childComplexity), true
+	case "ConnectionSettings.match_id":
+		if e.complexity.ConnectionSettings.MatchId == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Excercise(childComplexity), true
-	case "ExtraMetadata.kids":
-		if e.complexity.ExtraMetadata.Kids == nil {
+		return e.complexity.ConnectionSettings.MatchId(childComplexity), true
+	case "ConnectionSettings.muted_until":
+		if e.complexity.ConnectionSettings.MutedUntil == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Kids(childComplexity), true
-	case "ExtraMetadata.languages":
-		if e.complexity.ExtraMetadata.Languages == nil {
+		return e.complexity.ConnectionSettings.MutedUntil(childComplexity), true
+	case "ConnectionSettings.nickname":
+		if e.complexity.ConnectionSettings.Nickname == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Languages(childComplexity), true
-	case "ExtraMetadata.looking_for":
-		if e.complexity.ExtraMetadata.LookingFor == nil {
+		return e.complexity.ConnectionSettings.Nickname(childComplexity), true
+	case "ConnectionSettings.pinned":
+		if e.complexity.ConnectionSettings.Pinned == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.LookingFor(childComplexity), true
-	case "ExtraMetadata.religion":
-		if e.complexity.ExtraMetadata.Religion == nil {
+		return e.complexity.ConnectionSettings.Pinned(childComplexity), true
+
+	case "DateCheckIn.alerted_at":
+		if e.complexity.DateCheckIn.AlertedAt == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Religion(childComplexity), true
-	case "ExtraMetadata.school":
-		if e.complexity.ExtraMetadata.School == nil {
+		return e.complexity.DateCheckIn.AlertedAt(childComplexity), true
+	case "DateCheckIn.check_in_at":
+		if e.complexity.DateCheckIn.CheckInAt == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.School(childComplexity), true
-	case "ExtraMetadata.sexuality":
-		if e.complexity.ExtraMetadata.Sexuality == nil {
+		return e.complexity.DateCheckIn.CheckInAt(childComplexity), true
+	case "DateCheckIn.checked_in_at":
+		if e.complexity.DateCheckIn.CheckedInAt == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Sexuality(childComplexity), true
-	case "ExtraMetadata.smoking":
-		if e.complexity.ExtraMetadata.Smoking == nil {
+		return e.complexity.DateCheckIn.CheckedInAt(childComplexity), true
+	case "DateCheckIn.created_at":
+		if e.complexity.DateCheckIn.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Smoking(childComplexity), true
-	case "ExtraMetadata.work":
-		if e.complexity.ExtraMetadata.Work == nil {
+		return e.complexity.DateCheckIn.CreatedAt(childComplexity), true
+	case "DateCheckIn.date_id":
+		if e.complexity.DateCheckIn.DateId == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Work(childComplexity), true
-	case "ExtraMetadata.zodiac":
-		if e.complexity.ExtraMetadata.Zodiac == nil {
+		return e.complexity.DateCheckIn.DateId(childComplexity), true
+	case "DateCheckIn.id":
+		if e.complexity.DateCheckIn.Id == nil {
 			break
 		}
 
-		return e.complexity.ExtraMetadata.Zodiac(childComplexity), true
-
-	case "Match.he_id":
-		if e.complexity.Match.HeId == nil {
+		return e.complexity.DateCheckIn.Id(childComplexity), true
+	case "DateCheckIn.status":
+		if e.complexity.DateCheckIn.Status == nil {
 			break
 		}
 
-		return e.complexity.Match.HeId(childComplexity), true
-	case "Match.id":
-		if e.complexity.Match.Id == nil {
+		return e.complexity.DateCheckIn.Status(childComplexity), true
+
+	case "DateProposal.counter_id":
+		if e.complexity.DateProposal.CounterId == nil {
 			break
 		}
 
-		return e.complexity.Match.Id(childComplexity), true
-	case "Match.is_unlocked":
-		if e.complexity.Match.IsUnlocked == nil {
+		return e.complexity.DateProposal.CounterId(childComplexity), true
+	case "DateProposal.note":
+		if e.complexity.DateProposal.Note == nil {
 			break
 		}
 
-		return e.complexity.Match.IsUnlocked(childComplexity), true
-	case "Match.matched_at":
-		if e.complexity.Match.MatchedAt == nil {
+		return e.complexity.DateProposal.Note(childComplexity), true
+	case "DateProposal.responded_at":
+		if e.complexity.DateProposal.RespondedAt == nil {
 			break
 		}
 
-		return e.complexity.Match.MatchedAt(childComplexity), true
-	case "Match.post_unlock_rating":
-		if e.complexity.Match.PostUnlockRating == nil {
+		return e.complexity.DateProposal.RespondedAt(childComplexity), true
+	case "DateProposal.responded_by":
+		if e.complexity.DateProposal.RespondedBy == nil {
 			break
 		}
 
-		return e.complexity.Match.PostUnlockRating(childComplexity), true
-	case "Match.score":
-		if e.complexity.Match.Score == nil {
+		return e.complexity.DateProposal.RespondedBy(childComplexity), true
+	case "DateProposal.starts_at":
+		if e.complexity.DateProposal.StartsAt == nil {
 			break
 		}
 
-		return e.complexity.Match.Score(childComplexity), true
-	case "Match.she_id":
-		if e.complexity.Match.SheId == nil {
+		return e.complexity.DateProposal.StartsAt(childComplexity), true
+	case "DateProposal.status":
+		if e.complexity.DateProposal.Status == nil {
+			break
+		}
+
+		return e.complexity.DateProposal.Status(childComplexity), true
+	case "DateProposal.venue":
+		if e.complexity.DateProposal.Venue == nil {
+			break
+		}
+
+		return e.complexity.DateProposal.Venue(childComplexity), true
+
+	case "DateProposalResponse.counter":
+		if e.complexity.DateProposalResponse.Counter == nil {
+			break
+		}
+
+		return e.complexity.DateProposalResponse.Counter(childComplexity), true
+	case "DateProposalResponse.date":
+		if e.complexity.DateProposalResponse.Date == nil {
+			break
+		}
+
+		return e.complexity.DateProposalResponse.Date(childComplexity), true
+	case "DateProposalResponse.proposal":
+		if e.complexity.DateProposalResponse.Proposal == nil {
+			break
+		}
+
+		return e.complexity.DateProposalResponse.Proposal(childComplexity), true
+
+	case "ExtraMetadata.drinking":
+		if e.complexity.ExtraMetadata.Drinking == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Drinking(childComplexity), true
+	case "ExtraMetadata.ethnicity":
+		if e.complexity.ExtraMetadata.Ethnicity == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Ethnicity(childComplexity), true
+	case "ExtraMetadata.excercise":
+		if e.complexity.ExtraMetadata.Excercise == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Excercise(childComplexity), true
+	case "ExtraMetadata.kids":
+		if e.complexity.ExtraMetadata.Kids == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Kids(childComplexity), true
+	case "ExtraMetadata.languages":
+		if e.complexity.ExtraMetadata.Languages == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Languages(childComplexity), true
+	case "ExtraMetadata.looking_for":
+		if e.complexity.ExtraMetadata.LookingFor == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.LookingFor(childComplexity), true
+	case "ExtraMetadata.religion":
+		if e.complexity.ExtraMetadata.Religion == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Religion(childComplexity), true
+	case "ExtraMetadata.school":
+		if e.complexity.ExtraMetadata.School == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.School(childComplexity), true
+	case "ExtraMetadata.sexuality":
+		if e.complexity.ExtraMetadata.Sexuality == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Sexuality(childComplexity), true
+	case "ExtraMetadata.smoking":
+		if e.complexity.ExtraMetadata.Smoking == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Smoking(childComplexity), true
+	case "ExtraMetadata.work":
+		if e.complexity.ExtraMetadata.Work == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Work(childComplexity), true
+	case "ExtraMetadata.zodiac":
+		if e.complexity.ExtraMetadata.Zodiac == nil {
+			break
+		}
+
+		return e.complexity.ExtraMetadata.Zodiac(childComplexity), true
+
+	case "Match.he_id":
+		if e.complexity.Match.HeId == nil {
+			break
+		}
+
+		return e.complexity.Match.HeId(childComplexity), true
+	case "Match.id":
+		if e.complexity.Match.Id == nil {
+			break
+		}
+
+		return e.complexity.Match.Id(childComplexity), true
+	case "Match.is_unlocked":
+		if e.complexity.Match.IsUnlocked == nil {
+			break
+		}
+
+		return e.complexity.Match.IsUnlocked(childComplexity), true
+	case "Match.matched_at":
+		if e.complexity.Match.MatchedAt == nil {
+			break
+		}
+
+		return e.complexity.Match.MatchedAt(childComplexity), true
+	case "Match.post_unlock_rating":
+		if e.complexity.Match.PostUnlockRating == nil {
+			break
+		}
+
+		return e.complexity.Match.PostUnlockRating(childComplexity), true
+	case "Match.score":
+		if e.complexity.Match.Score == nil {
+			break
+		}
+
+		return e.complexity.Match.Score(childComplexity), true
+	case "Match.she_id":
+		if e.complexity.Match.SheId == nil {
 			break
 		}
 
@@ -696,6 +1346,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Media.Id(childComplexity), true
+	case "Media.opened_at":
+		if e.complexity.Media.OpenedAt == nil {
+			break
+		}
+
+		return e.complexity.Media.OpenedAt(childComplexity), true
 	case "Media.type":
 		if e.complexity.Media.Type == nil {
 			break
@@ -708,7 +1364,150 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Media.Url(childComplexity), true
+	case "Media.view_once":
+		if e.complexity.Media.ViewOnce == nil {
+			break
+		}
+
+		return e.complexity.Media.ViewOnce(childComplexity), true
+
+	case "MediaGrant.expires_at":
+		if e.complexity.MediaGrant.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.MediaGrant.ExpiresAt(childComplexity), true
+	case "MediaGrant.media_id":
+		if e.complexity.MediaGrant.MediaId == nil {
+			break
+		}
+
+		return e.complexity.MediaGrant.MediaId(childComplexity), true
+	case "MediaGrant.message_id":
+		if e.complexity.MediaGrant.MessageId == nil {
+			break
+		}
+
+		return e.complexity.MediaGrant.MessageId(childComplexity), true
+	case "MediaGrant.url":
+		if e.complexity.MediaGrant.Url == nil {
+			break
+		}
+
+		return e.complexity.MediaGrant.Url(childComplexity), true
+
+	case "MessagePreview.content":
+		if e.complexity.MessagePreview.Content == nil {
+			break
+		}
+
+		return e.complexity.MessagePreview.Content(childComplexity), true
+	case "MessagePreview.id":
+		if e.complexity.MessagePreview.Id == nil {
+			break
+		}
+
+		return e.complexity.MessagePreview.Id(childComplexity), true
+	case "MessagePreview.sender_id":
+		if e.complexity.MessagePreview.SenderId == nil {
+			break
+		}
+
+		return e.complexity.MessagePreview.SenderId(childComplexity), true
+	case "MessagePreview.type":
+		if e.complexity.MessagePreview.Type == nil {
+			break
+		}
+
+		return e.complexity.MessagePreview.Type(childComplexity), true
+
+	case "MessageRevision.content":
+		if e.complexity.MessageRevision.Content == nil {
+			break
+		}
+
+		return e.complexity.MessageRevision.Content(childComplexity), true
+	case "MessageRevision.created_at":
+		if e.complexity.MessageRevision.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.MessageRevision.CreatedAt(childComplexity), true
+	case "MessageRevision.id":
+		if e.complexity.MessageRevision.Id == nil {
+			break
+		}
+
+		return e.complexity.MessageRevision.Id(childComplexity), true
+	case "MessageRevision.media":
+		if e.complexity.MessageRevision.Media == nil {
+			break
+		}
+
+		return e.complexity.MessageRevision.Media(childComplexity), true
+	case "MessageRevision.message_id":
+		if e.complexity.MessageRevision.MessageId == nil {
+			break
+		}
+
+		return e.complexity.MessageRevision.MessageId(childComplexity), true
+	case "MessageRevision.replaced_at":
+		if e.complexity.MessageRevision.ReplacedAt == nil {
+			break
+		}
+
+		return e.complexity.MessageRevision.ReplacedAt(childComplexity), true
+	case "MessageRevision.sender_id":
+		if e.complexity.MessageRevision.SenderId == nil {
+			break
+		}
+
+		return e.complexity.MessageRevision.SenderId(childComplexity), true
+
+	case "Mutation.addTrustedContact":
+		if e.complexity.Mutation.AddTrustedContact == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addTrustedContact_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddTrustedContact(childComplexity, args["name"].(string), args["email"].(string)), true
+	case "Mutation.cancelCheckIn":
+		if e.complexity.Mutation.CancelCheckIn == nil {
+			break
+		}
 
+		args, err := ec.field_Mutation_cancelCheckIn_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelCheckIn(childComplexity, args["id"].(string)), true
+	case "Mutation.cancelScheduledMessage":
+		if e.complexity.Mutation.CancelScheduledMessage == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelScheduledMessage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelScheduledMessage(childComplexity, args["chat_id"].(string), args["id"].(string)), true
+	case "Mutation.checkIn":
+		if e.complexity.Mutation.CheckIn == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_checkIn_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CheckIn(childComplexity, args["id"].(string)), true
 	case "Mutation.create_comment":
 		if e.complexity.Mutation.CreateComment == nil {
 			break
@@ -797,6 +1596,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.DeletePost(childComplexity, args["post_id"].(string)), true
+	case "Mutation.exportChat":
+		if e.complexity.Mutation.ExportChat == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_exportChat_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExportChat(childComplexity, args["chat_id"].(string), args["format"].(model.ChatExportFormat)), true
 	case "Mutation.increment_post_view":
 		if e.complexity.Mutation.IncrementPostView == nil {
 			break
@@ -819,73 +1629,194 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Mutation.LoginWithPassword(childComplexity, args["email"].(string), args["password"].(string)), true
-	case "Mutation.refreshToken":
-		if e.complexity.Mutation.RefreshToken == nil {
-			break
-		}
-
-		return e.complexity.Mutation.RefreshToken(childComplexity), true
-	case "Mutation.requestEmailLoginCode":
-		if e.complexity.Mutation.RequestEmailLoginCode == nil {
+	case "Mutation.markSeen":
+		if e.complexity.Mutation.MarkSeen == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_requestEmailLoginCode_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_markSeen_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RequestEmailLoginCode(childComplexity, args["email"].(string)), true
-	case "Mutation.swipe":
-		if e.complexity.Mutation.Swipe == nil {
+		return e.complexity.Mutation.MarkSeen(childComplexity, args["chat_id"].(string), args["message_ids"].([]string)), true
+	case "Mutation.openViewOnce":
+		if e.complexity.Mutation.OpenViewOnce == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_swipe_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_openViewOnce_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.Swipe(childComplexity, args["target_id"].(string), args["action_type"].(models.SwipeType)), true
-	case "Mutation.toggle_comment_like":
-		if e.complexity.Mutation.ToggleCommentLike == nil {
+		return e.complexity.Mutation.OpenViewOnce(childComplexity, args["chat_id"].(string), args["message_id"].(string), args["media_id"].(string)), true
+	case "Mutation.react":
+		if e.complexity.Mutation.React == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_toggle_comment_like_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_react_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.ToggleCommentLike(childComplexity, args["comment_id"].(string)), true
-	case "Mutation.toggle_post_like":
-		if e.complexity.Mutation.TogglePostLike == nil {
+		return e.complexity.Mutation.React(childComplexity, args["chat_id"].(string), args["message_id"].(string), args["reaction"].(string)), true
+	case "Mutation.refreshToken":
+		if e.complexity.Mutation.RefreshToken == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_toggle_post_like_args(ctx, rawArgs)
+		return e.complexity.Mutation.RefreshToken(childComplexity), true
+	case "Mutation.removeTrustedContact":
+		if e.complexity.Mutation.RemoveTrustedContact == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeTrustedContact_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.TogglePostLike(childComplexity, args["post_id"].(string)), true
-	case "Mutation.update_comment":
-		if e.complexity.Mutation.UpdateComment == nil {
+		return e.complexity.Mutation.RemoveTrustedContact(childComplexity, args["id"].(string)), true
+	case "Mutation.requestEmailLoginCode":
+		if e.complexity.Mutation.RequestEmailLoginCode == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_update_comment_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_requestEmailLoginCode_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.UpdateComment(childComplexity, args["input"].(model.UpdateCommentInput)), true
-	case "Mutation.updateMe":
-		if e.complexity.Mutation.UpdateMe == nil {
+		return e.complexity.Mutation.RequestEmailLoginCode(childComplexity, args["email"].(string)), true
+	case "Mutation.respondToDateProposal":
+		if e.complexity.Mutation.RespondToDateProposal == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateMe_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_respondToDateProposal_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RespondToDateProposal(childComplexity, args["chat_id"].(string), args["message_id"].(string), args["action"].(chatservice.DateAction), args["counter"].(*model.DateProposalInput)), true
+	case "Mutation.scheduleCheckIn":
+		if e.complexity.Mutation.ScheduleCheckIn == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_scheduleCheckIn_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ScheduleCheckIn(childComplexity, args["date_id"].(string), args["check_in_at"].(time.Time)), true
+	case "Mutation.scheduleMessage":
+		if e.complexity.Mutation.ScheduleMessage == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_scheduleMessage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ScheduleMessage(childComplexity, args["input"].(model.SendMessageInput), args["deliver_at"].(time.Time)), true
+	case "Mutation.sendMessage":
+		if e.complexity.Mutation.SendMessage == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_sendMessage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SendMessage(childComplexity, args["input"].(model.SendMessageInput)), true
+	case "Mutation.shareDate":
+		if e.complexity.Mutation.ShareDate == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_shareDate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ShareDate(childComplexity, args["date_id"].(string)), true
+	case "Mutation.swipe":
+		if e.complexity.Mutation.Swipe == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_swipe_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Swipe(childComplexity, args["target_id"].(string), args["action_type"].(models.SwipeType)), true
+	case "Mutation.toggle_comment_like":
+		if e.complexity.Mutation.ToggleCommentLike == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_toggle_comment_like_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ToggleCommentLike(childComplexity, args["comment_id"].(string)), true
+	case "Mutation.toggle_post_like":
+		if e.complexity.Mutation.TogglePostLike == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_toggle_post_like_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TogglePostLike(childComplexity, args["post_id"].(string)), true
+	case "Mutation.unreact":
+		if e.complexity.Mutation.Unreact == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unreact_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Unreact(childComplexity, args["chat_id"].(string), args["message_id"].(string)), true
+	case "Mutation.update_comment":
+		if e.complexity.Mutation.UpdateComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_update_comment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateComment(childComplexity, args["input"].(model.UpdateCommentInput)), true
+	case "Mutation.updateConnectionSettings":
+		if e.complexity.Mutation.UpdateConnectionSettings == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateConnectionSettings_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateConnectionSettings(childComplexity, args["match_id"].(string), args["input"].(model.ConnectionSettingsInput)), true
+	case "Mutation.updateMe":
+		if e.complexity.Mutation.UpdateMe == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateMe_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
@@ -952,6 +1883,67 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PersonalityTrait.Value(childComplexity), true
 
+	case "PlannedDate.accepted_by":
+		if e.complexity.PlannedDate.AcceptedBy == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.AcceptedBy(childComplexity), true
+	case "PlannedDate.chat_id":
+		if e.complexity.PlannedDate.ChatId == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.ChatId(childComplexity), true
+	case "PlannedDate.created_at":
+		if e.complexity.PlannedDate.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.CreatedAt(childComplexity), true
+	case "PlannedDate.id":
+		if e.complexity.PlannedDate.Id == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.Id(childComplexity), true
+	case "PlannedDate.match_id":
+		if e.complexity.PlannedDate.MatchId == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.MatchId(childComplexity), true
+	case "PlannedDate.message_id":
+		if e.complexity.PlannedDate.MessageId == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.MessageId(childComplexity), true
+	case "PlannedDate.note":
+		if e.complexity.PlannedDate.Note == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.Note(childComplexity), true
+	case "PlannedDate.proposed_by":
+		if e.complexity.PlannedDate.ProposedBy == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.ProposedBy(childComplexity), true
+	case "PlannedDate.starts_at":
+		if e.complexity.PlannedDate.StartsAt == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.StartsAt(childComplexity), true
+	case "PlannedDate.venue":
+		if e.complexity.PlannedDate.Venue == nil {
+			break
+		}
+
+		return e.complexity.PlannedDate.Venue(childComplexity), true
+
 	case "Post.comments":
 		if e.complexity.Post.Comments == nil {
 			break
@@ -1045,6 +2037,23 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.PostsConnection.TotalCount(childComplexity), true
 
+	case "Query.chatExports":
+		if e.complexity.Query.ChatExports == nil {
+			break
+		}
+
+		args, err := ec.field_Query_chatExports_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ChatExports(childComplexity, args["chat_id"].(string)), true
+	case "Query.dateCheckIns":
+		if e.complexity.Query.DateCheckIns == nil {
+			break
+		}
+
+		return e.complexity.Query.DateCheckIns(childComplexity), true
 	case "Query.get_comment":
 		if e.complexity.Query.GetComment == nil {
 			break
@@ -1083,7 +2092,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			break
 		}
 
-		return e.complexity.Query.GetMyConnections(childComplexity), true
+		args, err := ec.field_Query_getMyConnections_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetMyConnections(childComplexity, args["filter"].(*model.ConnectionFilter), args["sort"].(*model.ConnectionSort), args["limit"].(*int32), args["cursor"].(*string)), true
 	case "Query.get_post":
 		if e.complexity.Query.GetPost == nil {
 			break
@@ -1117,6 +2131,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.GetTrendingPosts(childComplexity, args["time_window"].(*int32), args["limit"].(*int32), args["cursor"].(*string)), true
+	case "Query.getUnreadBadge":
+		if e.complexity.Query.GetUnreadBadge == nil {
+			break
+		}
+
+		return e.complexity.Query.GetUnreadBadge(childComplexity), true
 	case "Query.getUserVerificationStatus":
 		if e.complexity.Query.GetUserVerificationStatus == nil {
 			break
@@ -1129,12 +2149,34 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Me(childComplexity), true
+	case "Query.messageRevisions":
+		if e.complexity.Query.MessageRevisions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_messageRevisions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MessageRevisions(childComplexity, args["chat_id"].(string), args["message_id"].(string)), true
 	case "Query.mySwipes":
 		if e.complexity.Query.MySwipes == nil {
 			break
 		}
 
 		return e.complexity.Query.MySwipes(childComplexity), true
+	case "Query.plannedDates":
+		if e.complexity.Query.PlannedDates == nil {
+			break
+		}
+
+		args, err := ec.field_Query_plannedDates_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PlannedDates(childComplexity, args["match_id"].(*string), args["upcoming"].(*bool)), true
 	case "Query.profileActivities":
 		if e.complexity.Query.ProfileActivities == nil {
 			break
@@ -1157,6 +2199,23 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Query.Recommendations(childComplexity, args["cursor"].(*string), args["limit"].(*int32)), true
+	case "Query.scheduledMessages":
+		if e.complexity.Query.ScheduledMessages == nil {
+			break
+		}
+
+		args, err := ec.field_Query_scheduledMessages_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ScheduledMessages(childComplexity, args["chat_id"].(string)), true
+	case "Query.trustedContacts":
+		if e.complexity.Query.TrustedContacts == nil {
+			break
+		}
+
+		return e.complexity.Query.TrustedContacts(childComplexity), true
 	case "Query.user":
 		if e.complexity.Query.User == nil {
 			break
@@ -1169,6 +2228,44 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Query.User(childComplexity, args["id"].(string)), true
 
+	case "Reaction.content":
+		if e.complexity.Reaction.Content == nil {
+			break
+		}
+
+		return e.complexity.Reaction.Content(childComplexity), true
+	case "Reaction.created_at":
+		if e.complexity.Reaction.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Reaction.CreatedAt(childComplexity), true
+	case "Reaction.id":
+		if e.complexity.Reaction.Id == nil {
+			break
+		}
+
+		return e.complexity.Reaction.Id(childComplexity), true
+	case "Reaction.sender_id":
+		if e.complexity.Reaction.SenderId == nil {
+			break
+		}
+
+		return e.complexity.Reaction.SenderId(childComplexity), true
+
+	case "ReactionCount.content":
+		if e.complexity.ReactionCount.Content == nil {
+			break
+		}
+
+		return e.complexity.ReactionCount.Content(childComplexity), true
+	case "ReactionCount.count":
+		if e.complexity.ReactionCount.Count == nil {
+			break
+		}
+
+		return e.complexity.ReactionCount.Count(childComplexity), true
+
 	case "RecommendationsResult.fetched_at":
 		if e.complexity.RecommendationsResult.FetchedAt == nil {
 			break
@@ -1286,6 +2383,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Report.UserId(childComplexity), true
 
+	case "ScheduledMessage.chat_id":
+		if e.complexity.ScheduledMessage.ChatId == nil {
+			break
+		}
+
+		return e.complexity.ScheduledMessage.ChatId(childComplexity), true
+	case "ScheduledMessage.created_at":
+		if e.complexity.ScheduledMessage.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ScheduledMessage.CreatedAt(childComplexity), true
+	case "ScheduledMessage.deliver_at":
+		if e.complexity.ScheduledMessage.DeliverAt == nil {
+			break
+		}
+
+		return e.complexity.ScheduledMessage.DeliverAt(childComplexity), true
+	case "ScheduledMessage.id":
+		if e.complexity.ScheduledMessage.Id == nil {
+			break
+		}
+
+		return e.complexity.ScheduledMessage.Id(childComplexity), true
+	case "ScheduledMessage.message":
+		if e.complexity.ScheduledMessage.Message == nil {
+			break
+		}
+
+		return e.complexity.ScheduledMessage.Message(childComplexity), true
+	case "ScheduledMessage.status":
+		if e.complexity.ScheduledMessage.Status == nil {
+			break
+		}
+
+		return e.complexity.ScheduledMessage.Status(childComplexity), true
+
+	case "Subscription.chatEvents":
+		if e.complexity.Subscription.ChatEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_chatEvents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.ChatEvents(childComplexity, args["chat_id"].(string)), true
+
 	case "Swipe.action_type":
 		if e.complexity.Swipe.ActionType == nil {
 			break
@@ -1343,6 +2489,31 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.SwipedProfile.Swipe(childComplexity), true
 
+	case "TrustedContact.created_at":
+		if e.complexity.TrustedContact.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.TrustedContact.CreatedAt(childComplexity), true
+	case "TrustedContact.email":
+		if e.complexity.TrustedContact.Email == nil {
+			break
+		}
+
+		return e.complexity.TrustedContact.Email(childComplexity), true
+	case "TrustedContact.id":
+		if e.complexity.TrustedContact.Id == nil {
+			break
+		}
+
+		return e.complexity.TrustedContact.Id(childComplexity), true
+	case "TrustedContact.name":
+		if e.complexity.TrustedContact.Name == nil {
+			break
+		}
+
+		return e.complexity.TrustedContact.Name(childComplexity), true
+
 	case "User.address":
 		if e.complexity.User.Address == nil {
 			break
@@ -1391,6 +2562,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.User.Gender(childComplexity), true
+	case "User.hide_online":
+		if e.complexity.User.HideOnline == nil {
+			break
+		}
+
+		return e.complexity.User.HideOnline(childComplexity), true
 	case "User.hobbies":
 		if e.complexity.User.Hobbies == nil {
 			break
@@ -1421,6 +2598,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.User.LastName(childComplexity), true
+	case "User.last_seen_at":
+		if e.complexity.User.LastSeenAt == nil {
+			break
+		}
+
+		return e.complexity.User.LastSeenAt(childComplexity), true
 	case "User.personality_traits":
 		if e.complexity.User.PersonalityTraits == nil {
 			break
@@ -1555,6 +2738,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.UserPublic.IsVerified(childComplexity), true
+	case "UserPublic.last_seen_at":
+		if e.complexity.UserPublic.LastSeenAt == nil {
+			break
+		}
+
+		return e.complexity.UserPublic.LastSeenAt(childComplexity), true
 	case "UserPublic.name":
 		if e.complexity.UserPublic.Name == nil {
 			break
@@ -1632,14 +2821,18 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputAddressInput,
+		ec.unmarshalInputChatMediaInput,
 		ec.unmarshalInputCommentFilterInput,
+		ec.unmarshalInputConnectionSettingsInput,
 		ec.unmarshalInputCreateCommentInput,
 		ec.unmarshalInputCreatePostInput,
 		ec.unmarshalInputCreateReportInput,
 		ec.unmarshalInputCreateUserInput,
+		ec.unmarshalInputDateProposalInput,
 		ec.unmarshalInputMediaInput,
 		ec.unmarshalInputPersonalityTraitInput,
 		ec.unmarshalInputPostFilterInput,
+		ec.unmarshalInputSendMessageInput,
 		ec.unmarshalInputSortInput,
 		ec.unmarshalInputUpdateCommentInput,
 		ec.unmarshalInputUpdatePostInput,
@@ -1690,6 +2883,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -1768,23 +2978,77 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
-func (ec *executionContext) field_Mutation_createProfileActivity_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_addTrustedContact_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "type", ec.unmarshalNActivityType2blindlyᚋinternalᚋmodelsᚐActivityType)
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
 	if err != nil {
 		return nil, err
 	}
-	args["type"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "target_user_id", ec.unmarshalNString2string)
+	args["name"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "email", ec.unmarshalNString2string)
 	if err != nil {
 		return nil, err
 	}
-	args["target_user_id"] = arg1
+	args["email"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Mutation_createReport_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+func (ec *executionContext) field_Mutation_cancelCheckIn_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_cancelScheduledMessage_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_checkIn_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createProfileActivity_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "type", ec.unmarshalNActivityType2blindlyᚋinternalᚋmodelsᚐActivityType)
+	if err != nil {
+		return nil, err
+	}
+	args["type"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "target_user_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["target_user_id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createReport_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
 	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateReportInput2blindlyᚋinternalᚋgraphᚋmodelᚐCreateReportInput)
@@ -1861,6 +3125,22 @@ func (ec *executionContext) field_Mutation_delete_post_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_exportChat_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "format", ec.unmarshalNChatExportFormat2blindlyᚋinternalᚋgraphᚋmodelᚐChatExportFormat)
+	if err != nil {
+		return nil, err
+	}
+	args["format"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_increment_post_view_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1888,6 +3168,75 @@ func (ec *executionContext) field_Mutation_loginWithPassword_args(ctx context.Co
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_markSeen_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "message_ids", ec.unmarshalNString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["message_ids"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_openViewOnce_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "message_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["message_id"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "media_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["media_id"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_react_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "message_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["message_id"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "reaction", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["reaction"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_removeTrustedContact_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_requestEmailLoginCode_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1899,6 +3248,86 @@ func (ec *executionContext) field_Mutation_requestEmailLoginCode_args(ctx contex
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_respondToDateProposal_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "message_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["message_id"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "action", ec.unmarshalNDateProposalAction2blindlyᚋinternalᚋchat_serviceᚐDateAction)
+	if err != nil {
+		return nil, err
+	}
+	args["action"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "counter", ec.unmarshalODateProposalInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐDateProposalInput)
+	if err != nil {
+		return nil, err
+	}
+	args["counter"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_scheduleCheckIn_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "date_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["date_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "check_in_at", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["check_in_at"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_scheduleMessage_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSendMessageInput2blindlyᚋinternalᚋgraphᚋmodelᚐSendMessageInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "deliver_at", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["deliver_at"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_sendMessage_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSendMessageInput2blindlyᚋinternalᚋgraphᚋmodelᚐSendMessageInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_shareDate_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "date_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["date_id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_swipe_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1937,6 +3366,38 @@ func (ec *executionContext) field_Mutation_toggle_post_like_args(ctx context.Con
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_unreact_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "message_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["message_id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateConnectionSettings_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "match_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["match_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNConnectionSettingsInput2blindlyᚋinternalᚋgraphᚋmodelᚐConnectionSettingsInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_updateMe_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -1997,6 +3458,43 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_chatExports_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_getMyConnections_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOConnectionFilter2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐConnectionFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "sort", ec.unmarshalOConnectionSort2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐConnectionSort)
+	if err != nil {
+		return nil, err
+	}
+	args["sort"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint32)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "cursor", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["cursor"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_get_comment_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -2108,6 +3606,38 @@ func (ec *executionContext) field_Query_get_trending_posts_args(ctx context.Cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_messageRevisions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "message_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["message_id"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_plannedDates_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "match_id", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["match_id"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "upcoming", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["upcoming"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_profileActivities_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -2135,6 +3665,17 @@ func (ec *executionContext) field_Query_recommendations_args(ctx context.Context
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_scheduledMessages_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -2146,6 +3687,17 @@ func (ec *executionContext) field_Query_user_args(ctx context.Context, rawArgs m
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_chatEvents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "chat_id", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["chat_id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -2399,6 +3951,10 @@ func (ec *executionContext) fieldContext_AuthPayload_user(_ context.Context, fie
 				return ec.fieldContext_User_address(ctx, field)
 			case "extra":
 				return ec.fieldContext_User_extra(ctx, field)
+			case "hide_online":
+				return ec.fieldContext_User_hide_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_User_last_seen_at(ctx, field)
 			case "created_at":
 				return ec.fieldContext_User_created_at(ctx, field)
 			case "updated_at":
@@ -2410,14 +3966,14 @@ func (ec *executionContext) fieldContext_AuthPayload_user(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Chat_id(ctx context.Context, field graphql.CollectedField, obj *models.Chat) (ret graphql.Marshaler) {
+func (ec *executionContext) _CallLog_call_id(ctx context.Context, field graphql.CollectedField, obj *models.CallLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Chat_id,
+		ec.fieldContext_CallLog_call_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			return obj.CallId, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2426,9 +3982,9 @@ func (ec *executionContext) _Chat_id(ctx context.Context, field graphql.Collecte
 	)
 }
 
-func (ec *executionContext) fieldContext_Chat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CallLog_call_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Chat",
+		Object:     "CallLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2439,14 +3995,14 @@ func (ec *executionContext) fieldContext_Chat_id(_ context.Context, field graphq
 	return fc, nil
 }
 
-func (ec *executionContext) _Chat_match_id(ctx context.Context, field graphql.CollectedField, obj *models.Chat) (ret graphql.Marshaler) {
+func (ec *executionContext) _CallLog_media(ctx context.Context, field graphql.CollectedField, obj *models.CallLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Chat_match_id,
+		ec.fieldContext_CallLog_media,
 		func(ctx context.Context) (any, error) {
-			return obj.MatchId, nil
+			return obj.Media, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2455,9 +4011,9 @@ func (ec *executionContext) _Chat_match_id(ctx context.Context, field graphql.Co
 	)
 }
 
-func (ec *executionContext) fieldContext_Chat_match_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CallLog_media(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Chat",
+		Object:     "CallLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2468,188 +4024,188 @@ func (ec *executionContext) fieldContext_Chat_match_id(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Chat_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Chat) (ret graphql.Marshaler) {
+func (ec *executionContext) _CallLog_status(ctx context.Context, field graphql.CollectedField, obj *models.CallLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Chat_created_at,
+		ec.fieldContext_CallLog_status,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNCallStatus2blindlyᚋinternalᚋmodelsᚐCallStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Chat_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CallLog_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Chat",
+		Object:     "CallLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type CallStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Comment_id(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
+func (ec *executionContext) _CallLog_started_at(ctx context.Context, field graphql.CollectedField, obj *models.CallLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Comment_id,
+		ec.fieldContext_CallLog_started_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			return obj.StartedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Comment_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CallLog_started_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Comment",
+		Object:     "CallLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Comment_post_id(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
+func (ec *executionContext) _CallLog_answered_at(ctx context.Context, field graphql.CollectedField, obj *models.CallLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Comment_post_id,
+		ec.fieldContext_CallLog_answered_at,
 		func(ctx context.Context) (any, error) {
-			return obj.PostId, nil
+			return obj.AnsweredAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Comment_post_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CallLog_answered_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Comment",
+		Object:     "CallLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Comment_user_id(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
+func (ec *executionContext) _CallLog_ended_at(ctx context.Context, field graphql.CollectedField, obj *models.CallLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Comment_user_id,
+		ec.fieldContext_CallLog_ended_at,
 		func(ctx context.Context) (any, error) {
-			return obj.UserId, nil
+			return obj.EndedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Comment_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CallLog_ended_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Comment",
+		Object:     "CallLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Comment_reply_to_id(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
+func (ec *executionContext) _CallLog_duration_sec(ctx context.Context, field graphql.CollectedField, obj *models.CallLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Comment_reply_to_id,
+		ec.fieldContext_CallLog_duration_sec,
 		func(ctx context.Context) (any, error) {
-			return obj.ReplyToId, nil
+			return ec.resolvers.CallLog().DurationSec(ctx, obj)
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Comment_reply_to_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CallLog_duration_sec(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Comment",
+		Object:     "CallLog",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Comment_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
+func (ec *executionContext) _CallLog_ended_by(ctx context.Context, field graphql.CollectedField, obj *models.CallLog) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Comment_created_at,
+		ec.fieldContext_CallLog_ended_by,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.EndedBy, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
-		true,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Comment_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CallLog_ended_by(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Comment",
+		Object:     "CallLog",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Comment_content(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
+func (ec *executionContext) _Chat_id(ctx context.Context, field graphql.CollectedField, obj *models.Chat) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Comment_content,
+		ec.fieldContext_Chat_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Content, nil
+			return obj.Id, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2658,9 +4214,9 @@ func (ec *executionContext) _Comment_content(ctx context.Context, field graphql.
 	)
 }
 
-func (ec *executionContext) fieldContext_Comment_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Chat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Comment",
+		Object:     "Chat",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2671,503 +4227,407 @@ func (ec *executionContext) fieldContext_Comment_content(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Comment_likes(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
+func (ec *executionContext) _Chat_match_id(ctx context.Context, field graphql.CollectedField, obj *models.Chat) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Comment_likes,
+		ec.fieldContext_Chat_match_id,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Comment().Likes(ctx, obj)
+			return obj.MatchId, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Comment_likes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Chat_match_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Comment",
+		Object:     "Chat",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Comment_user(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
+func (ec *executionContext) _Chat_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Chat) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Comment_user,
+		ec.fieldContext_Chat_created_at,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Comment().User(ctx, obj)
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Comment_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Chat_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Comment",
+		Object:     "Chat",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserPublic_id(ctx, field)
-			case "name":
-				return ec.fieldContext_UserPublic_name(ctx, field)
-			case "pfp":
-				return ec.fieldContext_UserPublic_pfp(ctx, field)
-			case "bio":
-				return ec.fieldContext_UserPublic_bio(ctx, field)
-			case "dob":
-				return ec.fieldContext_UserPublic_dob(ctx, field)
-			case "gender":
-				return ec.fieldContext_UserPublic_gender(ctx, field)
-			case "hobbies":
-				return ec.fieldContext_UserPublic_hobbies(ctx, field)
-			case "interests":
-				return ec.fieldContext_UserPublic_interests(ctx, field)
-			case "user_prompts":
-				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
-			case "personality_traits":
-				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
-			case "photos":
-				return ec.fieldContext_UserPublic_photos(ctx, field)
-			case "is_verified":
-				return ec.fieldContext_UserPublic_is_verified(ctx, field)
-			case "extra":
-				return ec.fieldContext_UserPublic_extra(ctx, field)
-			case "created_at":
-				return ec.fieldContext_UserPublic_created_at(ctx, field)
-			case "is_online":
-				return ec.fieldContext_UserPublic_is_online(ctx, field)
-			case "is_locked":
-				return ec.fieldContext_UserPublic_is_locked(ctx, field)
-			case "is_poked":
-				return ec.fieldContext_UserPublic_is_poked(ctx, field)
-			case "chat_id":
-				return ec.fieldContext_UserPublic_chat_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Comment_is_liked(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Comment_is_liked,
+		ec.fieldContext_ChatEvent_type,
 		func(ctx context.Context) (any, error) {
-			return obj.IsLiked, nil
+			return obj.Type, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNChatEventType2blindlyᚋinternalᚋgraphᚋmodelᚐChatEventType,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Comment_is_liked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Comment",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type ChatEventType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CommentsConnection_comments(ctx context.Context, field graphql.CollectedField, obj *model.CommentsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_chat_id(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CommentsConnection_comments,
+		ec.fieldContext_ChatEvent_chat_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Comments, nil
+			return obj.ChatID, nil
 		},
 		nil,
-		ec.marshalNComment2ᚕᚖblindlyᚋinternalᚋmodelsᚐComment,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CommentsConnection_comments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_chat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CommentsConnection",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Comment_id(ctx, field)
-			case "post_id":
-				return ec.fieldContext_Comment_post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Comment_user_id(ctx, field)
-			case "reply_to_id":
-				return ec.fieldContext_Comment_reply_to_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Comment_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Comment_content(ctx, field)
-			case "likes":
-				return ec.fieldContext_Comment_likes(ctx, field)
-			case "user":
-				return ec.fieldContext_Comment_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Comment_is_liked(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CommentsConnection_page_info(ctx context.Context, field graphql.CollectedField, obj *model.CommentsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_message(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CommentsConnection_page_info,
+		ec.fieldContext_ChatEvent_message,
 		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalNPageInfo2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPageInfo,
-		true,
+		ec.marshalOChatMessage2ᚖblindlyᚋinternalᚋmodelsᚐMessage,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CommentsConnection_page_info(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CommentsConnection",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "has_next_page":
-				return ec.fieldContext_PageInfo_has_next_page(ctx, field)
-			case "next_cursor":
-				return ec.fieldContext_PageInfo_next_cursor(ctx, field)
-			case "has_previous_page":
-				return ec.fieldContext_PageInfo_has_previous_page(ctx, field)
-			case "previous_cursor":
-				return ec.fieldContext_PageInfo_previous_cursor(ctx, field)
+			case "id":
+				return ec.fieldContext_ChatMessage_id(ctx, field)
+			case "type":
+				return ec.fieldContext_ChatMessage_type(ctx, field)
+			case "content":
+				return ec.fieldContext_ChatMessage_content(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_ChatMessage_sender_id(ctx, field)
+			case "received":
+				return ec.fieldContext_ChatMessage_received(ctx, field)
+			case "seen":
+				return ec.fieldContext_ChatMessage_seen(ctx, field)
+			case "media":
+				return ec.fieldContext_ChatMessage_media(ctx, field)
+			case "reactions":
+				return ec.fieldContext_ChatMessage_reactions(ctx, field)
+			case "reaction_counts":
+				return ec.fieldContext_ChatMessage_reaction_counts(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_ChatMessage_reply_to_id(ctx, field)
+			case "reply_to":
+				return ec.fieldContext_ChatMessage_reply_to(ctx, field)
+			case "date_proposal":
+				return ec.fieldContext_ChatMessage_date_proposal(ctx, field)
+			case "call":
+				return ec.fieldContext_ChatMessage_call(ctx, field)
+			case "edited_at":
+				return ec.fieldContext_ChatMessage_edited_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ChatMessage_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_ChatMessage_updated_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ChatMessage", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CommentsConnection_total_count(ctx context.Context, field graphql.CollectedField, obj *model.CommentsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_change(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CommentsConnection_total_count,
+		ec.fieldContext_ChatEvent_change,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalCount, nil
+			return obj.Change, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CommentsConnection_total_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_change(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CommentsConnection",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Connection_chat(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_message_ids(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Connection_chat,
+		ec.fieldContext_ChatEvent_message_ids,
 		func(ctx context.Context) (any, error) {
-			return obj.Chat, nil
+			return obj.MessageIds, nil
 		},
 		nil,
-		ec.marshalNChat2ᚖblindlyᚋinternalᚋmodelsᚐChat,
-		true,
+		ec.marshalOString2ᚕstringᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Connection_chat(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_message_ids(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Connection",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Chat_id(ctx, field)
-			case "match_id":
-				return ec.fieldContext_Chat_match_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Chat_created_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Chat", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Connection_match(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_user_id(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Connection_match,
+		ec.fieldContext_ChatEvent_user_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Match, nil
+			return obj.UserID, nil
 		},
 		nil,
-		ec.marshalNMatch2ᚖblindlyᚋinternalᚋmodelsᚐMatch,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Connection_match(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Connection",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Match_id(ctx, field)
-			case "she_id":
-				return ec.fieldContext_Match_she_id(ctx, field)
-			case "he_id":
-				return ec.fieldContext_Match_he_id(ctx, field)
-			case "score":
-				return ec.fieldContext_Match_score(ctx, field)
-			case "post_unlock_rating":
-				return ec.fieldContext_Match_post_unlock_rating(ctx, field)
-			case "is_unlocked":
-				return ec.fieldContext_Match_is_unlocked(ctx, field)
-			case "matched_at":
-				return ec.fieldContext_Match_matched_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Match", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Connection_last_message(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_is_typing(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Connection_last_message,
+		ec.fieldContext_ChatEvent_is_typing,
 		func(ctx context.Context) (any, error) {
-			return obj.LastMessage, nil
+			return obj.IsTyping, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOBoolean2ᚖbool,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Connection_last_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_is_typing(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Connection",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Connection_unread_messages(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_is_online(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Connection_unread_messages,
+		ec.fieldContext_ChatEvent_is_online,
 		func(ctx context.Context) (any, error) {
-			return obj.UnreadMessages, nil
+			return obj.IsOnline, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOBoolean2ᚖbool,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Connection_unread_messages(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_is_online(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Connection",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Connection_percentage_complete(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_last_seen_at(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Connection_percentage_complete,
+		ec.fieldContext_ChatEvent_last_seen_at,
 		func(ctx context.Context) (any, error) {
-			return obj.PercentageComplete, nil
+			return obj.LastSeenAt, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Connection_percentage_complete(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_last_seen_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Connection",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Connection_connection_profile(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.ChatEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Connection_connection_profile,
+		ec.fieldContext_ChatEvent_timestamp,
 		func(ctx context.Context) (any, error) {
-			return obj.ConnectionProfile, nil
+			return obj.Timestamp, nil
 		},
 		nil,
-		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Connection_connection_profile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatEvent_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Connection",
+		Object:     "ChatEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserPublic_id(ctx, field)
-			case "name":
-				return ec.fieldContext_UserPublic_name(ctx, field)
-			case "pfp":
-				return ec.fieldContext_UserPublic_pfp(ctx, field)
-			case "bio":
-				return ec.fieldContext_UserPublic_bio(ctx, field)
-			case "dob":
-				return ec.fieldContext_UserPublic_dob(ctx, field)
-			case "gender":
-				return ec.fieldContext_UserPublic_gender(ctx, field)
-			case "hobbies":
-				return ec.fieldContext_UserPublic_hobbies(ctx, field)
-			case "interests":
-				return ec.fieldContext_UserPublic_interests(ctx, field)
-			case "user_prompts":
-				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
-			case "personality_traits":
-				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
-			case "photos":
-				return ec.fieldContext_UserPublic_photos(ctx, field)
-			case "is_verified":
-				return ec.fieldContext_UserPublic_is_verified(ctx, field)
-			case "extra":
-				return ec.fieldContext_UserPublic_extra(ctx, field)
-			case "created_at":
-				return ec.fieldContext_UserPublic_created_at(ctx, field)
-			case "is_online":
-				return ec.fieldContext_UserPublic_is_online(ctx, field)
-			case "is_locked":
-				return ec.fieldContext_UserPublic_is_locked(ctx, field)
-			case "is_poked":
-				return ec.fieldContext_UserPublic_is_poked(ctx, field)
-			case "chat_id":
-				return ec.fieldContext_UserPublic_chat_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_school(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatExport_id(ctx context.Context, field graphql.CollectedField, obj *models.ChatExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_school,
+		ec.fieldContext_ChatExport_id,
 		func(ctx context.Context) (any, error) {
-			return obj.School, nil
+			return obj.Id, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_school(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatExport_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3178,25 +4638,25 @@ func (ec *executionContext) fieldContext_ExtraMetadata_school(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_work(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatExport_chat_id(ctx context.Context, field graphql.CollectedField, obj *models.ChatExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_work,
+		ec.fieldContext_ChatExport_chat_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Work, nil
+			return obj.ChatId, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_work(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatExport_chat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3207,25 +4667,25 @@ func (ec *executionContext) fieldContext_ExtraMetadata_work(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_looking_for(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatExport_format(ctx context.Context, field graphql.CollectedField, obj *models.ChatExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_looking_for,
+		ec.fieldContext_ChatExport_format,
 		func(ctx context.Context) (any, error) {
-			return obj.LookingFor, nil
+			return obj.Format, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstring,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_looking_for(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatExport_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3236,25 +4696,25 @@ func (ec *executionContext) fieldContext_ExtraMetadata_looking_for(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_zodiac(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatExport_status(ctx context.Context, field graphql.CollectedField, obj *models.ChatExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_zodiac,
+		ec.fieldContext_ChatExport_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Zodiac, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_zodiac(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatExport_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3265,25 +4725,25 @@ func (ec *executionContext) fieldContext_ExtraMetadata_zodiac(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_languages(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatExport_error(ctx context.Context, field graphql.CollectedField, obj *models.ChatExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_languages,
+		ec.fieldContext_ChatExport_error,
 		func(ctx context.Context) (any, error) {
-			return obj.Languages, nil
+			return obj.Error, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstring,
-		true,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_languages(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatExport_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3294,28 +4754,28 @@ func (ec *executionContext) fieldContext_ExtraMetadata_languages(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_excercise(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatExport_download_url(ctx context.Context, field graphql.CollectedField, obj *models.ChatExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_excercise,
+		ec.fieldContext_ChatExport_download_url,
 		func(ctx context.Context) (any, error) {
-			return obj.Excercise, nil
+			return ec.resolvers.ChatExport().DownloadURL(ctx, obj)
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_excercise(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatExport_download_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatExport",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -3323,83 +4783,83 @@ func (ec *executionContext) fieldContext_ExtraMetadata_excercise(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_drinking(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatExport_created_at(ctx context.Context, field graphql.CollectedField, obj *models.ChatExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_drinking,
+		ec.fieldContext_ChatExport_created_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Drinking, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNTime2timeᚐTime,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_drinking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatExport_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_smoking(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatExport_updated_at(ctx context.Context, field graphql.CollectedField, obj *models.ChatExport) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_smoking,
+		ec.fieldContext_ChatExport_updated_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Smoking, nil
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNTime2timeᚐTime,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_smoking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatExport_updated_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatExport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_kids(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_id(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_kids,
+		ec.fieldContext_ChatMessage_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Kids, nil
+			return obj.Id, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_kids(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3410,54 +4870,54 @@ func (ec *executionContext) fieldContext_ExtraMetadata_kids(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_religion(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_type(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_religion,
+		ec.fieldContext_ChatMessage_type,
 		func(ctx context.Context) (any, error) {
-			return obj.Religion, nil
+			return obj.Type, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNMessageType2blindlyᚋinternalᚋmodelsᚐMessageType,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_religion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type MessageType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_ethnicity(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_content(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_ethnicity,
+		ec.fieldContext_ChatMessage_content,
 		func(ctx context.Context) (any, error) {
-			return obj.Ethnicity, nil
+			return obj.Content, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_ethnicity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3468,25 +4928,25 @@ func (ec *executionContext) fieldContext_ExtraMetadata_ethnicity(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _ExtraMetadata_sexuality(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_sender_id(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ExtraMetadata_sexuality,
+		ec.fieldContext_ChatMessage_sender_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Sexuality, nil
+			return obj.SenderId, nil
 		},
 		nil,
-		ec.marshalOString2string,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ExtraMetadata_sexuality(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_sender_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ExtraMetadata",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3497,308 +4957,376 @@ func (ec *executionContext) fieldContext_ExtraMetadata_sexuality(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _Match_id(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_received(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Match_id,
+		ec.fieldContext_ChatMessage_received,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			return obj.Received, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Match_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_received(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Match",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Match_she_id(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_seen(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Match_she_id,
+		ec.fieldContext_ChatMessage_seen,
 		func(ctx context.Context) (any, error) {
-			return obj.SheId, nil
+			return obj.Seen, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Match_she_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_seen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Match",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Match_he_id(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_media(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Match_he_id,
+		ec.fieldContext_ChatMessage_media,
 		func(ctx context.Context) (any, error) {
-			return obj.HeId, nil
+			return obj.Media, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOMedia2ᚕblindlyᚋinternalᚋmodelsᚐMediaᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Match_he_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_media(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Match",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Media_id(ctx, field)
+			case "url":
+				return ec.fieldContext_Media_url(ctx, field)
+			case "type":
+				return ec.fieldContext_Media_type(ctx, field)
+			case "view_once":
+				return ec.fieldContext_Media_view_once(ctx, field)
+			case "opened_at":
+				return ec.fieldContext_Media_opened_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Media_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Media", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Match_score(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_reactions(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Match_score,
+		ec.fieldContext_ChatMessage_reactions,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Match().Score(ctx, obj)
+			return obj.Reactions, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOReaction2ᚕblindlyᚋinternalᚋmodelsᚐReactionᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Match_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_reactions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Match",
+		Object:     "ChatMessage",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Reaction_id(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_Reaction_sender_id(ctx, field)
+			case "content":
+				return ec.fieldContext_Reaction_content(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Reaction_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Reaction", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Match_post_unlock_rating(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_reaction_counts(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Match_post_unlock_rating,
+		ec.fieldContext_ChatMessage_reaction_counts,
 		func(ctx context.Context) (any, error) {
-			return obj.PostUnlockRating, nil
+			return obj.ReactionCounts, nil
 		},
 		nil,
-		ec.marshalNPostUnlockRating2blindlyᚋinternalᚋmodelsᚐPostUnlockRating,
-		true,
+		ec.marshalOReactionCount2ᚕblindlyᚋinternalᚋmodelsᚐReactionCountᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Match_post_unlock_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_reaction_counts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Match",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "she_rating":
-				return ec.fieldContext_PostUnlockRating_she_rating(ctx, field)
-			case "he_rating":
-				return ec.fieldContext_PostUnlockRating_he_rating(ctx, field)
+			case "content":
+				return ec.fieldContext_ReactionCount_content(ctx, field)
+			case "count":
+				return ec.fieldContext_ReactionCount_count(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PostUnlockRating", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ReactionCount", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Match_is_unlocked(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_reply_to_id(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Match_is_unlocked,
+		ec.fieldContext_ChatMessage_reply_to_id,
 		func(ctx context.Context) (any, error) {
-			return obj.IsUnlocked, nil
+			return obj.ReplyToId, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Match_is_unlocked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_reply_to_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Match",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Match_matched_at(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_reply_to(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Match_matched_at,
+		ec.fieldContext_ChatMessage_reply_to,
 		func(ctx context.Context) (any, error) {
-			return obj.MatchedAt, nil
+			return obj.ReplyTo, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
-		true,
+		ec.marshalOMessagePreview2ᚖblindlyᚋinternalᚋmodelsᚐMessagePreview,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Match_matched_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_reply_to(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Match",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_MessagePreview_id(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_MessagePreview_sender_id(ctx, field)
+			case "content":
+				return ec.fieldContext_MessagePreview_content(ctx, field)
+			case "type":
+				return ec.fieldContext_MessagePreview_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MessagePreview", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Media_id(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_date_proposal(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Media_id,
+		ec.fieldContext_ChatMessage_date_proposal,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			return obj.DateProposal, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalODateProposal2ᚖblindlyᚋinternalᚋmodelsᚐDateProposal,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Media_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_date_proposal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Media",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "starts_at":
+				return ec.fieldContext_DateProposal_starts_at(ctx, field)
+			case "venue":
+				return ec.fieldContext_DateProposal_venue(ctx, field)
+			case "note":
+				return ec.fieldContext_DateProposal_note(ctx, field)
+			case "status":
+				return ec.fieldContext_DateProposal_status(ctx, field)
+			case "responded_by":
+				return ec.fieldContext_DateProposal_responded_by(ctx, field)
+			case "responded_at":
+				return ec.fieldContext_DateProposal_responded_at(ctx, field)
+			case "counter_id":
+				return ec.fieldContext_DateProposal_counter_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DateProposal", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Media_url(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_call(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Media_url,
+		ec.fieldContext_ChatMessage_call,
 		func(ctx context.Context) (any, error) {
-			return obj.Url, nil
+			return obj.Call, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOCallLog2ᚖblindlyᚋinternalᚋmodelsᚐCallLog,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Media_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_call(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Media",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "call_id":
+				return ec.fieldContext_CallLog_call_id(ctx, field)
+			case "media":
+				return ec.fieldContext_CallLog_media(ctx, field)
+			case "status":
+				return ec.fieldContext_CallLog_status(ctx, field)
+			case "started_at":
+				return ec.fieldContext_CallLog_started_at(ctx, field)
+			case "answered_at":
+				return ec.fieldContext_CallLog_answered_at(ctx, field)
+			case "ended_at":
+				return ec.fieldContext_CallLog_ended_at(ctx, field)
+			case "duration_sec":
+				return ec.fieldContext_CallLog_duration_sec(ctx, field)
+			case "ended_by":
+				return ec.fieldContext_CallLog_ended_by(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CallLog", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Media_type(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_edited_at(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Media_type,
+		ec.fieldContext_ChatMessage_edited_at,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Media().Type(ctx, obj)
+			return obj.EditedAt, nil
 		},
 		nil,
-		ec.marshalNMediaType2blindlyᚋinternalᚋgraphᚋmodelᚐMediaType,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Media_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_edited_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Media",
+		Object:     "ChatMessage",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MediaType does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Media_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Media_created_at,
+		ec.fieldContext_ChatMessage_created_at,
 		func(ctx context.Context) (any, error) {
 			return obj.CreatedAt, nil
 		},
@@ -3809,9 +5337,9 @@ func (ec *executionContext) _Media_created_at(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext_Media_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Media",
+		Object:     "ChatMessage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3822,1216 +5350,811 @@ func (ec *executionContext) fieldContext_Media_created_at(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_create_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChatMessage_updated_at(ctx context.Context, field graphql.CollectedField, obj *models.Message) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_create_post,
+		ec.fieldContext_ChatMessage_updated_at,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreatePost(ctx, fc.Args["input"].(model.CreatePostInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.UpdatedAt, nil
 		},
-		ec.marshalNPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
+		nil,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_create_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChatMessage_updated_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ChatMessage",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Post_user_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Post_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "media":
-				return ec.fieldContext_Post_media(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "comments":
-				return ec.fieldContext_Post_comments(ctx, field)
-			case "views":
-				return ec.fieldContext_Post_views(ctx, field)
-			case "user":
-				return ec.fieldContext_Post_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Post_is_liked(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_create_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_update_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Comment_id(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_update_post,
+		ec.fieldContext_Comment_id,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdatePost(ctx, fc.Args["input"].(model.UpdatePostInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Id, nil
 		},
-		ec.marshalNPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
+		nil,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_update_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Comment_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Comment",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Post_user_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Post_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "media":
-				return ec.fieldContext_Post_media(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "comments":
-				return ec.fieldContext_Post_comments(ctx, field)
-			case "views":
-				return ec.fieldContext_Post_views(ctx, field)
-			case "user":
-				return ec.fieldContext_Post_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Post_is_liked(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_update_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_delete_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Comment_post_id(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_delete_post,
+		ec.fieldContext_Comment_post_id,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeletePost(ctx, fc.Args["post_id"].(string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.PostId, nil
 		},
-		ec.marshalNBoolean2bool,
+		nil,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_delete_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Comment_post_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Comment",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_delete_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_create_comment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Comment_user_id(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_create_comment,
+		ec.fieldContext_Comment_user_id,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateComment(ctx, fc.Args["input"].(model.CreateCommentInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.UserId, nil
 		},
-		ec.marshalNComment2ᚖblindlyᚋinternalᚋmodelsᚐComment,
+		nil,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_create_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Comment_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Comment",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Comment_id(ctx, field)
-			case "post_id":
-				return ec.fieldContext_Comment_post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Comment_user_id(ctx, field)
-			case "reply_to_id":
-				return ec.fieldContext_Comment_reply_to_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Comment_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Comment_content(ctx, field)
-			case "likes":
-				return ec.fieldContext_Comment_likes(ctx, field)
-			case "user":
-				return ec.fieldContext_Comment_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Comment_is_liked(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_create_comment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_update_comment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Comment_reply_to_id(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_update_comment,
+		ec.fieldContext_Comment_reply_to_id,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateComment(ctx, fc.Args["input"].(model.UpdateCommentInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.ReplyToId, nil
 		},
-		ec.marshalNComment2ᚖblindlyᚋinternalᚋmodelsᚐComment,
-		true,
+		nil,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_update_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Comment_reply_to_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Comment",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Comment_id(ctx, field)
-			case "post_id":
-				return ec.fieldContext_Comment_post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Comment_user_id(ctx, field)
-			case "reply_to_id":
-				return ec.fieldContext_Comment_reply_to_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Comment_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Comment_content(ctx, field)
-			case "likes":
-				return ec.fieldContext_Comment_likes(ctx, field)
-			case "user":
-				return ec.fieldContext_Comment_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Comment_is_liked(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_update_comment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_delete_comment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Comment_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_delete_comment,
+		ec.fieldContext_Comment_created_at,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().DeleteComment(ctx, fc.Args["comment_id"].(string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.CreatedAt, nil
 		},
-		ec.marshalNBoolean2bool,
+		nil,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_delete_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Comment_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Comment",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_delete_comment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_toggle_post_like(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Comment_content(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_toggle_post_like,
+		ec.fieldContext_Comment_content,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().TogglePostLike(ctx, fc.Args["post_id"].(string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Content, nil
 		},
-		ec.marshalNPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
+		nil,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_toggle_post_like(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Comment_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Comment",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Post_user_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Post_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "media":
-				return ec.fieldContext_Post_media(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "comments":
-				return ec.fieldContext_Post_comments(ctx, field)
-			case "views":
-				return ec.fieldContext_Post_views(ctx, field)
-			case "user":
-				return ec.fieldContext_Post_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Post_is_liked(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_toggle_post_like_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_toggle_comment_like(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Comment_likes(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_toggle_comment_like,
+		ec.fieldContext_Comment_likes,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().ToggleCommentLike(ctx, fc.Args["comment_id"].(string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return ec.resolvers.Comment().Likes(ctx, obj)
 		},
-		ec.marshalNComment2ᚖblindlyᚋinternalᚋmodelsᚐComment,
+		nil,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_toggle_comment_like(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Comment_likes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Comment",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Comment_id(ctx, field)
-			case "post_id":
-				return ec.fieldContext_Comment_post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Comment_user_id(ctx, field)
-			case "reply_to_id":
-				return ec.fieldContext_Comment_reply_to_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Comment_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Comment_content(ctx, field)
-			case "likes":
-				return ec.fieldContext_Comment_likes(ctx, field)
-			case "user":
-				return ec.fieldContext_Comment_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Comment_is_liked(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_toggle_comment_like_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_increment_post_view(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Comment_user(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_increment_post_view,
+		ec.fieldContext_Comment_user,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().IncrementPostView(ctx, fc.Args["post_id"].(string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return ec.resolvers.Comment().User(ctx, obj)
 		},
-		ec.marshalNPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
+		nil,
+		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_increment_post_view(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Comment_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Comment",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Post_user_id(ctx, field)
+				return ec.fieldContext_UserPublic_id(ctx, field)
+			case "name":
+				return ec.fieldContext_UserPublic_name(ctx, field)
+			case "pfp":
+				return ec.fieldContext_UserPublic_pfp(ctx, field)
+			case "bio":
+				return ec.fieldContext_UserPublic_bio(ctx, field)
+			case "dob":
+				return ec.fieldContext_UserPublic_dob(ctx, field)
+			case "gender":
+				return ec.fieldContext_UserPublic_gender(ctx, field)
+			case "hobbies":
+				return ec.fieldContext_UserPublic_hobbies(ctx, field)
+			case "interests":
+				return ec.fieldContext_UserPublic_interests(ctx, field)
+			case "user_prompts":
+				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
+			case "personality_traits":
+				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
+			case "photos":
+				return ec.fieldContext_UserPublic_photos(ctx, field)
+			case "is_verified":
+				return ec.fieldContext_UserPublic_is_verified(ctx, field)
+			case "extra":
+				return ec.fieldContext_UserPublic_extra(ctx, field)
 			case "created_at":
-				return ec.fieldContext_Post_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "media":
-				return ec.fieldContext_Post_media(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "comments":
-				return ec.fieldContext_Post_comments(ctx, field)
-			case "views":
-				return ec.fieldContext_Post_views(ctx, field)
-			case "user":
-				return ec.fieldContext_Post_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Post_is_liked(ctx, field)
+				return ec.fieldContext_UserPublic_created_at(ctx, field)
+			case "is_online":
+				return ec.fieldContext_UserPublic_is_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_UserPublic_last_seen_at(ctx, field)
+			case "is_locked":
+				return ec.fieldContext_UserPublic_is_locked(ctx, field)
+			case "is_poked":
+				return ec.fieldContext_UserPublic_is_poked(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_UserPublic_chat_id(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_increment_post_view_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createProfileActivity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Comment_is_liked(ctx context.Context, field graphql.CollectedField, obj *models.Comment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createProfileActivity,
+		ec.fieldContext_Comment_is_liked,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateProfileActivity(ctx, fc.Args["type"].(models.ActivityType), fc.Args["target_user_id"].(string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.IsLiked, nil
 		},
-		ec.marshalNUserProfileActivity2ᚖblindlyᚋinternalᚋmodelsᚐUserProfileActivity,
+		nil,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createProfileActivity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Comment_is_liked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Comment",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserProfileActivity_id(ctx, field)
-			case "type":
-				return ec.fieldContext_UserProfileActivity_type(ctx, field)
-			case "target_user":
-				return ec.fieldContext_UserProfileActivity_target_user(ctx, field)
-			case "class":
-				return ec.fieldContext_UserProfileActivity_class(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserProfileActivity", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createProfileActivity_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CommentsConnection_comments(ctx context.Context, field graphql.CollectedField, obj *model.CommentsConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createReport,
+		ec.fieldContext_CommentsConnection_comments,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateReport(ctx, fc.Args["input"].(model.CreateReportInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Comments, nil
 		},
-		ec.marshalNReport2ᚖblindlyᚋinternalᚋmodelsᚐReport,
+		nil,
+		ec.marshalNComment2ᚕᚖblindlyᚋinternalᚋmodelsᚐComment,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommentsConnection_comments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CommentsConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Report_id(ctx, field)
+				return ec.fieldContext_Comment_id(ctx, field)
+			case "post_id":
+				return ec.fieldContext_Comment_post_id(ctx, field)
 			case "user_id":
-				return ec.fieldContext_Report_user_id(ctx, field)
-			case "target_id":
-				return ec.fieldContext_Report_target_id(ctx, field)
-			case "reason":
-				return ec.fieldContext_Report_reason(ctx, field)
-			case "additional_info":
-				return ec.fieldContext_Report_additional_info(ctx, field)
-			case "media":
-				return ec.fieldContext_Report_media(ctx, field)
-			case "status":
-				return ec.fieldContext_Report_status(ctx, field)
+				return ec.fieldContext_Comment_user_id(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_Comment_reply_to_id(ctx, field)
 			case "created_at":
-				return ec.fieldContext_Report_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_Report_updated_at(ctx, field)
+				return ec.fieldContext_Comment_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Comment_content(ctx, field)
+			case "likes":
+				return ec.fieldContext_Comment_likes(ctx, field)
+			case "user":
+				return ec.fieldContext_Comment_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Comment_is_liked(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Report", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createReport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_swipe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _CommentsConnection_page_info(ctx context.Context, field graphql.CollectedField, obj *model.CommentsConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_swipe,
+		ec.fieldContext_CommentsConnection_page_info,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().Swipe(ctx, fc.Args["target_id"].(string), fc.Args["action_type"].(models.SwipeType))
+			return obj.PageInfo, nil
 		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+		nil,
+		ec.marshalNPageInfo2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPageInfo,
+		true,
+		true,
+	)
+}
 
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
+func (ec *executionContext) fieldContext_CommentsConnection_page_info(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CommentsConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "has_next_page":
+				return ec.fieldContext_PageInfo_has_next_page(ctx, field)
+			case "next_cursor":
+				return ec.fieldContext_PageInfo_next_cursor(ctx, field)
+			case "has_previous_page":
+				return ec.fieldContext_PageInfo_has_previous_page(ctx, field)
+			case "previous_cursor":
+				return ec.fieldContext_PageInfo_previous_cursor(ctx, field)
 			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
 
-			next = directive1
-			return next
+func (ec *executionContext) _CommentsConnection_total_count(ctx context.Context, field graphql.CollectedField, obj *model.CommentsConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CommentsConnection_total_count,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCount, nil
 		},
-		ec.marshalNSwipeResponse2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipeResponse,
+		nil,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_swipe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CommentsConnection_total_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CommentsConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "swipe":
-				return ec.fieldContext_SwipeResponse_swipe(ctx, field)
-			case "match":
-				return ec.fieldContext_SwipeResponse_match(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SwipeResponse", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_swipe_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Connection_chat(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createUser,
+		ec.fieldContext_Connection_chat,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateUser(ctx, fc.Args["input"].(model.CreateUserInput))
+			return obj.Chat, nil
 		},
 		nil,
-		ec.marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload,
+		ec.marshalNChat2ᚖblindlyᚋinternalᚋmodelsᚐChat,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connection_chat(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Connection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "access_token":
-				return ec.fieldContext_AuthPayload_access_token(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "id":
+				return ec.fieldContext_Chat_id(ctx, field)
+			case "match_id":
+				return ec.fieldContext_Chat_match_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Chat_created_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Chat", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_loginWithPassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Connection_match(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_loginWithPassword,
+		ec.fieldContext_Connection_match,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().LoginWithPassword(ctx, fc.Args["email"].(string), fc.Args["password"].(string))
+			return obj.Match, nil
 		},
 		nil,
-		ec.marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload,
+		ec.marshalNMatch2ᚖblindlyᚋinternalᚋmodelsᚐMatch,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_loginWithPassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connection_match(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Connection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "access_token":
-				return ec.fieldContext_AuthPayload_access_token(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "id":
+				return ec.fieldContext_Match_id(ctx, field)
+			case "she_id":
+				return ec.fieldContext_Match_she_id(ctx, field)
+			case "he_id":
+				return ec.fieldContext_Match_he_id(ctx, field)
+			case "score":
+				return ec.fieldContext_Match_score(ctx, field)
+			case "post_unlock_rating":
+				return ec.fieldContext_Match_post_unlock_rating(ctx, field)
+			case "is_unlocked":
+				return ec.fieldContext_Match_is_unlocked(ctx, field)
+			case "matched_at":
+				return ec.fieldContext_Match_matched_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Match", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_loginWithPassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_requestEmailLoginCode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Connection_last_message(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_requestEmailLoginCode,
+		ec.fieldContext_Connection_last_message,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().RequestEmailLoginCode(ctx, fc.Args["email"].(string))
+			return obj.LastMessage, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_requestEmailLoginCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connection_last_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Connection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_requestEmailLoginCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_verifyEmailLoginCode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Connection_unread_messages(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_verifyEmailLoginCode,
+		ec.fieldContext_Connection_unread_messages,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().VerifyEmailLoginCode(ctx, fc.Args["email"].(string), fc.Args["code"].(string))
+			return obj.UnreadMessages, nil
 		},
 		nil,
-		ec.marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_verifyEmailLoginCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connection_unread_messages(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Connection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "access_token":
-				return ec.fieldContext_AuthPayload_access_token(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_verifyEmailLoginCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updateMe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Connection_percentage_complete(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_updateMe,
+		ec.fieldContext_Connection_percentage_complete,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().UpdateMe(ctx, fc.Args["input"].(model.UpdateUserInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.PercentageComplete, nil
 		},
-		ec.marshalNUser2ᚖblindlyᚋinternalᚋmodelsᚐUser,
+		nil,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updateMe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connection_percentage_complete(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Connection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "first_name":
-				return ec.fieldContext_User_first_name(ctx, field)
-			case "last_name":
-				return ec.fieldContext_User_last_name(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "dob":
-				return ec.fieldContext_User_dob(ctx, field)
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Connection_connection_profile(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Connection_connection_profile,
+		func(ctx context.Context) (any, error) {
+			return obj.ConnectionProfile, nil
+		},
+		nil,
+		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Connection_connection_profile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Connection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserPublic_id(ctx, field)
+			case "name":
+				return ec.fieldContext_UserPublic_name(ctx, field)
 			case "pfp":
-				return ec.fieldContext_User_pfp(ctx, field)
-			case "gender":
-				return ec.fieldContext_User_gender(ctx, field)
+				return ec.fieldContext_UserPublic_pfp(ctx, field)
 			case "bio":
-				return ec.fieldContext_User_bio(ctx, field)
+				return ec.fieldContext_UserPublic_bio(ctx, field)
+			case "dob":
+				return ec.fieldContext_UserPublic_dob(ctx, field)
+			case "gender":
+				return ec.fieldContext_UserPublic_gender(ctx, field)
 			case "hobbies":
-				return ec.fieldContext_User_hobbies(ctx, field)
+				return ec.fieldContext_UserPublic_hobbies(ctx, field)
 			case "interests":
-				return ec.fieldContext_User_interests(ctx, field)
+				return ec.fieldContext_UserPublic_interests(ctx, field)
 			case "user_prompts":
-				return ec.fieldContext_User_user_prompts(ctx, field)
+				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
 			case "personality_traits":
-				return ec.fieldContext_User_personality_traits(ctx, field)
+				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
 			case "photos":
-				return ec.fieldContext_User_photos(ctx, field)
+				return ec.fieldContext_UserPublic_photos(ctx, field)
 			case "is_verified":
-				return ec.fieldContext_User_is_verified(ctx, field)
-			case "address":
-				return ec.fieldContext_User_address(ctx, field)
+				return ec.fieldContext_UserPublic_is_verified(ctx, field)
 			case "extra":
-				return ec.fieldContext_User_extra(ctx, field)
+				return ec.fieldContext_UserPublic_extra(ctx, field)
 			case "created_at":
-				return ec.fieldContext_User_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_User_updated_at(ctx, field)
+				return ec.fieldContext_UserPublic_created_at(ctx, field)
+			case "is_online":
+				return ec.fieldContext_UserPublic_is_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_UserPublic_last_seen_at(ctx, field)
+			case "is_locked":
+				return ec.fieldContext_UserPublic_is_locked(ctx, field)
+			case "is_poked":
+				return ec.fieldContext_UserPublic_is_poked(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_UserPublic_chat_id(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updateMe_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Connection_settings(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_refreshToken,
+		ec.fieldContext_Connection_settings,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Mutation().RefreshToken(ctx)
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Settings, nil
 		},
-		ec.marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload,
+		nil,
+		ec.marshalNConnectionSettings2ᚖblindlyᚋinternalᚋmodelsᚐConnectionSettings,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_refreshToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connection_settings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Connection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "access_token":
-				return ec.fieldContext_AuthPayload_access_token(ctx, field)
-			case "user":
-				return ec.fieldContext_AuthPayload_user(ctx, field)
+			case "match_id":
+				return ec.fieldContext_ConnectionSettings_match_id(ctx, field)
+			case "pinned":
+				return ec.fieldContext_ConnectionSettings_pinned(ctx, field)
+			case "archived":
+				return ec.fieldContext_ConnectionSettings_archived(ctx, field)
+			case "muted_until":
+				return ec.fieldContext_ConnectionSettings_muted_until(ctx, field)
+			case "is_muted":
+				return ec.fieldContext_ConnectionSettings_is_muted(ctx, field)
+			case "nickname":
+				return ec.fieldContext_ConnectionSettings_nickname(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ConnectionSettings", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createVerification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Connection_last_activity_at(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Mutation_createVerification,
+		ec.fieldContext_Connection_last_activity_at,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateVerification(ctx, fc.Args["input"].(model.UserVerificationInput))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.LastActivityAt, nil
 		},
-		ec.marshalNUserVerification2ᚖblindlyᚋinternalᚋmodelsᚐUserVerification,
+		nil,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createVerification(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connection_last_activity_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "Connection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserVerification_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_UserVerification_user_id(ctx, field)
-			case "media":
-				return ec.fieldContext_UserVerification_media(ctx, field)
-			case "status":
-				return ec.fieldContext_UserVerification_status(ctx, field)
-			case "created_at":
-				return ec.fieldContext_UserVerification_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_UserVerification_updated_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserVerification", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createVerification_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_has_next_page(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Connection_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Connection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_has_next_page,
+		ec.fieldContext_Connection_cursor,
 		func(ctx context.Context) (any, error) {
-			return obj.HasNextPage, nil
+			return obj.Cursor, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_has_next_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connection_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "Connection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_next_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionSettings_match_id(ctx context.Context, field graphql.CollectedField, obj *models.ConnectionSettings) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_next_cursor,
+		ec.fieldContext_ConnectionSettings_match_id,
 		func(ctx context.Context) (any, error) {
-			return obj.NextCursor, nil
+			return obj.MatchId, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_next_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionSettings_match_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "ConnectionSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5042,14 +6165,14 @@ func (ec *executionContext) fieldContext_PageInfo_next_cursor(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_has_previous_page(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionSettings_pinned(ctx context.Context, field graphql.CollectedField, obj *models.ConnectionSettings) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_has_previous_page,
+		ec.fieldContext_ConnectionSettings_pinned,
 		func(ctx context.Context) (any, error) {
-			return obj.HasPreviousPage, nil
+			return obj.Pinned, nil
 		},
 		nil,
 		ec.marshalNBoolean2bool,
@@ -5058,9 +6181,9 @@ func (ec *executionContext) _PageInfo_has_previous_page(ctx context.Context, fie
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_has_previous_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionSettings_pinned(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "ConnectionSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5071,101 +6194,101 @@ func (ec *executionContext) fieldContext_PageInfo_has_previous_page(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_previous_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionSettings_archived(ctx context.Context, field graphql.CollectedField, obj *models.ConnectionSettings) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfo_previous_cursor,
+		ec.fieldContext_ConnectionSettings_archived,
 		func(ctx context.Context) (any, error) {
-			return obj.PreviousCursor, nil
+			return obj.Archived, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_previous_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionSettings_archived(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "ConnectionSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PersonalityTrait_key(ctx context.Context, field graphql.CollectedField, obj *model.PersonalityTrait) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionSettings_muted_until(ctx context.Context, field graphql.CollectedField, obj *models.ConnectionSettings) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PersonalityTrait_key,
+		ec.fieldContext_ConnectionSettings_muted_until,
 		func(ctx context.Context) (any, error) {
-			return obj.Key, nil
+			return obj.MutedUntil, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PersonalityTrait_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionSettings_muted_until(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PersonalityTrait",
+		Object:     "ConnectionSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PersonalityTrait_value(ctx context.Context, field graphql.CollectedField, obj *model.PersonalityTrait) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionSettings_is_muted(ctx context.Context, field graphql.CollectedField, obj *models.ConnectionSettings) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PersonalityTrait_value,
+		ec.fieldContext_ConnectionSettings_is_muted,
 		func(ctx context.Context) (any, error) {
-			return obj.Value, nil
+			return ec.resolvers.ConnectionSettings().IsMuted(ctx, obj)
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PersonalityTrait_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionSettings_is_muted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PersonalityTrait",
+		Object:     "ConnectionSettings",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConnectionSettings_nickname(ctx context.Context, field graphql.CollectedField, obj *models.ConnectionSettings) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_id,
+		ec.fieldContext_ConnectionSettings_nickname,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			return obj.Nickname, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -5174,9 +6297,9 @@ func (ec *executionContext) _Post_id(ctx context.Context, field graphql.Collecte
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectionSettings_nickname(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "ConnectionSettings",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5187,14 +6310,14 @@ func (ec *executionContext) fieldContext_Post_id(_ context.Context, field graphq
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_user_id(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateCheckIn_id(ctx context.Context, field graphql.CollectedField, obj *models.DateCheckIn) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_user_id,
+		ec.fieldContext_DateCheckIn_id,
 		func(ctx context.Context) (any, error) {
-			return obj.UserId, nil
+			return obj.Id, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -5203,9 +6326,9 @@ func (ec *executionContext) _Post_user_id(ctx context.Context, field graphql.Col
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateCheckIn_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "DateCheckIn",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5216,1439 +6339,985 @@ func (ec *executionContext) fieldContext_Post_user_id(_ context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateCheckIn_date_id(ctx context.Context, field graphql.CollectedField, obj *models.DateCheckIn) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_created_at,
+		ec.fieldContext_DateCheckIn_date_id,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.DateId, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateCheckIn_date_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "DateCheckIn",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateCheckIn_check_in_at(ctx context.Context, field graphql.CollectedField, obj *models.DateCheckIn) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_content,
+		ec.fieldContext_DateCheckIn_check_in_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Content, nil
+			return obj.CheckInAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateCheckIn_check_in_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "DateCheckIn",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_media(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateCheckIn_status(ctx context.Context, field graphql.CollectedField, obj *models.DateCheckIn) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_media,
+		ec.fieldContext_DateCheckIn_status,
 		func(ctx context.Context) (any, error) {
-			return obj.Media, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_media(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateCheckIn_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "DateCheckIn",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Media_id(ctx, field)
-			case "url":
-				return ec.fieldContext_Media_url(ctx, field)
-			case "type":
-				return ec.fieldContext_Media_type(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Media_created_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Media", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_likes(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateCheckIn_checked_in_at(ctx context.Context, field graphql.CollectedField, obj *models.DateCheckIn) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_likes,
+		ec.fieldContext_DateCheckIn_checked_in_at,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Post().Likes(ctx, obj)
+			return obj.CheckedInAt, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_likes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateCheckIn_checked_in_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "DateCheckIn",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_comments(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateCheckIn_alerted_at(ctx context.Context, field graphql.CollectedField, obj *models.DateCheckIn) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_comments,
+		ec.fieldContext_DateCheckIn_alerted_at,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Post().Comments(ctx, obj)
+			return obj.AlertedAt, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_comments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateCheckIn_alerted_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "DateCheckIn",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_views(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateCheckIn_created_at(ctx context.Context, field graphql.CollectedField, obj *models.DateCheckIn) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_views,
+		ec.fieldContext_DateCheckIn_created_at,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Post().Views(ctx, obj)
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_views(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateCheckIn_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "DateCheckIn",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_user(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposal_starts_at(ctx context.Context, field graphql.CollectedField, obj *models.DateProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_user,
+		ec.fieldContext_DateProposal_starts_at,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Post().User(ctx, obj)
+			return obj.StartsAt, nil
 		},
 		nil,
-		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposal_starts_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "DateProposal",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserPublic_id(ctx, field)
-			case "name":
-				return ec.fieldContext_UserPublic_name(ctx, field)
-			case "pfp":
-				return ec.fieldContext_UserPublic_pfp(ctx, field)
-			case "bio":
-				return ec.fieldContext_UserPublic_bio(ctx, field)
-			case "dob":
-				return ec.fieldContext_UserPublic_dob(ctx, field)
-			case "gender":
-				return ec.fieldContext_UserPublic_gender(ctx, field)
-			case "hobbies":
-				return ec.fieldContext_UserPublic_hobbies(ctx, field)
-			case "interests":
-				return ec.fieldContext_UserPublic_interests(ctx, field)
-			case "user_prompts":
-				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
-			case "personality_traits":
-				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
-			case "photos":
-				return ec.fieldContext_UserPublic_photos(ctx, field)
-			case "is_verified":
-				return ec.fieldContext_UserPublic_is_verified(ctx, field)
-			case "extra":
-				return ec.fieldContext_UserPublic_extra(ctx, field)
-			case "created_at":
-				return ec.fieldContext_UserPublic_created_at(ctx, field)
-			case "is_online":
-				return ec.fieldContext_UserPublic_is_online(ctx, field)
-			case "is_locked":
-				return ec.fieldContext_UserPublic_is_locked(ctx, field)
-			case "is_poked":
-				return ec.fieldContext_UserPublic_is_poked(ctx, field)
-			case "chat_id":
-				return ec.fieldContext_UserPublic_chat_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Post_is_liked(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposal_venue(ctx context.Context, field graphql.CollectedField, obj *models.DateProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Post_is_liked,
+		ec.fieldContext_DateProposal_venue,
 		func(ctx context.Context) (any, error) {
-			return obj.IsLiked, nil
+			return obj.Venue, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Post_is_liked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposal_venue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Post",
+		Object:     "DateProposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PostUnlockRating_she_rating(ctx context.Context, field graphql.CollectedField, obj *models.PostUnlockRating) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposal_note(ctx context.Context, field graphql.CollectedField, obj *models.DateProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PostUnlockRating_she_rating,
+		ec.fieldContext_DateProposal_note,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.PostUnlockRating().SheRating(ctx, obj)
+			return obj.Note, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PostUnlockRating_she_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposal_note(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PostUnlockRating",
+		Object:     "DateProposal",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PostUnlockRating_he_rating(ctx context.Context, field graphql.CollectedField, obj *models.PostUnlockRating) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposal_status(ctx context.Context, field graphql.CollectedField, obj *models.DateProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PostUnlockRating_he_rating,
+		ec.fieldContext_DateProposal_status,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.PostUnlockRating().HeRating(ctx, obj)
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNDateProposalStatus2blindlyᚋinternalᚋmodelsᚐDateProposalStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PostUnlockRating_he_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposal_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PostUnlockRating",
+		Object:     "DateProposal",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type DateProposalStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PostsConnection_posts(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposal_responded_by(ctx context.Context, field graphql.CollectedField, obj *models.DateProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PostsConnection_posts,
+		ec.fieldContext_DateProposal_responded_by,
 		func(ctx context.Context) (any, error) {
-			return obj.Posts, nil
+			return obj.RespondedBy, nil
 		},
 		nil,
-		ec.marshalNPost2ᚕᚖblindlyᚋinternalᚋmodelsᚐPost,
-		true,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PostsConnection_posts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposal_responded_by(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PostsConnection",
+		Object:     "DateProposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Post_user_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Post_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "media":
-				return ec.fieldContext_Post_media(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "comments":
-				return ec.fieldContext_Post_comments(ctx, field)
-			case "views":
-				return ec.fieldContext_Post_views(ctx, field)
-			case "user":
-				return ec.fieldContext_Post_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Post_is_liked(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PostsConnection_page_info(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposal_responded_at(ctx context.Context, field graphql.CollectedField, obj *models.DateProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PostsConnection_page_info,
+		ec.fieldContext_DateProposal_responded_at,
 		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
+			return obj.RespondedAt, nil
 		},
 		nil,
-		ec.marshalNPageInfo2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPageInfo,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PostsConnection_page_info(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposal_responded_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PostsConnection",
+		Object:     "DateProposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "has_next_page":
-				return ec.fieldContext_PageInfo_has_next_page(ctx, field)
-			case "next_cursor":
-				return ec.fieldContext_PageInfo_next_cursor(ctx, field)
-			case "has_previous_page":
-				return ec.fieldContext_PageInfo_has_previous_page(ctx, field)
-			case "previous_cursor":
-				return ec.fieldContext_PageInfo_previous_cursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PostsConnection_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposal_counter_id(ctx context.Context, field graphql.CollectedField, obj *models.DateProposal) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PostsConnection_total_count,
+		ec.fieldContext_DateProposal_counter_id,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalCount, nil
+			return obj.CounterId, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PostsConnection_total_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposal_counter_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PostsConnection",
+		Object:     "DateProposal",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMyConnections(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposalResponse_proposal(ctx context.Context, field graphql.CollectedField, obj *chatservice.DateResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getMyConnections,
+		ec.fieldContext_DateProposalResponse_proposal,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().GetMyConnections(ctx)
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Proposal, nil
 		},
-		ec.marshalNConnection2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐConnection,
+		nil,
+		ec.marshalNChatMessage2ᚖblindlyᚋinternalᚋmodelsᚐMessage,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getMyConnections(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposalResponse_proposal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DateProposalResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "chat":
-				return ec.fieldContext_Connection_chat(ctx, field)
-			case "match":
-				return ec.fieldContext_Connection_match(ctx, field)
-			case "last_message":
-				return ec.fieldContext_Connection_last_message(ctx, field)
-			case "unread_messages":
-				return ec.fieldContext_Connection_unread_messages(ctx, field)
-			case "percentage_complete":
-				return ec.fieldContext_Connection_percentage_complete(ctx, field)
-			case "connection_profile":
-				return ec.fieldContext_Connection_connection_profile(ctx, field)
+			case "id":
+				return ec.fieldContext_ChatMessage_id(ctx, field)
+			case "type":
+				return ec.fieldContext_ChatMessage_type(ctx, field)
+			case "content":
+				return ec.fieldContext_ChatMessage_content(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_ChatMessage_sender_id(ctx, field)
+			case "received":
+				return ec.fieldContext_ChatMessage_received(ctx, field)
+			case "seen":
+				return ec.fieldContext_ChatMessage_seen(ctx, field)
+			case "media":
+				return ec.fieldContext_ChatMessage_media(ctx, field)
+			case "reactions":
+				return ec.fieldContext_ChatMessage_reactions(ctx, field)
+			case "reaction_counts":
+				return ec.fieldContext_ChatMessage_reaction_counts(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_ChatMessage_reply_to_id(ctx, field)
+			case "reply_to":
+				return ec.fieldContext_ChatMessage_reply_to(ctx, field)
+			case "date_proposal":
+				return ec.fieldContext_ChatMessage_date_proposal(ctx, field)
+			case "call":
+				return ec.fieldContext_ChatMessage_call(ctx, field)
+			case "edited_at":
+				return ec.fieldContext_ChatMessage_edited_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ChatMessage_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_ChatMessage_updated_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Connection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ChatMessage", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_get_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposalResponse_counter(ctx context.Context, field graphql.CollectedField, obj *chatservice.DateResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_get_posts,
+		ec.fieldContext_DateProposalResponse_counter,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetPosts(ctx, fc.Args["filter"].(*model.PostFilterInput), fc.Args["sort"].(*model.SortInput), fc.Args["limit"].(*int32), fc.Args["cursor"].(*string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Counter, nil
 		},
-		ec.marshalNPostsConnection2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPostsConnection,
-		true,
+		nil,
+		ec.marshalOChatMessage2ᚖblindlyᚋinternalᚋmodelsᚐMessage,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_get_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposalResponse_counter(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DateProposalResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "posts":
-				return ec.fieldContext_PostsConnection_posts(ctx, field)
-			case "page_info":
-				return ec.fieldContext_PostsConnection_page_info(ctx, field)
-			case "total_count":
-				return ec.fieldContext_PostsConnection_total_count(ctx, field)
+			case "id":
+				return ec.fieldContext_ChatMessage_id(ctx, field)
+			case "type":
+				return ec.fieldContext_ChatMessage_type(ctx, field)
+			case "content":
+				return ec.fieldContext_ChatMessage_content(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_ChatMessage_sender_id(ctx, field)
+			case "received":
+				return ec.fieldContext_ChatMessage_received(ctx, field)
+			case "seen":
+				return ec.fieldContext_ChatMessage_seen(ctx, field)
+			case "media":
+				return ec.fieldContext_ChatMessage_media(ctx, field)
+			case "reactions":
+				return ec.fieldContext_ChatMessage_reactions(ctx, field)
+			case "reaction_counts":
+				return ec.fieldContext_ChatMessage_reaction_counts(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_ChatMessage_reply_to_id(ctx, field)
+			case "reply_to":
+				return ec.fieldContext_ChatMessage_reply_to(ctx, field)
+			case "date_proposal":
+				return ec.fieldContext_ChatMessage_date_proposal(ctx, field)
+			case "call":
+				return ec.fieldContext_ChatMessage_call(ctx, field)
+			case "edited_at":
+				return ec.fieldContext_ChatMessage_edited_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ChatMessage_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_ChatMessage_updated_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PostsConnection", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ChatMessage", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_get_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_get_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DateProposalResponse_date(ctx context.Context, field graphql.CollectedField, obj *chatservice.DateResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_get_post,
+		ec.fieldContext_DateProposalResponse_date,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetPost(ctx, fc.Args["post_id"].(string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Date, nil
 		},
-		ec.marshalOPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
+		nil,
+		ec.marshalOPlannedDate2ᚖblindlyᚋinternalᚋmodelsᚐPlannedDate,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_get_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DateProposalResponse_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DateProposalResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Post_user_id(ctx, field)
+				return ec.fieldContext_PlannedDate_id(ctx, field)
+			case "match_id":
+				return ec.fieldContext_PlannedDate_match_id(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_PlannedDate_chat_id(ctx, field)
+			case "message_id":
+				return ec.fieldContext_PlannedDate_message_id(ctx, field)
+			case "proposed_by":
+				return ec.fieldContext_PlannedDate_proposed_by(ctx, field)
+			case "accepted_by":
+				return ec.fieldContext_PlannedDate_accepted_by(ctx, field)
+			case "starts_at":
+				return ec.fieldContext_PlannedDate_starts_at(ctx, field)
+			case "venue":
+				return ec.fieldContext_PlannedDate_venue(ctx, field)
+			case "note":
+				return ec.fieldContext_PlannedDate_note(ctx, field)
 			case "created_at":
-				return ec.fieldContext_Post_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Post_content(ctx, field)
-			case "media":
-				return ec.fieldContext_Post_media(ctx, field)
-			case "likes":
-				return ec.fieldContext_Post_likes(ctx, field)
-			case "comments":
-				return ec.fieldContext_Post_comments(ctx, field)
-			case "views":
-				return ec.fieldContext_Post_views(ctx, field)
-			case "user":
-				return ec.fieldContext_Post_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Post_is_liked(ctx, field)
+				return ec.fieldContext_PlannedDate_created_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PlannedDate", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_get_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_get_feed_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_school(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_get_feed_posts,
+		ec.fieldContext_ExtraMetadata_school,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetFeedPosts(ctx, fc.Args["limit"].(*int32), fc.Args["cursor"].(*string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.School, nil
 		},
-		ec.marshalNPostsConnection2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPostsConnection,
-		true,
+		nil,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_get_feed_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_school(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "posts":
-				return ec.fieldContext_PostsConnection_posts(ctx, field)
-			case "page_info":
-				return ec.fieldContext_PostsConnection_page_info(ctx, field)
-			case "total_count":
-				return ec.fieldContext_PostsConnection_total_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PostsConnection", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_get_feed_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_get_comments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_work(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_get_comments,
+		ec.fieldContext_ExtraMetadata_work,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetComments(ctx, fc.Args["filter"].(model.CommentFilterInput), fc.Args["sort"].(*model.SortInput), fc.Args["limit"].(*int32), fc.Args["cursor"].(*string))
+			return obj.Work, nil
 		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
 
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
+func (ec *executionContext) fieldContext_ExtraMetadata_work(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ExtraMetadata",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
 
-			next = directive1
-			return next
+func (ec *executionContext) _ExtraMetadata_looking_for(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ExtraMetadata_looking_for,
+		func(ctx context.Context) (any, error) {
+			return obj.LookingFor, nil
 		},
-		ec.marshalNCommentsConnection2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐCommentsConnection,
+		nil,
+		ec.marshalNString2ᚕstring,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_get_comments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_looking_for(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "comments":
-				return ec.fieldContext_CommentsConnection_comments(ctx, field)
-			case "page_info":
-				return ec.fieldContext_CommentsConnection_page_info(ctx, field)
-			case "total_count":
-				return ec.fieldContext_CommentsConnection_total_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CommentsConnection", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_get_comments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_get_comment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_zodiac(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_get_comment,
+		ec.fieldContext_ExtraMetadata_zodiac,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetComment(ctx, fc.Args["comment_id"].(string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Zodiac, nil
 		},
-		ec.marshalOComment2ᚖblindlyᚋinternalᚋmodelsᚐComment,
+		nil,
+		ec.marshalOString2string,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_get_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_zodiac(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Comment_id(ctx, field)
-			case "post_id":
-				return ec.fieldContext_Comment_post_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Comment_user_id(ctx, field)
-			case "reply_to_id":
-				return ec.fieldContext_Comment_reply_to_id(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Comment_created_at(ctx, field)
-			case "content":
-				return ec.fieldContext_Comment_content(ctx, field)
-			case "likes":
-				return ec.fieldContext_Comment_likes(ctx, field)
-			case "user":
-				return ec.fieldContext_Comment_user(ctx, field)
-			case "is_liked":
-				return ec.fieldContext_Comment_is_liked(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_get_comment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_get_trending_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_languages(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_get_trending_posts,
+		ec.fieldContext_ExtraMetadata_languages,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().GetTrendingPosts(ctx, fc.Args["time_window"].(*int32), fc.Args["limit"].(*int32), fc.Args["cursor"].(*string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Languages, nil
 		},
-		ec.marshalNPostsConnection2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPostsConnection,
+		nil,
+		ec.marshalNString2ᚕstring,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_get_trending_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_languages(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "posts":
-				return ec.fieldContext_PostsConnection_posts(ctx, field)
-			case "page_info":
-				return ec.fieldContext_PostsConnection_page_info(ctx, field)
-			case "total_count":
-				return ec.fieldContext_PostsConnection_total_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PostsConnection", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_get_trending_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_profileActivities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_excercise(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_profileActivities,
+		ec.fieldContext_ExtraMetadata_excercise,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().ProfileActivities(ctx, fc.Args["class"].(*model.ActivityClass))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Excercise, nil
 		},
-		ec.marshalNUserProfileActivity2ᚕᚖblindlyᚋinternalᚋmodelsᚐUserProfileActivity,
-		true,
+		nil,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_profileActivities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_excercise(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserProfileActivity_id(ctx, field)
-			case "type":
-				return ec.fieldContext_UserProfileActivity_type(ctx, field)
-			case "target_user":
-				return ec.fieldContext_UserProfileActivity_target_user(ctx, field)
-			case "class":
-				return ec.fieldContext_UserProfileActivity_class(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserProfileActivity", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_profileActivities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_recommendations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_drinking(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_recommendations,
+		ec.fieldContext_ExtraMetadata_drinking,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Recommendations(ctx, fc.Args["cursor"].(*string), fc.Args["limit"].(*int32))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Drinking, nil
 		},
-		ec.marshalNRecommendationsResult2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐRecommendationsResult,
-		true,
+		nil,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_recommendations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_drinking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "items":
-				return ec.fieldContext_RecommendationsResult_items(ctx, field)
-			case "next_cursor":
-				return ec.fieldContext_RecommendationsResult_next_cursor(ctx, field)
-			case "has_more":
-				return ec.fieldContext_RecommendationsResult_has_more(ctx, field)
-			case "fetched_at":
-				return ec.fieldContext_RecommendationsResult_fetched_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RecommendationsResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_recommendations_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_mySwipes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_smoking(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_mySwipes,
+		ec.fieldContext_ExtraMetadata_smoking,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().MySwipes(ctx)
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Smoking, nil
 		},
-		ec.marshalNSwipedProfile2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipedProfileᚄ,
-		true,
+		nil,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_mySwipes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_smoking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "profile":
-				return ec.fieldContext_SwipedProfile_profile(ctx, field)
-			case "swipe":
-				return ec.fieldContext_SwipedProfile_swipe(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SwipedProfile", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_kids(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_me,
+		ec.fieldContext_ExtraMetadata_kids,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Me(ctx)
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Kids, nil
 		},
-		ec.marshalOUser2ᚖblindlyᚋinternalᚋmodelsᚐUser,
+		nil,
+		ec.marshalOString2string,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_me(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_kids(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_User_id(ctx, field)
-			case "first_name":
-				return ec.fieldContext_User_first_name(ctx, field)
-			case "last_name":
-				return ec.fieldContext_User_last_name(ctx, field)
-			case "email":
-				return ec.fieldContext_User_email(ctx, field)
-			case "dob":
-				return ec.fieldContext_User_dob(ctx, field)
-			case "pfp":
-				return ec.fieldContext_User_pfp(ctx, field)
-			case "gender":
-				return ec.fieldContext_User_gender(ctx, field)
-			case "bio":
-				return ec.fieldContext_User_bio(ctx, field)
-			case "hobbies":
-				return ec.fieldContext_User_hobbies(ctx, field)
-			case "interests":
-				return ec.fieldContext_User_interests(ctx, field)
-			case "user_prompts":
-				return ec.fieldContext_User_user_prompts(ctx, field)
-			case "personality_traits":
-				return ec.fieldContext_User_personality_traits(ctx, field)
-			case "photos":
-				return ec.fieldContext_User_photos(ctx, field)
-			case "is_verified":
-				return ec.fieldContext_User_is_verified(ctx, field)
-			case "address":
-				return ec.fieldContext_User_address(ctx, field)
-			case "extra":
-				return ec.fieldContext_User_extra(ctx, field)
-			case "created_at":
-				return ec.fieldContext_User_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_User_updated_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_religion(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_user,
+		ec.fieldContext_ExtraMetadata_religion,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().User(ctx, fc.Args["id"].(string))
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Religion, nil
 		},
-		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
-		true,
+		nil,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_religion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserPublic_id(ctx, field)
-			case "name":
-				return ec.fieldContext_UserPublic_name(ctx, field)
-			case "pfp":
-				return ec.fieldContext_UserPublic_pfp(ctx, field)
-			case "bio":
-				return ec.fieldContext_UserPublic_bio(ctx, field)
-			case "dob":
-				return ec.fieldContext_UserPublic_dob(ctx, field)
-			case "gender":
-				return ec.fieldContext_UserPublic_gender(ctx, field)
-			case "hobbies":
-				return ec.fieldContext_UserPublic_hobbies(ctx, field)
-			case "interests":
-				return ec.fieldContext_UserPublic_interests(ctx, field)
-			case "user_prompts":
-				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
-			case "personality_traits":
-				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
-			case "photos":
-				return ec.fieldContext_UserPublic_photos(ctx, field)
-			case "is_verified":
-				return ec.fieldContext_UserPublic_is_verified(ctx, field)
-			case "extra":
-				return ec.fieldContext_UserPublic_extra(ctx, field)
-			case "created_at":
-				return ec.fieldContext_UserPublic_created_at(ctx, field)
-			case "is_online":
-				return ec.fieldContext_UserPublic_is_online(ctx, field)
-			case "is_locked":
-				return ec.fieldContext_UserPublic_is_locked(ctx, field)
-			case "is_poked":
-				return ec.fieldContext_UserPublic_is_poked(ctx, field)
-			case "chat_id":
-				return ec.fieldContext_UserPublic_chat_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_user_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getUserVerificationStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_ethnicity(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_getUserVerificationStatus,
+		ec.fieldContext_ExtraMetadata_ethnicity,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().GetUserVerificationStatus(ctx)
-		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
-
-			directive1 := func(ctx context.Context) (any, error) {
-				return builtInDirectiveAuth(ctx, nil, directive0)
-			}
-
-			next = directive1
-			return next
+			return obj.Ethnicity, nil
 		},
-		ec.marshalNUserVerification2ᚖblindlyᚋinternalᚋmodelsᚐUserVerification,
-		true,
+		nil,
+		ec.marshalOString2string,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_getUserVerificationStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_ethnicity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserVerification_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_UserVerification_user_id(ctx, field)
-			case "media":
-				return ec.fieldContext_UserVerification_media(ctx, field)
-			case "status":
-				return ec.fieldContext_UserVerification_status(ctx, field)
-			case "created_at":
-				return ec.fieldContext_UserVerification_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_UserVerification_updated_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserVerification", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _ExtraMetadata_sexuality(ctx context.Context, field graphql.CollectedField, obj *models.ExtraMetadata) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___type,
+		ec.fieldContext_ExtraMetadata_sexuality,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
+			return obj.Sexuality, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalOString2string,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ExtraMetadata_sexuality(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ExtraMetadata",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Match_id(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query___schema,
+		ec.fieldContext_Match_id,
 		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
+			return obj.Id, nil
 		},
 		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Match_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Match",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendationsResult_items(ctx context.Context, field graphql.CollectedField, obj *model.RecommendationsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _Match_she_id(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendationsResult_items,
+		ec.fieldContext_Match_she_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Items, nil
+			return obj.SheId, nil
 		},
 		nil,
-		ec.marshalNRecommendedProfile2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐRecommendedProfileᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendationsResult_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Match_she_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendationsResult",
+		Object:     "Match",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "profile":
-				return ec.fieldContext_RecommendedProfile_profile(ctx, field)
-			case "match_score":
-				return ec.fieldContext_RecommendedProfile_match_score(ctx, field)
-			case "compatibility_score":
-				return ec.fieldContext_RecommendedProfile_compatibility_score(ctx, field)
-			case "common_interests":
-				return ec.fieldContext_RecommendedProfile_common_interests(ctx, field)
-			case "distance_km":
-				return ec.fieldContext_RecommendedProfile_distance_km(ctx, field)
-			case "reason":
-				return ec.fieldContext_RecommendedProfile_reason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RecommendedProfile", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendationsResult_next_cursor(ctx context.Context, field graphql.CollectedField, obj *model.RecommendationsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _Match_he_id(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendationsResult_next_cursor,
+		ec.fieldContext_Match_he_id,
 		func(ctx context.Context) (any, error) {
-			return obj.NextCursor, nil
+			return obj.HeId, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendationsResult_next_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Match_he_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendationsResult",
+		Object:     "Match",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6659,208 +7328,176 @@ func (ec *executionContext) fieldContext_RecommendationsResult_next_cursor(_ con
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendationsResult_has_more(ctx context.Context, field graphql.CollectedField, obj *model.RecommendationsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _Match_score(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendationsResult_has_more,
+		ec.fieldContext_Match_score,
 		func(ctx context.Context) (any, error) {
-			return obj.HasMore, nil
+			return ec.resolvers.Match().Score(ctx, obj)
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendationsResult_has_more(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Match_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendationsResult",
+		Object:     "Match",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendationsResult_fetched_at(ctx context.Context, field graphql.CollectedField, obj *model.RecommendationsResult) (ret graphql.Marshaler) {
+func (ec *executionContext) _Match_post_unlock_rating(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendationsResult_fetched_at,
+		ec.fieldContext_Match_post_unlock_rating,
 		func(ctx context.Context) (any, error) {
-			return obj.FetchedAt, nil
+			return obj.PostUnlockRating, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNPostUnlockRating2blindlyᚋinternalᚋmodelsᚐPostUnlockRating,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendationsResult_fetched_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Match_post_unlock_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendationsResult",
+		Object:     "Match",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "she_rating":
+				return ec.fieldContext_PostUnlockRating_she_rating(ctx, field)
+			case "he_rating":
+				return ec.fieldContext_PostUnlockRating_he_rating(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PostUnlockRating", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendedProfile_profile(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Match_is_unlocked(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendedProfile_profile,
+		ec.fieldContext_Match_is_unlocked,
 		func(ctx context.Context) (any, error) {
-			return obj.Profile, nil
+			return obj.IsUnlocked, nil
 		},
 		nil,
-		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendedProfile_profile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Match_is_unlocked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendedProfile",
+		Object:     "Match",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserPublic_id(ctx, field)
-			case "name":
-				return ec.fieldContext_UserPublic_name(ctx, field)
-			case "pfp":
-				return ec.fieldContext_UserPublic_pfp(ctx, field)
-			case "bio":
-				return ec.fieldContext_UserPublic_bio(ctx, field)
-			case "dob":
-				return ec.fieldContext_UserPublic_dob(ctx, field)
-			case "gender":
-				return ec.fieldContext_UserPublic_gender(ctx, field)
-			case "hobbies":
-				return ec.fieldContext_UserPublic_hobbies(ctx, field)
-			case "interests":
-				return ec.fieldContext_UserPublic_interests(ctx, field)
-			case "user_prompts":
-				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
-			case "personality_traits":
-				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
-			case "photos":
-				return ec.fieldContext_UserPublic_photos(ctx, field)
-			case "is_verified":
-				return ec.fieldContext_UserPublic_is_verified(ctx, field)
-			case "extra":
-				return ec.fieldContext_UserPublic_extra(ctx, field)
-			case "created_at":
-				return ec.fieldContext_UserPublic_created_at(ctx, field)
-			case "is_online":
-				return ec.fieldContext_UserPublic_is_online(ctx, field)
-			case "is_locked":
-				return ec.fieldContext_UserPublic_is_locked(ctx, field)
-			case "is_poked":
-				return ec.fieldContext_UserPublic_is_poked(ctx, field)
-			case "chat_id":
-				return ec.fieldContext_UserPublic_chat_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendedProfile_match_score(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Match_matched_at(ctx context.Context, field graphql.CollectedField, obj *models.Match) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendedProfile_match_score,
+		ec.fieldContext_Match_matched_at,
 		func(ctx context.Context) (any, error) {
-			return obj.MatchScore, nil
+			return obj.MatchedAt, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendedProfile_match_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Match_matched_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendedProfile",
+		Object:     "Match",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendedProfile_compatibility_score(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_id(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendedProfile_compatibility_score,
+		ec.fieldContext_Media_id,
 		func(ctx context.Context) (any, error) {
-			return obj.CompatibilityScore, nil
+			return obj.Id, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendedProfile_compatibility_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Media_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendedProfile",
+		Object:     "Media",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendedProfile_common_interests(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_url(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendedProfile_common_interests,
+		ec.fieldContext_Media_url,
 		func(ctx context.Context) (any, error) {
-			return obj.CommonInterests, nil
+			return obj.Url, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendedProfile_common_interests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Media_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendedProfile",
+		Object:     "Media",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6871,130 +7508,130 @@ func (ec *executionContext) fieldContext_RecommendedProfile_common_interests(_ c
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendedProfile_distance_km(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_type(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendedProfile_distance_km,
+		ec.fieldContext_Media_type,
 		func(ctx context.Context) (any, error) {
-			return obj.DistanceKm, nil
+			return ec.resolvers.Media().Type(ctx, obj)
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNMediaType2blindlyᚋinternalᚋgraphᚋmodelᚐMediaType,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendedProfile_distance_km(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Media_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendedProfile",
+		Object:     "Media",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type MediaType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RecommendedProfile_reason(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_view_once(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_RecommendedProfile_reason,
+		ec.fieldContext_Media_view_once,
 		func(ctx context.Context) (any, error) {
-			return obj.Reason, nil
+			return obj.ViewOnce, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_RecommendedProfile_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Media_view_once(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RecommendedProfile",
+		Object:     "Media",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_id(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_opened_at(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Report_id,
+		ec.fieldContext_Media_opened_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			return obj.OpenedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Report_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Media_opened_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "Media",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_user_id(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+func (ec *executionContext) _Media_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Media) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Report_user_id,
+		ec.fieldContext_Media_created_at,
 		func(ctx context.Context) (any, error) {
-			return obj.UserId, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Report_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Media_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "Media",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_target_id(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+func (ec *executionContext) _MediaGrant_message_id(ctx context.Context, field graphql.CollectedField, obj *chatservice.MediaGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Report_target_id,
+		ec.fieldContext_MediaGrant_message_id,
 		func(ctx context.Context) (any, error) {
-			return obj.TargetId, nil
+			return obj.MessageId, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -7003,9 +7640,9 @@ func (ec *executionContext) _Report_target_id(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext_Report_target_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MediaGrant_message_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "MediaGrant",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7016,14 +7653,14 @@ func (ec *executionContext) fieldContext_Report_target_id(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_reason(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+func (ec *executionContext) _MediaGrant_media_id(ctx context.Context, field graphql.CollectedField, obj *chatservice.MediaGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Report_reason,
+		ec.fieldContext_MediaGrant_media_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Reason, nil
+			return obj.MediaId, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -7032,9 +7669,9 @@ func (ec *executionContext) _Report_reason(ctx context.Context, field graphql.Co
 	)
 }
 
-func (ec *executionContext) fieldContext_Report_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MediaGrant_media_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "MediaGrant",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7045,14 +7682,14 @@ func (ec *executionContext) fieldContext_Report_reason(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_additional_info(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+func (ec *executionContext) _MediaGrant_url(ctx context.Context, field graphql.CollectedField, obj *chatservice.MediaGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Report_additional_info,
+		ec.fieldContext_MediaGrant_url,
 		func(ctx context.Context) (any, error) {
-			return obj.AdditionalInfo, nil
+			return obj.Url, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -7061,9 +7698,9 @@ func (ec *executionContext) _Report_additional_info(ctx context.Context, field g
 	)
 }
 
-func (ec *executionContext) fieldContext_Report_additional_info(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MediaGrant_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "MediaGrant",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7074,53 +7711,43 @@ func (ec *executionContext) fieldContext_Report_additional_info(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_media(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+func (ec *executionContext) _MediaGrant_expires_at(ctx context.Context, field graphql.CollectedField, obj *chatservice.MediaGrant) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Report_media,
+		ec.fieldContext_MediaGrant_expires_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Media, nil
+			return obj.ExpiresAt, nil
 		},
 		nil,
-		ec.marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Report_media(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MediaGrant_expires_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "MediaGrant",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Media_id(ctx, field)
-			case "url":
-				return ec.fieldContext_Media_url(ctx, field)
-			case "type":
-				return ec.fieldContext_Media_type(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Media_created_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Media", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_status(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessagePreview_id(ctx context.Context, field graphql.CollectedField, obj *models.MessagePreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Report_status,
+		ec.fieldContext_MessagePreview_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.Id, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -7129,9 +7756,9 @@ func (ec *executionContext) _Report_status(ctx context.Context, field graphql.Co
 	)
 }
 
-func (ec *executionContext) fieldContext_Report_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessagePreview_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "MessagePreview",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7142,101 +7769,101 @@ func (ec *executionContext) fieldContext_Report_status(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessagePreview_sender_id(ctx context.Context, field graphql.CollectedField, obj *models.MessagePreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Report_created_at,
+		ec.fieldContext_MessagePreview_sender_id,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.SenderId, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Report_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessagePreview_sender_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "MessagePreview",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_updated_at(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessagePreview_content(ctx context.Context, field graphql.CollectedField, obj *models.MessagePreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Report_updated_at,
+		ec.fieldContext_MessagePreview_content,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.Content, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Report_updated_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessagePreview_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "MessagePreview",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Swipe_id(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessagePreview_type(ctx context.Context, field graphql.CollectedField, obj *models.MessagePreview) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Swipe_id,
+		ec.fieldContext_MessagePreview_type,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			return obj.Type, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNMessageType2blindlyᚋinternalᚋmodelsᚐMessageType,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Swipe_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessagePreview_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Swipe",
+		Object:     "MessagePreview",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type MessageType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Swipe_user_id(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessageRevision_id(ctx context.Context, field graphql.CollectedField, obj *models.MessageRevision) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Swipe_user_id,
+		ec.fieldContext_MessageRevision_id,
 		func(ctx context.Context) (any, error) {
-			return obj.UserId, nil
+			return obj.Id, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -7245,9 +7872,9 @@ func (ec *executionContext) _Swipe_user_id(ctx context.Context, field graphql.Co
 	)
 }
 
-func (ec *executionContext) fieldContext_Swipe_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageRevision_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Swipe",
+		Object:     "MessageRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7258,14 +7885,14 @@ func (ec *executionContext) fieldContext_Swipe_user_id(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Swipe_target_id(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessageRevision_message_id(ctx context.Context, field graphql.CollectedField, obj *models.MessageRevision) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Swipe_target_id,
+		ec.fieldContext_MessageRevision_message_id,
 		func(ctx context.Context) (any, error) {
-			return obj.TargetId, nil
+			return obj.MessageId, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -7274,9 +7901,9 @@ func (ec *executionContext) _Swipe_target_id(ctx context.Context, field graphql.
 	)
 }
 
-func (ec *executionContext) fieldContext_Swipe_target_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageRevision_message_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Swipe",
+		Object:     "MessageRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7287,1451 +7914,2347 @@ func (ec *executionContext) fieldContext_Swipe_target_id(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Swipe_action_type(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessageRevision_sender_id(ctx context.Context, field graphql.CollectedField, obj *models.MessageRevision) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Swipe_action_type,
+		ec.fieldContext_MessageRevision_sender_id,
 		func(ctx context.Context) (any, error) {
-			return obj.ActionType, nil
+			return obj.SenderId, nil
 		},
 		nil,
-		ec.marshalNSwipeType2blindlyᚋinternalᚋmodelsᚐSwipeType,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Swipe_action_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageRevision_sender_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Swipe",
+		Object:     "MessageRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type SwipeType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Swipe_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessageRevision_content(ctx context.Context, field graphql.CollectedField, obj *models.MessageRevision) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Swipe_created_at,
+		ec.fieldContext_MessageRevision_content,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Content, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Swipe_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageRevision_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Swipe",
+		Object:     "MessageRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SwipeResponse_swipe(ctx context.Context, field graphql.CollectedField, obj *model.SwipeResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessageRevision_media(ctx context.Context, field graphql.CollectedField, obj *models.MessageRevision) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SwipeResponse_swipe,
+		ec.fieldContext_MessageRevision_media,
 		func(ctx context.Context) (any, error) {
-			return obj.Swipe, nil
+			return obj.Media, nil
 		},
 		nil,
-		ec.marshalNSwipe2ᚖblindlyᚋinternalᚋmodelsᚐSwipe,
-		true,
+		ec.marshalOMedia2ᚕblindlyᚋinternalᚋmodelsᚐMediaᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_SwipeResponse_swipe(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageRevision_media(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SwipeResponse",
+		Object:     "MessageRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Swipe_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Swipe_user_id(ctx, field)
-			case "target_id":
-				return ec.fieldContext_Swipe_target_id(ctx, field)
-			case "action_type":
-				return ec.fieldContext_Swipe_action_type(ctx, field)
+				return ec.fieldContext_Media_id(ctx, field)
+			case "url":
+				return ec.fieldContext_Media_url(ctx, field)
+			case "type":
+				return ec.fieldContext_Media_type(ctx, field)
+			case "view_once":
+				return ec.fieldContext_Media_view_once(ctx, field)
+			case "opened_at":
+				return ec.fieldContext_Media_opened_at(ctx, field)
 			case "created_at":
-				return ec.fieldContext_Swipe_created_at(ctx, field)
+				return ec.fieldContext_Media_created_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Swipe", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Media", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SwipeResponse_match(ctx context.Context, field graphql.CollectedField, obj *model.SwipeResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessageRevision_created_at(ctx context.Context, field graphql.CollectedField, obj *models.MessageRevision) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SwipeResponse_match,
+		ec.fieldContext_MessageRevision_created_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Match, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalOMatch2ᚖblindlyᚋinternalᚋmodelsᚐMatch,
+		ec.marshalNTime2timeᚐTime,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_SwipeResponse_match(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageRevision_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SwipeResponse",
+		Object:     "MessageRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Match_id(ctx, field)
-			case "she_id":
-				return ec.fieldContext_Match_she_id(ctx, field)
-			case "he_id":
-				return ec.fieldContext_Match_he_id(ctx, field)
-			case "score":
-				return ec.fieldContext_Match_score(ctx, field)
-			case "post_unlock_rating":
-				return ec.fieldContext_Match_post_unlock_rating(ctx, field)
-			case "is_unlocked":
-				return ec.fieldContext_Match_is_unlocked(ctx, field)
-			case "matched_at":
-				return ec.fieldContext_Match_matched_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Match", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SwipedProfile_profile(ctx context.Context, field graphql.CollectedField, obj *model.SwipedProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _MessageRevision_replaced_at(ctx context.Context, field graphql.CollectedField, obj *models.MessageRevision) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SwipedProfile_profile,
+		ec.fieldContext_MessageRevision_replaced_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Profile, nil
+			return obj.ReplacedAt, nil
 		},
 		nil,
-		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SwipedProfile_profile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MessageRevision_replaced_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SwipedProfile",
+		Object:     "MessageRevision",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserPublic_id(ctx, field)
-			case "name":
-				return ec.fieldContext_UserPublic_name(ctx, field)
-			case "pfp":
-				return ec.fieldContext_UserPublic_pfp(ctx, field)
-			case "bio":
-				return ec.fieldContext_UserPublic_bio(ctx, field)
-			case "dob":
-				return ec.fieldContext_UserPublic_dob(ctx, field)
-			case "gender":
-				return ec.fieldContext_UserPublic_gender(ctx, field)
-			case "hobbies":
-				return ec.fieldContext_UserPublic_hobbies(ctx, field)
-			case "interests":
-				return ec.fieldContext_UserPublic_interests(ctx, field)
-			case "user_prompts":
-				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
-			case "personality_traits":
-				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
-			case "photos":
-				return ec.fieldContext_UserPublic_photos(ctx, field)
-			case "is_verified":
-				return ec.fieldContext_UserPublic_is_verified(ctx, field)
-			case "extra":
-				return ec.fieldContext_UserPublic_extra(ctx, field)
-			case "created_at":
-				return ec.fieldContext_UserPublic_created_at(ctx, field)
-			case "is_online":
-				return ec.fieldContext_UserPublic_is_online(ctx, field)
-			case "is_locked":
-				return ec.fieldContext_UserPublic_is_locked(ctx, field)
-			case "is_poked":
-				return ec.fieldContext_UserPublic_is_poked(ctx, field)
-			case "chat_id":
-				return ec.fieldContext_UserPublic_chat_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SwipedProfile_swipe(ctx context.Context, field graphql.CollectedField, obj *model.SwipedProfile) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_sendMessage(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SwipedProfile_swipe,
+		ec.fieldContext_Mutation_sendMessage,
 		func(ctx context.Context) (any, error) {
-			return obj.Swipe, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SendMessage(ctx, fc.Args["input"].(model.SendMessageInput))
 		},
-		nil,
-		ec.marshalNSwipe2ᚖblindlyᚋinternalᚋmodelsᚐSwipe,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNChatMessage2ᚖblindlyᚋinternalᚋmodelsᚐMessage,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_SwipedProfile_swipe(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_sendMessage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SwipedProfile",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_Swipe_id(ctx, field)
-			case "user_id":
-				return ec.fieldContext_Swipe_user_id(ctx, field)
-			case "target_id":
-				return ec.fieldContext_Swipe_target_id(ctx, field)
-			case "action_type":
-				return ec.fieldContext_Swipe_action_type(ctx, field)
+				return ec.fieldContext_ChatMessage_id(ctx, field)
+			case "type":
+				return ec.fieldContext_ChatMessage_type(ctx, field)
+			case "content":
+				return ec.fieldContext_ChatMessage_content(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_ChatMessage_sender_id(ctx, field)
+			case "received":
+				return ec.fieldContext_ChatMessage_received(ctx, field)
+			case "seen":
+				return ec.fieldContext_ChatMessage_seen(ctx, field)
+			case "media":
+				return ec.fieldContext_ChatMessage_media(ctx, field)
+			case "reactions":
+				return ec.fieldContext_ChatMessage_reactions(ctx, field)
+			case "reaction_counts":
+				return ec.fieldContext_ChatMessage_reaction_counts(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_ChatMessage_reply_to_id(ctx, field)
+			case "reply_to":
+				return ec.fieldContext_ChatMessage_reply_to(ctx, field)
+			case "date_proposal":
+				return ec.fieldContext_ChatMessage_date_proposal(ctx, field)
+			case "call":
+				return ec.fieldContext_ChatMessage_call(ctx, field)
+			case "edited_at":
+				return ec.fieldContext_ChatMessage_edited_at(ctx, field)
 			case "created_at":
-				return ec.fieldContext_Swipe_created_at(ctx, field)
+				return ec.fieldContext_ChatMessage_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_ChatMessage_updated_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Swipe", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ChatMessage", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_sendMessage_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_markSeen(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_id,
+		ec.fieldContext_Mutation_markSeen,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().MarkSeen(ctx, fc.Args["chat_id"].(string), fc.Args["message_ids"].([]string))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_markSeen(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_markSeen_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_first_name(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_react(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_first_name,
+		ec.fieldContext_Mutation_react,
 		func(ctx context.Context) (any, error) {
-			return obj.FirstName, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().React(ctx, fc.Args["chat_id"].(string), fc.Args["message_id"].(string), fc.Args["reaction"].(string))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNChatMessage2ᚖblindlyᚋinternalᚋmodelsᚐMessage,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_first_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_react(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ChatMessage_id(ctx, field)
+			case "type":
+				return ec.fieldContext_ChatMessage_type(ctx, field)
+			case "content":
+				return ec.fieldContext_ChatMessage_content(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_ChatMessage_sender_id(ctx, field)
+			case "received":
+				return ec.fieldContext_ChatMessage_received(ctx, field)
+			case "seen":
+				return ec.fieldContext_ChatMessage_seen(ctx, field)
+			case "media":
+				return ec.fieldContext_ChatMessage_media(ctx, field)
+			case "reactions":
+				return ec.fieldContext_ChatMessage_reactions(ctx, field)
+			case "reaction_counts":
+				return ec.fieldContext_ChatMessage_reaction_counts(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_ChatMessage_reply_to_id(ctx, field)
+			case "reply_to":
+				return ec.fieldContext_ChatMessage_reply_to(ctx, field)
+			case "date_proposal":
+				return ec.fieldContext_ChatMessage_date_proposal(ctx, field)
+			case "call":
+				return ec.fieldContext_ChatMessage_call(ctx, field)
+			case "edited_at":
+				return ec.fieldContext_ChatMessage_edited_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ChatMessage_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_ChatMessage_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChatMessage", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_react_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_last_name(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unreact(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_last_name,
+		ec.fieldContext_Mutation_unreact,
 		func(ctx context.Context) (any, error) {
-			return obj.LastName, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().Unreact(ctx, fc.Args["chat_id"].(string), fc.Args["message_id"].(string))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNChatMessage2ᚖblindlyᚋinternalᚋmodelsᚐMessage,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_last_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_unreact(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ChatMessage_id(ctx, field)
+			case "type":
+				return ec.fieldContext_ChatMessage_type(ctx, field)
+			case "content":
+				return ec.fieldContext_ChatMessage_content(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_ChatMessage_sender_id(ctx, field)
+			case "received":
+				return ec.fieldContext_ChatMessage_received(ctx, field)
+			case "seen":
+				return ec.fieldContext_ChatMessage_seen(ctx, field)
+			case "media":
+				return ec.fieldContext_ChatMessage_media(ctx, field)
+			case "reactions":
+				return ec.fieldContext_ChatMessage_reactions(ctx, field)
+			case "reaction_counts":
+				return ec.fieldContext_ChatMessage_reaction_counts(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_ChatMessage_reply_to_id(ctx, field)
+			case "reply_to":
+				return ec.fieldContext_ChatMessage_reply_to(ctx, field)
+			case "date_proposal":
+				return ec.fieldContext_ChatMessage_date_proposal(ctx, field)
+			case "call":
+				return ec.fieldContext_ChatMessage_call(ctx, field)
+			case "edited_at":
+				return ec.fieldContext_ChatMessage_edited_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ChatMessage_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_ChatMessage_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChatMessage", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_unreact_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_openViewOnce(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_email,
+		ec.fieldContext_Mutation_openViewOnce,
 		func(ctx context.Context) (any, error) {
-			return obj.Email, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().OpenViewOnce(ctx, fc.Args["chat_id"].(string), fc.Args["message_id"].(string), fc.Args["media_id"].(string))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNMediaGrant2ᚖblindlyᚋinternalᚋchat_serviceᚐMediaGrant,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_openViewOnce(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "message_id":
+				return ec.fieldContext_MediaGrant_message_id(ctx, field)
+			case "media_id":
+				return ec.fieldContext_MediaGrant_media_id(ctx, field)
+			case "url":
+				return ec.fieldContext_MediaGrant_url(ctx, field)
+			case "expires_at":
+				return ec.fieldContext_MediaGrant_expires_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MediaGrant", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_openViewOnce_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_dob(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_scheduleMessage(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_dob,
+		ec.fieldContext_Mutation_scheduleMessage,
 		func(ctx context.Context) (any, error) {
-			return obj.Dob, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ScheduleMessage(ctx, fc.Args["input"].(model.SendMessageInput), fc.Args["deliver_at"].(time.Time))
 		},
-		nil,
-		ec.marshalOTime2timeᚐTime,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNScheduledMessage2ᚖblindlyᚋinternalᚋmodelsᚐScheduledMessage,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_dob(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_scheduleMessage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ScheduledMessage_id(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_ScheduledMessage_chat_id(ctx, field)
+			case "message":
+				return ec.fieldContext_ScheduledMessage_message(ctx, field)
+			case "deliver_at":
+				return ec.fieldContext_ScheduledMessage_deliver_at(ctx, field)
+			case "status":
+				return ec.fieldContext_ScheduledMessage_status(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ScheduledMessage_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ScheduledMessage", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_scheduleMessage_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_pfp(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateConnectionSettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_pfp,
+		ec.fieldContext_Mutation_updateConnectionSettings,
 		func(ctx context.Context) (any, error) {
-			return obj.Pfp, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateConnectionSettings(ctx, fc.Args["match_id"].(string), fc.Args["input"].(model.ConnectionSettingsInput))
 		},
-		nil,
-		ec.marshalOString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNConnectionSettings2ᚖblindlyᚋinternalᚋmodelsᚐConnectionSettings,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_pfp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateConnectionSettings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "match_id":
+				return ec.fieldContext_ConnectionSettings_match_id(ctx, field)
+			case "pinned":
+				return ec.fieldContext_ConnectionSettings_pinned(ctx, field)
+			case "archived":
+				return ec.fieldContext_ConnectionSettings_archived(ctx, field)
+			case "muted_until":
+				return ec.fieldContext_ConnectionSettings_muted_until(ctx, field)
+			case "is_muted":
+				return ec.fieldContext_ConnectionSettings_is_muted(ctx, field)
+			case "nickname":
+				return ec.fieldContext_ConnectionSettings_nickname(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ConnectionSettings", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateConnectionSettings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_gender(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_cancelScheduledMessage(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_gender,
+		ec.fieldContext_Mutation_cancelScheduledMessage,
 		func(ctx context.Context) (any, error) {
-			return obj.Gender, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CancelScheduledMessage(ctx, fc.Args["chat_id"].(string), fc.Args["id"].(string))
 		},
-		nil,
-		ec.marshalOString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_gender(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_cancelScheduledMessage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelScheduledMessage_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_bio(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_respondToDateProposal(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_bio,
+		ec.fieldContext_Mutation_respondToDateProposal,
 		func(ctx context.Context) (any, error) {
-			return obj.Bio, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RespondToDateProposal(ctx, fc.Args["chat_id"].(string), fc.Args["message_id"].(string), fc.Args["action"].(chatservice.DateAction), fc.Args["counter"].(*model.DateProposalInput))
 		},
-		nil,
-		ec.marshalOString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDateProposalResponse2ᚖblindlyᚋinternalᚋchat_serviceᚐDateResponse,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_bio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_respondToDateProposal(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "proposal":
+				return ec.fieldContext_DateProposalResponse_proposal(ctx, field)
+			case "counter":
+				return ec.fieldContext_DateProposalResponse_counter(ctx, field)
+			case "date":
+				return ec.fieldContext_DateProposalResponse_date(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DateProposalResponse", field.Name)
 		},
 	}
-	return fc, nil
-}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_respondToDateProposal_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
 
-func (ec *executionContext) _User_hobbies(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_addTrustedContact(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_hobbies,
+		ec.fieldContext_Mutation_addTrustedContact,
 		func(ctx context.Context) (any, error) {
-			return obj.Hobbies, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddTrustedContact(ctx, fc.Args["name"].(string), fc.Args["email"].(string))
 		},
-		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNTrustedContact2ᚖblindlyᚋinternalᚋmodelsᚐTrustedContact,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_hobbies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_addTrustedContact(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TrustedContact_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TrustedContact_name(ctx, field)
+			case "email":
+				return ec.fieldContext_TrustedContact_email(ctx, field)
+			case "created_at":
+				return ec.fieldContext_TrustedContact_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TrustedContact", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addTrustedContact_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_interests(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_removeTrustedContact(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_interests,
+		ec.fieldContext_Mutation_removeTrustedContact,
 		func(ctx context.Context) (any, error) {
-			return obj.Interests, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RemoveTrustedContact(ctx, fc.Args["id"].(string))
 		},
-		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_interests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_removeTrustedContact(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removeTrustedContact_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_user_prompts(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_shareDate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_user_prompts,
+		ec.fieldContext_Mutation_shareDate,
 		func(ctx context.Context) (any, error) {
-			return obj.UserPrompts, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ShareDate(ctx, fc.Args["date_id"].(string))
 		},
-		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_user_prompts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_shareDate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_shareDate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_personality_traits(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_scheduleCheckIn(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_personality_traits,
+		ec.fieldContext_Mutation_scheduleCheckIn,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.User().PersonalityTraits(ctx, obj)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ScheduleCheckIn(ctx, fc.Args["date_id"].(string), fc.Args["check_in_at"].(time.Time))
 		},
-		nil,
-		ec.marshalNPersonalityTrait2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDateCheckIn2ᚖblindlyᚋinternalᚋmodelsᚐDateCheckIn,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_personality_traits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_scheduleCheckIn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "key":
-				return ec.fieldContext_PersonalityTrait_key(ctx, field)
-			case "value":
-				return ec.fieldContext_PersonalityTrait_value(ctx, field)
+			case "id":
+				return ec.fieldContext_DateCheckIn_id(ctx, field)
+			case "date_id":
+				return ec.fieldContext_DateCheckIn_date_id(ctx, field)
+			case "check_in_at":
+				return ec.fieldContext_DateCheckIn_check_in_at(ctx, field)
+			case "status":
+				return ec.fieldContext_DateCheckIn_status(ctx, field)
+			case "checked_in_at":
+				return ec.fieldContext_DateCheckIn_checked_in_at(ctx, field)
+			case "alerted_at":
+				return ec.fieldContext_DateCheckIn_alerted_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_DateCheckIn_created_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PersonalityTrait", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DateCheckIn", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_scheduleCheckIn_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_photos(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_checkIn(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_photos,
+		ec.fieldContext_Mutation_checkIn,
 		func(ctx context.Context) (any, error) {
-			return obj.Photos, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CheckIn(ctx, fc.Args["id"].(string))
 		},
-		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDateCheckIn2ᚖblindlyᚋinternalᚋmodelsᚐDateCheckIn,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_photos(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_checkIn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_DateCheckIn_id(ctx, field)
+			case "date_id":
+				return ec.fieldContext_DateCheckIn_date_id(ctx, field)
+			case "check_in_at":
+				return ec.fieldContext_DateCheckIn_check_in_at(ctx, field)
+			case "status":
+				return ec.fieldContext_DateCheckIn_status(ctx, field)
+			case "checked_in_at":
+				return ec.fieldContext_DateCheckIn_checked_in_at(ctx, field)
+			case "alerted_at":
+				return ec.fieldContext_DateCheckIn_alerted_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_DateCheckIn_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DateCheckIn", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_checkIn_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_is_verified(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_cancelCheckIn(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_is_verified,
+		ec.fieldContext_Mutation_cancelCheckIn,
 		func(ctx context.Context) (any, error) {
-			return obj.IsVerified, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CancelCheckIn(ctx, fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
 		},
-		nil,
 		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_is_verified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_cancelCheckIn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelCheckIn_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_address(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_exportChat(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_address,
+		ec.fieldContext_Mutation_exportChat,
 		func(ctx context.Context) (any, error) {
-			return obj.Address, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ExportChat(ctx, fc.Args["chat_id"].(string), fc.Args["format"].(model.ChatExportFormat))
 		},
-		nil,
-		ec.marshalOAddress2blindlyᚋinternalᚋmodelsᚐAddress,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNChatExport2ᚖblindlyᚋinternalᚋmodelsᚐChatExport,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_exportChat(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "city":
-				return ec.fieldContext_Address_city(ctx, field)
-			case "state":
-				return ec.fieldContext_Address_state(ctx, field)
-			case "country":
-				return ec.fieldContext_Address_country(ctx, field)
-			case "coordinates":
-				return ec.fieldContext_Address_coordinates(ctx, field)
+			case "id":
+				return ec.fieldContext_ChatExport_id(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_ChatExport_chat_id(ctx, field)
+			case "format":
+				return ec.fieldContext_ChatExport_format(ctx, field)
+			case "status":
+				return ec.fieldContext_ChatExport_status(ctx, field)
+			case "error":
+				return ec.fieldContext_ChatExport_error(ctx, field)
+			case "download_url":
+				return ec.fieldContext_ChatExport_download_url(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ChatExport_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_ChatExport_updated_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ChatExport", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_exportChat_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_extra(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_create_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_extra,
+		ec.fieldContext_Mutation_create_post,
 		func(ctx context.Context) (any, error) {
-			return obj.Extra, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreatePost(ctx, fc.Args["input"].(model.CreatePostInput))
 		},
-		nil,
-		ec.marshalOExtraMetadata2blindlyᚋinternalᚋmodelsᚐExtraMetadata,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_extra(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_create_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "school":
-				return ec.fieldContext_ExtraMetadata_school(ctx, field)
-			case "work":
-				return ec.fieldContext_ExtraMetadata_work(ctx, field)
-			case "looking_for":
-				return ec.fieldContext_ExtraMetadata_looking_for(ctx, field)
-			case "zodiac":
-				return ec.fieldContext_ExtraMetadata_zodiac(ctx, field)
-			case "languages":
-				return ec.fieldContext_ExtraMetadata_languages(ctx, field)
-			case "excercise":
-				return ec.fieldContext_ExtraMetadata_excercise(ctx, field)
-			case "drinking":
-				return ec.fieldContext_ExtraMetadata_drinking(ctx, field)
-			case "smoking":
-				return ec.fieldContext_ExtraMetadata_smoking(ctx, field)
-			case "kids":
-				return ec.fieldContext_ExtraMetadata_kids(ctx, field)
-			case "religion":
-				return ec.fieldContext_ExtraMetadata_religion(ctx, field)
-			case "ethnicity":
-				return ec.fieldContext_ExtraMetadata_ethnicity(ctx, field)
-			case "sexuality":
-				return ec.fieldContext_ExtraMetadata_sexuality(ctx, field)
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Post_user_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Post_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "media":
+				return ec.fieldContext_Post_media(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "comments":
+				return ec.fieldContext_Post_comments(ctx, field)
+			case "views":
+				return ec.fieldContext_Post_views(ctx, field)
+			case "user":
+				return ec.fieldContext_Post_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Post_is_liked(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ExtraMetadata", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_create_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_created_at(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_update_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_created_at,
+		ec.fieldContext_Mutation_update_post,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdatePost(ctx, fc.Args["input"].(model.UpdatePostInput))
 		},
-		nil,
-		ec.marshalOTime2timeᚐTime,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_update_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Post_user_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Post_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "media":
+				return ec.fieldContext_Post_media(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "comments":
+				return ec.fieldContext_Post_comments(ctx, field)
+			case "views":
+				return ec.fieldContext_Post_views(ctx, field)
+			case "user":
+				return ec.fieldContext_Post_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Post_is_liked(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_update_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _User_updated_at(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delete_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_User_updated_at,
+		ec.fieldContext_Mutation_delete_post,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DeletePost(ctx, fc.Args["post_id"].(string))
 		},
-		nil,
-		ec.marshalOTime2timeᚐTime,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_User_updated_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delete_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "User",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delete_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserProfileActivity_id(ctx context.Context, field graphql.CollectedField, obj *models.UserProfileActivity) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_create_comment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserProfileActivity_id,
+		ec.fieldContext_Mutation_create_comment,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateComment(ctx, fc.Args["input"].(model.CreateCommentInput))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNComment2ᚖblindlyᚋinternalᚋmodelsᚐComment,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserProfileActivity_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_create_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserProfileActivity",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _UserProfileActivity_type(ctx context.Context, field graphql.CollectedField, obj *models.UserProfileActivity) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_UserProfileActivity_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalNActivityType2blindlyᚋinternalᚋmodelsᚐActivityType,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_UserProfileActivity_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "UserProfileActivity",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ActivityType does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Comment_id(ctx, field)
+			case "post_id":
+				return ec.fieldContext_Comment_post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Comment_user_id(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_Comment_reply_to_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Comment_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Comment_content(ctx, field)
+			case "likes":
+				return ec.fieldContext_Comment_likes(ctx, field)
+			case "user":
+				return ec.fieldContext_Comment_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Comment_is_liked(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_create_comment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserProfileActivity_target_user(ctx context.Context, field graphql.CollectedField, obj *models.UserProfileActivity) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_update_comment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserProfileActivity_target_user,
+		ec.fieldContext_Mutation_update_comment,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.UserProfileActivity().TargetUser(ctx, obj)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateComment(ctx, fc.Args["input"].(model.UpdateCommentInput))
 		},
-		nil,
-		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNComment2ᚖblindlyᚋinternalᚋmodelsᚐComment,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserProfileActivity_target_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_update_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserProfileActivity",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
-				return ec.fieldContext_UserPublic_id(ctx, field)
-			case "name":
-				return ec.fieldContext_UserPublic_name(ctx, field)
-			case "pfp":
-				return ec.fieldContext_UserPublic_pfp(ctx, field)
-			case "bio":
-				return ec.fieldContext_UserPublic_bio(ctx, field)
-			case "dob":
-				return ec.fieldContext_UserPublic_dob(ctx, field)
-			case "gender":
-				return ec.fieldContext_UserPublic_gender(ctx, field)
-			case "hobbies":
-				return ec.fieldContext_UserPublic_hobbies(ctx, field)
-			case "interests":
-				return ec.fieldContext_UserPublic_interests(ctx, field)
-			case "user_prompts":
-				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
-			case "personality_traits":
-				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
-			case "photos":
-				return ec.fieldContext_UserPublic_photos(ctx, field)
-			case "is_verified":
-				return ec.fieldContext_UserPublic_is_verified(ctx, field)
-			case "extra":
-				return ec.fieldContext_UserPublic_extra(ctx, field)
+				return ec.fieldContext_Comment_id(ctx, field)
+			case "post_id":
+				return ec.fieldContext_Comment_post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Comment_user_id(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_Comment_reply_to_id(ctx, field)
 			case "created_at":
-				return ec.fieldContext_UserPublic_created_at(ctx, field)
-			case "is_online":
-				return ec.fieldContext_UserPublic_is_online(ctx, field)
-			case "is_locked":
-				return ec.fieldContext_UserPublic_is_locked(ctx, field)
-			case "is_poked":
-				return ec.fieldContext_UserPublic_is_poked(ctx, field)
-			case "chat_id":
-				return ec.fieldContext_UserPublic_chat_id(ctx, field)
+				return ec.fieldContext_Comment_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Comment_content(ctx, field)
+			case "likes":
+				return ec.fieldContext_Comment_likes(ctx, field)
+			case "user":
+				return ec.fieldContext_Comment_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Comment_is_liked(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_update_comment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserProfileActivity_class(ctx context.Context, field graphql.CollectedField, obj *models.UserProfileActivity) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_delete_comment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserProfileActivity_class,
+		ec.fieldContext_Mutation_delete_comment,
 		func(ctx context.Context) (any, error) {
-			return ec.resolvers.UserProfileActivity().Class(ctx, obj)
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DeleteComment(ctx, fc.Args["comment_id"].(string))
 		},
-		nil,
-		ec.marshalNActivityClass2blindlyᚋinternalᚋgraphᚋmodelᚐActivityClass,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserProfileActivity_class(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_delete_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserProfileActivity",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ActivityClass does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delete_comment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_id(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_toggle_post_like(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_id,
+		ec.fieldContext_Mutation_toggle_post_like,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().TogglePostLike(ctx, fc.Args["post_id"].(string))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_toggle_post_like(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Post_user_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Post_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "media":
+				return ec.fieldContext_Post_media(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "comments":
+				return ec.fieldContext_Post_comments(ctx, field)
+			case "views":
+				return ec.fieldContext_Post_views(ctx, field)
+			case "user":
+				return ec.fieldContext_Post_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Post_is_liked(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_toggle_post_like_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_name(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_toggle_comment_like(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_name,
+		ec.fieldContext_Mutation_toggle_comment_like,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ToggleCommentLike(ctx, fc.Args["comment_id"].(string))
 		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
 
-func (ec *executionContext) fieldContext_UserPublic_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
 
-func (ec *executionContext) _UserPublic_pfp(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_UserPublic_pfp,
-		func(ctx context.Context) (any, error) {
-			return obj.Pfp, nil
+			next = directive1
+			return next
 		},
-		nil,
-		ec.marshalNString2string,
+		ec.marshalNComment2ᚖblindlyᚋinternalᚋmodelsᚐComment,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_pfp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_toggle_comment_like(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Comment_id(ctx, field)
+			case "post_id":
+				return ec.fieldContext_Comment_post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Comment_user_id(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_Comment_reply_to_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Comment_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Comment_content(ctx, field)
+			case "likes":
+				return ec.fieldContext_Comment_likes(ctx, field)
+			case "user":
+				return ec.fieldContext_Comment_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Comment_is_liked(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_toggle_comment_like_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_bio(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_increment_post_view(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_bio,
+		ec.fieldContext_Mutation_increment_post_view,
 		func(ctx context.Context) (any, error) {
-			return obj.Bio, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().IncrementPostView(ctx, fc.Args["post_id"].(string))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_bio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_increment_post_view(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Post_user_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Post_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "media":
+				return ec.fieldContext_Post_media(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "comments":
+				return ec.fieldContext_Post_comments(ctx, field)
+			case "views":
+				return ec.fieldContext_Post_views(ctx, field)
+			case "user":
+				return ec.fieldContext_Post_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Post_is_liked(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_increment_post_view_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_dob(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createProfileActivity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_dob,
+		ec.fieldContext_Mutation_createProfileActivity,
 		func(ctx context.Context) (any, error) {
-			return obj.Dob, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateProfileActivity(ctx, fc.Args["type"].(models.ActivityType), fc.Args["target_user_id"].(string))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNUserProfileActivity2ᚖblindlyᚋinternalᚋmodelsᚐUserProfileActivity,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_dob(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createProfileActivity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserProfileActivity_id(ctx, field)
+			case "type":
+				return ec.fieldContext_UserProfileActivity_type(ctx, field)
+			case "target_user":
+				return ec.fieldContext_UserProfileActivity_target_user(ctx, field)
+			case "class":
+				return ec.fieldContext_UserProfileActivity_class(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserProfileActivity", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createProfileActivity_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_gender(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_gender,
+		ec.fieldContext_Mutation_createReport,
 		func(ctx context.Context) (any, error) {
-			return obj.Gender, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateReport(ctx, fc.Args["input"].(model.CreateReportInput))
 		},
-		nil,
-		ec.marshalNString2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNReport2ᚖblindlyᚋinternalᚋmodelsᚐReport,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_gender(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Report_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Report_user_id(ctx, field)
+			case "target_id":
+				return ec.fieldContext_Report_target_id(ctx, field)
+			case "reason":
+				return ec.fieldContext_Report_reason(ctx, field)
+			case "additional_info":
+				return ec.fieldContext_Report_additional_info(ctx, field)
+			case "media":
+				return ec.fieldContext_Report_media(ctx, field)
+			case "status":
+				return ec.fieldContext_Report_status(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Report_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_Report_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Report", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createReport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_hobbies(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_swipe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_hobbies,
+		ec.fieldContext_Mutation_swipe,
 		func(ctx context.Context) (any, error) {
-			return obj.Hobbies, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().Swipe(ctx, fc.Args["target_id"].(string), fc.Args["action_type"].(models.SwipeType))
 		},
-		nil,
-		ec.marshalNString2ᚕstringᚄ,
-		true,
-		true,
-	)
-}
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
 
-func (ec *executionContext) fieldContext_UserPublic_hobbies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
 
-func (ec *executionContext) _UserPublic_interests(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_UserPublic_interests,
-		func(ctx context.Context) (any, error) {
-			return obj.Interests, nil
+			next = directive1
+			return next
 		},
-		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNSwipeResponse2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipeResponse,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_interests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_swipe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "swipe":
+				return ec.fieldContext_SwipeResponse_swipe(ctx, field)
+			case "match":
+				return ec.fieldContext_SwipeResponse_match(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SwipeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_swipe_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_user_prompts(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createUser(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_user_prompts,
+		ec.fieldContext_Mutation_createUser,
 		func(ctx context.Context) (any, error) {
-			return obj.UserPrompts, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateUser(ctx, fc.Args["input"].(model.CreateUserInput))
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_user_prompts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createUser(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "access_token":
+				return ec.fieldContext_AuthPayload_access_token(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createUser_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_personality_traits(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_loginWithPassword(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_personality_traits,
+		ec.fieldContext_Mutation_loginWithPassword,
 		func(ctx context.Context) (any, error) {
-			return obj.PersonalityTraits, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().LoginWithPassword(ctx, fc.Args["email"].(string), fc.Args["password"].(string))
 		},
 		nil,
-		ec.marshalNPersonalityTrait2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitᚄ,
+		ec.marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_personality_traits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_loginWithPassword(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "key":
-				return ec.fieldContext_PersonalityTrait_key(ctx, field)
-			case "value":
-				return ec.fieldContext_PersonalityTrait_value(ctx, field)
+			case "access_token":
+				return ec.fieldContext_AuthPayload_access_token(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PersonalityTrait", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_loginWithPassword_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_photos(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_requestEmailLoginCode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_photos,
+		ec.fieldContext_Mutation_requestEmailLoginCode,
 		func(ctx context.Context) (any, error) {
-			return obj.Photos, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RequestEmailLoginCode(ctx, fc.Args["email"].(string))
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_photos(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_requestEmailLoginCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_requestEmailLoginCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_is_verified(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_verifyEmailLoginCode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_is_verified,
+		ec.fieldContext_Mutation_verifyEmailLoginCode,
 		func(ctx context.Context) (any, error) {
-			return obj.IsVerified, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().VerifyEmailLoginCode(ctx, fc.Args["email"].(string), fc.Args["code"].(string))
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_is_verified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_verifyEmailLoginCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "access_token":
+				return ec.fieldContext_AuthPayload_access_token(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_verifyEmailLoginCode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_extra(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_updateMe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_extra,
+		ec.fieldContext_Mutation_updateMe,
 		func(ctx context.Context) (any, error) {
-			return obj.Extra, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateMe(ctx, fc.Args["input"].(model.UpdateUserInput))
 		},
-		nil,
-		ec.marshalOExtraMetadata2ᚖblindlyᚋinternalᚋmodelsᚐExtraMetadata,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNUser2ᚖblindlyᚋinternalᚋmodelsᚐUser,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_extra(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateMe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "school":
-				return ec.fieldContext_ExtraMetadata_school(ctx, field)
-			case "work":
-				return ec.fieldContext_ExtraMetadata_work(ctx, field)
-			case "looking_for":
-				return ec.fieldContext_ExtraMetadata_looking_for(ctx, field)
-			case "zodiac":
-				return ec.fieldContext_ExtraMetadata_zodiac(ctx, field)
-			case "languages":
-				return ec.fieldContext_ExtraMetadata_languages(ctx, field)
-			case "excercise":
-				return ec.fieldContext_ExtraMetadata_excercise(ctx, field)
-			case "drinking":
-				return ec.fieldContext_ExtraMetadata_drinking(ctx, field)
-			case "smoking":
-				return ec.fieldContext_ExtraMetadata_smoking(ctx, field)
-			case "kids":
-				return ec.fieldContext_ExtraMetadata_kids(ctx, field)
-			case "religion":
-				return ec.fieldContext_ExtraMetadata_religion(ctx, field)
-			case "ethnicity":
-				return ec.fieldContext_ExtraMetadata_ethnicity(ctx, field)
-			case "sexuality":
-				return ec.fieldContext_ExtraMetadata_sexuality(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ExtraMetadata", field.Name)
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "first_name":
+				return ec.fieldContext_User_first_name(ctx, field)
+			case "last_name":
+				return ec.fieldContext_User_last_name(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "dob":
+				return ec.fieldContext_User_dob(ctx, field)
+			case "pfp":
+				return ec.fieldContext_User_pfp(ctx, field)
+			case "gender":
+				return ec.fieldContext_User_gender(ctx, field)
+			case "bio":
+				return ec.fieldContext_User_bio(ctx, field)
+			case "hobbies":
+				return ec.fieldContext_User_hobbies(ctx, field)
+			case "interests":
+				return ec.fieldContext_User_interests(ctx, field)
+			case "user_prompts":
+				return ec.fieldContext_User_user_prompts(ctx, field)
+			case "personality_traits":
+				return ec.fieldContext_User_personality_traits(ctx, field)
+			case "photos":
+				return ec.fieldContext_User_photos(ctx, field)
+			case "is_verified":
+				return ec.fieldContext_User_is_verified(ctx, field)
+			case "address":
+				return ec.fieldContext_User_address(ctx, field)
+			case "extra":
+				return ec.fieldContext_User_extra(ctx, field)
+			case "hide_online":
+				return ec.fieldContext_User_hide_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_User_last_seen_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_User_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_User_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateMe_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_created_at(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_created_at,
+		ec.fieldContext_Mutation_refreshToken,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return ec.resolvers.Mutation().RefreshToken(ctx)
 		},
-		nil,
-		ec.marshalNTime2timeᚐTime,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_refreshToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			switch field.Name {
+			case "access_token":
+				return ec.fieldContext_AuthPayload_access_token(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_is_online(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_createVerification(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_is_online,
+		ec.fieldContext_Mutation_createVerification,
 		func(ctx context.Context) (any, error) {
-			return obj.IsOnline, nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateVerification(ctx, fc.Args["input"].(model.UserVerificationInput))
 		},
-		nil,
-		ec.marshalNBoolean2bool,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNUserVerification2ᚖblindlyᚋinternalᚋmodelsᚐUserVerification,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_is_online(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createVerification(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserVerification_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_UserVerification_user_id(ctx, field)
+			case "media":
+				return ec.fieldContext_UserVerification_media(ctx, field)
+			case "status":
+				return ec.fieldContext_UserVerification_status(ctx, field)
+			case "created_at":
+				return ec.fieldContext_UserVerification_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_UserVerification_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserVerification", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createVerification_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_is_locked(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_has_next_page(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_is_locked,
+		ec.fieldContext_PageInfo_has_next_page,
 		func(ctx context.Context) (any, error) {
-			return obj.IsLocked, nil
+			return obj.HasNextPage, nil
 		},
 		nil,
 		ec.marshalNBoolean2bool,
@@ -8740,9 +10263,9 @@ func (ec *executionContext) _UserPublic_is_locked(ctx context.Context, field gra
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_is_locked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_has_next_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8753,83 +10276,83 @@ func (ec *executionContext) fieldContext_UserPublic_is_locked(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_is_poked(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_next_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_is_poked,
+		ec.fieldContext_PageInfo_next_cursor,
 		func(ctx context.Context) (any, error) {
-			return obj.IsPoked, nil
+			return obj.NextCursor, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_is_poked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_next_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserPublic_chat_id(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_has_previous_page(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserPublic_chat_id,
+		ec.fieldContext_PageInfo_has_previous_page,
 		func(ctx context.Context) (any, error) {
-			return obj.ChatID, nil
+			return obj.HasPreviousPage, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserPublic_chat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_has_previous_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserPublic",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserVerification_id(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_previous_cursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserVerification_id,
+		ec.fieldContext_PageInfo_previous_cursor,
 		func(ctx context.Context) (any, error) {
-			return obj.Id, nil
+			return obj.PreviousCursor, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserVerification_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_previous_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserVerification",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8840,14 +10363,14 @@ func (ec *executionContext) fieldContext_UserVerification_id(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _UserVerification_user_id(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalityTrait_key(ctx context.Context, field graphql.CollectedField, obj *model.PersonalityTrait) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserVerification_user_id,
+		ec.fieldContext_PersonalityTrait_key,
 		func(ctx context.Context) (any, error) {
-			return obj.UserId, nil
+			return obj.Key, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -8856,9 +10379,9 @@ func (ec *executionContext) _UserVerification_user_id(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_UserVerification_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalityTrait_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserVerification",
+		Object:     "PersonalityTrait",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8869,53 +10392,43 @@ func (ec *executionContext) fieldContext_UserVerification_user_id(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _UserVerification_media(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+func (ec *executionContext) _PersonalityTrait_value(ctx context.Context, field graphql.CollectedField, obj *model.PersonalityTrait) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserVerification_media,
+		ec.fieldContext_PersonalityTrait_value,
 		func(ctx context.Context) (any, error) {
-			return obj.Media, nil
+			return obj.Value, nil
 		},
 		nil,
-		ec.marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMediaᚄ,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserVerification_media(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PersonalityTrait_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserVerification",
+		Object:     "PersonalityTrait",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Media_id(ctx, field)
-			case "url":
-				return ec.fieldContext_Media_url(ctx, field)
-			case "type":
-				return ec.fieldContext_Media_type(ctx, field)
-			case "created_at":
-				return ec.fieldContext_Media_created_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Media", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserVerification_status(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_id(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserVerification_status,
+		ec.fieldContext_PlannedDate_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.Id, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -8924,9 +10437,9 @@ func (ec *executionContext) _UserVerification_status(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_UserVerification_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserVerification",
+		Object:     "PlannedDate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8937,72 +10450,72 @@ func (ec *executionContext) fieldContext_UserVerification_status(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _UserVerification_created_at(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_match_id(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserVerification_created_at,
+		ec.fieldContext_PlannedDate_match_id,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.MatchId, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserVerification_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_match_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserVerification",
+		Object:     "PlannedDate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserVerification_updated_at(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_chat_id(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserVerification_updated_at,
+		ec.fieldContext_PlannedDate_chat_id,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.ChatId, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserVerification_updated_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_chat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserVerification",
+		Object:     "PlannedDate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_message_id(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_name,
+		ec.fieldContext_PlannedDate_message_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.MessageId, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -9011,9 +10524,9 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_message_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PlannedDate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9024,27 +10537,27 @@ func (ec *executionContext) fieldContext___Directive_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_proposed_by(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_description,
+		ec.fieldContext_PlannedDate_proposed_by,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.ProposedBy, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_proposed_by(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PlannedDate",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -9053,126 +10566,101 @@ func (ec *executionContext) fieldContext___Directive_description(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_accepted_by(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_isRepeatable,
+		ec.fieldContext_PlannedDate_accepted_by,
 		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
+			return obj.AcceptedBy, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_accepted_by(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PlannedDate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_starts_at(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_locations,
+		ec.fieldContext_PlannedDate_starts_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
+			return obj.StartsAt, nil
 		},
 		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_starts_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PlannedDate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_venue(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Directive_args,
+		ec.fieldContext_PlannedDate_venue,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.Venue, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_venue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "PlannedDate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_note(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_name,
+		ec.fieldContext_PlannedDate_note,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Note, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -9181,9 +10669,9 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_note(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "PlannedDate",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9194,85 +10682,85 @@ func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PlannedDate_created_at(ctx context.Context, field graphql.CollectedField, obj *models.PlannedDate) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_description,
+		ec.fieldContext_PlannedDate_created_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNTime2timeᚐTime,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PlannedDate_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "PlannedDate",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_id(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_isDeprecated,
+		ec.fieldContext_Post_id,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.Id, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_user_id(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_deprecationReason,
+		ec.fieldContext_Post_user_id,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.UserId, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -9281,56 +10769,56 @@ func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_name,
+		ec.fieldContext_Post_created_at,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_content(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_description,
+		ec.fieldContext_Post_content,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Content, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -9339,806 +10827,1005 @@ func (ec *executionContext) fieldContext___Field_description(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_media(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_args,
+		ec.fieldContext_Post_media,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.Media, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		ec.marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_media(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
+			case "id":
+				return ec.fieldContext_Media_id(ctx, field)
+			case "url":
+				return ec.fieldContext_Media_url(ctx, field)
 			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+				return ec.fieldContext_Media_type(ctx, field)
+			case "view_once":
+				return ec.fieldContext_Media_view_once(ctx, field)
+			case "opened_at":
+				return ec.fieldContext_Media_opened_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Media_created_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Media", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_likes(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_type,
+		ec.fieldContext_Post_likes,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return ec.resolvers.Post().Likes(ctx, obj)
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_likes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_comments(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_isDeprecated,
+		ec.fieldContext_Post_comments,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return ec.resolvers.Post().Comments(ctx, obj)
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_comments(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_views(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_deprecationReason,
+		ec.fieldContext_Post_views,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return ec.resolvers.Post().Views(ctx, obj)
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_views(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Post",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_user(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_name,
+		ec.fieldContext_Post_user,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return ec.resolvers.Post().User(ctx, obj)
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserPublic_id(ctx, field)
+			case "name":
+				return ec.fieldContext_UserPublic_name(ctx, field)
+			case "pfp":
+				return ec.fieldContext_UserPublic_pfp(ctx, field)
+			case "bio":
+				return ec.fieldContext_UserPublic_bio(ctx, field)
+			case "dob":
+				return ec.fieldContext_UserPublic_dob(ctx, field)
+			case "gender":
+				return ec.fieldContext_UserPublic_gender(ctx, field)
+			case "hobbies":
+				return ec.fieldContext_UserPublic_hobbies(ctx, field)
+			case "interests":
+				return ec.fieldContext_UserPublic_interests(ctx, field)
+			case "user_prompts":
+				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
+			case "personality_traits":
+				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
+			case "photos":
+				return ec.fieldContext_UserPublic_photos(ctx, field)
+			case "is_verified":
+				return ec.fieldContext_UserPublic_is_verified(ctx, field)
+			case "extra":
+				return ec.fieldContext_UserPublic_extra(ctx, field)
+			case "created_at":
+				return ec.fieldContext_UserPublic_created_at(ctx, field)
+			case "is_online":
+				return ec.fieldContext_UserPublic_is_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_UserPublic_last_seen_at(ctx, field)
+			case "is_locked":
+				return ec.fieldContext_UserPublic_is_locked(ctx, field)
+			case "is_poked":
+				return ec.fieldContext_UserPublic_is_poked(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_UserPublic_chat_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Post_is_liked(ctx context.Context, field graphql.CollectedField, obj *models.Post) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_description,
+		ec.fieldContext_Post_is_liked,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.IsLiked, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Post_is_liked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Post",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PostUnlockRating_she_rating(ctx context.Context, field graphql.CollectedField, obj *models.PostUnlockRating) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_type,
+		ec.fieldContext_PostUnlockRating_she_rating,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return ec.resolvers.PostUnlockRating().SheRating(ctx, obj)
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostUnlockRating_she_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PostUnlockRating",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PostUnlockRating_he_rating(ctx context.Context, field graphql.CollectedField, obj *models.PostUnlockRating) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_defaultValue,
+		ec.fieldContext_PostUnlockRating_he_rating,
 		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
+			return ec.resolvers.PostUnlockRating().HeRating(ctx, obj)
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostUnlockRating_he_rating(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PostUnlockRating",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PostsConnection_posts(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_isDeprecated,
+		ec.fieldContext_PostsConnection_posts,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.Posts, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNPost2ᚕᚖblindlyᚋinternalᚋmodelsᚐPost,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostsConnection_posts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PostsConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Post_user_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Post_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "media":
+				return ec.fieldContext_Post_media(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "comments":
+				return ec.fieldContext_Post_comments(ctx, field)
+			case "views":
+				return ec.fieldContext_Post_views(ctx, field)
+			case "user":
+				return ec.fieldContext_Post_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Post_is_liked(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PostsConnection_page_info(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_deprecationReason,
+		ec.fieldContext_PostsConnection_page_info,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.PageInfo, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNPageInfo2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPageInfo,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostsConnection_page_info(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "PostsConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "has_next_page":
+				return ec.fieldContext_PageInfo_has_next_page(ctx, field)
+			case "next_cursor":
+				return ec.fieldContext_PageInfo_next_cursor(ctx, field)
+			case "has_previous_page":
+				return ec.fieldContext_PageInfo_has_previous_page(ctx, field)
+			case "previous_cursor":
+				return ec.fieldContext_PageInfo_previous_cursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _PostsConnection_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PostsConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_description,
+		ec.fieldContext_PostsConnection_total_count,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.TotalCount, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PostsConnection_total_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "PostsConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getMyConnections(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_types,
+		ec.fieldContext_Query_getMyConnections,
 		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetMyConnections(ctx, fc.Args["filter"].(*model.ConnectionFilter), fc.Args["sort"].(*model.ConnectionSort), fc.Args["limit"].(*int32), fc.Args["cursor"].(*string))
 		},
-		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNConnection2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐConnection,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getMyConnections(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "chat":
+				return ec.fieldContext_Connection_chat(ctx, field)
+			case "match":
+				return ec.fieldContext_Connection_match(ctx, field)
+			case "last_message":
+				return ec.fieldContext_Connection_last_message(ctx, field)
+			case "unread_messages":
+				return ec.fieldContext_Connection_unread_messages(ctx, field)
+			case "percentage_complete":
+				return ec.fieldContext_Connection_percentage_complete(ctx, field)
+			case "connection_profile":
+				return ec.fieldContext_Connection_connection_profile(ctx, field)
+			case "settings":
+				return ec.fieldContext_Connection_settings(ctx, field)
+			case "last_activity_at":
+				return ec.fieldContext_Connection_last_activity_at(ctx, field)
+			case "cursor":
+				return ec.fieldContext_Connection_cursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Connection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMyConnections_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_getUnreadBadge(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_queryType,
+		ec.fieldContext_Query_getUnreadBadge,
 		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
+			return ec.resolvers.Query().GetUnreadBadge(ctx)
 		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getUnreadBadge(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_scheduledMessages(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_mutationType,
+		ec.fieldContext_Query_scheduledMessages,
 		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().ScheduledMessages(ctx, fc.Args["chat_id"].(string))
 		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNScheduledMessage2ᚕᚖblindlyᚋinternalᚋmodelsᚐScheduledMessageᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_scheduledMessages(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "id":
+				return ec.fieldContext_ScheduledMessage_id(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_ScheduledMessage_chat_id(ctx, field)
+			case "message":
+				return ec.fieldContext_ScheduledMessage_message(ctx, field)
+			case "deliver_at":
+				return ec.fieldContext_ScheduledMessage_deliver_at(ctx, field)
+			case "status":
+				return ec.fieldContext_ScheduledMessage_status(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ScheduledMessage_created_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ScheduledMessage", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_scheduledMessages_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_messageRevisions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_subscriptionType,
+		ec.fieldContext_Query_messageRevisions,
 		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().MessageRevisions(ctx, fc.Args["chat_id"].(string), fc.Args["message_id"].(string))
 		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNMessageRevision2ᚕᚖblindlyᚋinternalᚋmodelsᚐMessageRevisionᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_messageRevisions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "id":
+				return ec.fieldContext_MessageRevision_id(ctx, field)
+			case "message_id":
+				return ec.fieldContext_MessageRevision_message_id(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_MessageRevision_sender_id(ctx, field)
+			case "content":
+				return ec.fieldContext_MessageRevision_content(ctx, field)
+			case "media":
+				return ec.fieldContext_MessageRevision_media(ctx, field)
+			case "created_at":
+				return ec.fieldContext_MessageRevision_created_at(ctx, field)
+			case "replaced_at":
+				return ec.fieldContext_MessageRevision_replaced_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MessageRevision", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_messageRevisions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_plannedDates(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_directives,
+		ec.fieldContext_Query_plannedDates,
 		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PlannedDates(ctx, fc.Args["match_id"].(*string), fc.Args["upcoming"].(*bool))
 		},
-		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNPlannedDate2ᚕᚖblindlyᚋinternalᚋmodelsᚐPlannedDateᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_plannedDates(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
+			case "id":
+				return ec.fieldContext_PlannedDate_id(ctx, field)
+			case "match_id":
+				return ec.fieldContext_PlannedDate_match_id(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_PlannedDate_chat_id(ctx, field)
+			case "message_id":
+				return ec.fieldContext_PlannedDate_message_id(ctx, field)
+			case "proposed_by":
+				return ec.fieldContext_PlannedDate_proposed_by(ctx, field)
+			case "accepted_by":
+				return ec.fieldContext_PlannedDate_accepted_by(ctx, field)
+			case "starts_at":
+				return ec.fieldContext_PlannedDate_starts_at(ctx, field)
+			case "venue":
+				return ec.fieldContext_PlannedDate_venue(ctx, field)
+			case "note":
+				return ec.fieldContext_PlannedDate_note(ctx, field)
+			case "created_at":
+				return ec.fieldContext_PlannedDate_created_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PlannedDate", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_plannedDates_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_trustedContacts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_kind,
+		ec.fieldContext_Query_trustedContacts,
 		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
+			return ec.resolvers.Query().TrustedContacts(ctx)
 		},
-		nil,
-		ec.marshalN__TypeKind2string,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNTrustedContact2ᚕᚖblindlyᚋinternalᚋmodelsᚐTrustedContactᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_trustedContacts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TrustedContact_id(ctx, field)
+			case "name":
+				return ec.fieldContext_TrustedContact_name(ctx, field)
+			case "email":
+				return ec.fieldContext_TrustedContact_email(ctx, field)
+			case "created_at":
+				return ec.fieldContext_TrustedContact_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TrustedContact", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_dateCheckIns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_name,
+		ec.fieldContext_Query_dateCheckIns,
 		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
+			return ec.resolvers.Query().DateCheckIns(ctx)
 		},
-		nil,
-		ec.marshalOString2ᚖstring,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNDateCheckIn2ᚕᚖblindlyᚋinternalᚋmodelsᚐDateCheckInᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_dateCheckIns(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_DateCheckIn_id(ctx, field)
+			case "date_id":
+				return ec.fieldContext_DateCheckIn_date_id(ctx, field)
+			case "check_in_at":
+				return ec.fieldContext_DateCheckIn_check_in_at(ctx, field)
+			case "status":
+				return ec.fieldContext_DateCheckIn_status(ctx, field)
+			case "checked_in_at":
+				return ec.fieldContext_DateCheckIn_checked_in_at(ctx, field)
+			case "alerted_at":
+				return ec.fieldContext_DateCheckIn_alerted_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_DateCheckIn_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DateCheckIn", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_chatExports(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_description,
+		ec.fieldContext_Query_chatExports,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().ChatExports(ctx, fc.Args["chat_id"].(string))
 		},
-		nil,
-		ec.marshalOString2ᚖstring,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNChatExport2ᚕᚖblindlyᚋinternalᚋmodelsᚐChatExportᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_chatExports(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ChatExport_id(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_ChatExport_chat_id(ctx, field)
+			case "format":
+				return ec.fieldContext_ChatExport_format(ctx, field)
+			case "status":
+				return ec.fieldContext_ChatExport_status(ctx, field)
+			case "error":
+				return ec.fieldContext_ChatExport_error(ctx, field)
+			case "download_url":
+				return ec.fieldContext_ChatExport_download_url(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ChatExport_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_ChatExport_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChatExport", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_chatExports_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_get_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_specifiedByURL,
+		ec.fieldContext_Query_get_posts,
 		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetPosts(ctx, fc.Args["filter"].(*model.PostFilterInput), fc.Args["sort"].(*model.SortInput), fc.Args["limit"].(*int32), fc.Args["cursor"].(*string))
 		},
-		nil,
-		ec.marshalOString2ᚖstring,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNPostsConnection2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPostsConnection,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_get_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "posts":
+				return ec.fieldContext_PostsConnection_posts(ctx, field)
+			case "page_info":
+				return ec.fieldContext_PostsConnection_page_info(ctx, field)
+			case "total_count":
+				return ec.fieldContext_PostsConnection_total_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PostsConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_get_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_get_post(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_fields,
+		ec.fieldContext_Query_get_post,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+			return ec.resolvers.Query().GetPost(ctx, fc.Args["post_id"].(string))
 		},
-		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOPost2ᚖblindlyᚋinternalᚋmodelsᚐPost,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_get_post(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			case "id":
+				return ec.fieldContext_Post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Post_user_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Post_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Post_content(ctx, field)
+			case "media":
+				return ec.fieldContext_Post_media(ctx, field)
+			case "likes":
+				return ec.fieldContext_Post_likes(ctx, field)
+			case "comments":
+				return ec.fieldContext_Post_comments(ctx, field)
+			case "views":
+				return ec.fieldContext_Post_views(ctx, field)
+			case "user":
+				return ec.fieldContext_Post_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Post_is_liked(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Post", field.Name)
 		},
 	}
 	defer func() {
@@ -10148,154 +11835,183 @@ func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, fiel
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_get_post_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_get_feed_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_interfaces,
+		ec.fieldContext_Query_get_feed_posts,
 		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetFeedPosts(ctx, fc.Args["limit"].(*int32), fc.Args["cursor"].(*string))
 		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNPostsConnection2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPostsConnection,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_get_feed_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			case "posts":
+				return ec.fieldContext_PostsConnection_posts(ctx, field)
+			case "page_info":
+				return ec.fieldContext_PostsConnection_page_info(ctx, field)
+			case "total_count":
+				return ec.fieldContext_PostsConnection_total_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PostsConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_get_feed_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_get_comments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_possibleTypes,
+		ec.fieldContext_Query_get_comments,
 		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetComments(ctx, fc.Args["filter"].(model.CommentFilterInput), fc.Args["sort"].(*model.SortInput), fc.Args["limit"].(*int32), fc.Args["cursor"].(*string))
 		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNCommentsConnection2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐCommentsConnection,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_get_comments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "comments":
+				return ec.fieldContext_CommentsConnection_comments(ctx, field)
+			case "page_info":
+				return ec.fieldContext_CommentsConnection_page_info(ctx, field)
+			case "total_count":
+				return ec.fieldContext_CommentsConnection_total_count(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CommentsConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_get_comments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_get_comment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_enumValues,
+		ec.fieldContext_Query_get_comment,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+			return ec.resolvers.Query().GetComment(ctx, fc.Args["comment_id"].(string))
 		},
-		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOComment2ᚖblindlyᚋinternalᚋmodelsᚐComment,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_get_comment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			case "id":
+				return ec.fieldContext_Comment_id(ctx, field)
+			case "post_id":
+				return ec.fieldContext_Comment_post_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Comment_user_id(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_Comment_reply_to_id(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Comment_created_at(ctx, field)
+			case "content":
+				return ec.fieldContext_Comment_content(ctx, field)
+			case "likes":
+				return ec.fieldContext_Comment_likes(ctx, field)
+			case "user":
+				return ec.fieldContext_Comment_user(ctx, field)
+			case "is_liked":
+				return ec.fieldContext_Comment_is_liked(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Comment", field.Name)
 		},
 	}
 	defer func() {
@@ -10305,314 +12021,5771 @@ func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context,
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_get_comment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_get_trending_posts(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_inputFields,
+		ec.fieldContext_Query_get_trending_posts,
 		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().GetTrendingPosts(ctx, fc.Args["time_window"].(*int32), fc.Args["limit"].(*int32), fc.Args["cursor"].(*string))
 		},
-		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNPostsConnection2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPostsConnection,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_get_trending_posts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			case "posts":
+				return ec.fieldContext_PostsConnection_posts(ctx, field)
+			case "page_info":
+				return ec.fieldContext_PostsConnection_page_info(ctx, field)
+			case "total_count":
+				return ec.fieldContext_PostsConnection_total_count(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PostsConnection", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_get_trending_posts_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_profileActivities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_ofType,
+		ec.fieldContext_Query_profileActivities,
 		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().ProfileActivities(ctx, fc.Args["class"].(*model.ActivityClass))
 		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNUserProfileActivity2ᚕᚖblindlyᚋinternalᚋmodelsᚐUserProfileActivity,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_profileActivities(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+			case "id":
+				return ec.fieldContext_UserProfileActivity_id(ctx, field)
+			case "type":
+				return ec.fieldContext_UserProfileActivity_type(ctx, field)
+			case "target_user":
+				return ec.fieldContext_UserProfileActivity_target_user(ctx, field)
+			case "class":
+				return ec.fieldContext_UserProfileActivity_class(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserProfileActivity", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_profileActivities_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_recommendations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_isOneOf,
+		ec.fieldContext_Query_recommendations,
 		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Recommendations(ctx, fc.Args["cursor"].(*string), fc.Args["limit"].(*int32))
 		},
-		nil,
-		ec.marshalOBoolean2bool,
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNRecommendationsResult2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐRecommendationsResult,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_recommendations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "items":
+				return ec.fieldContext_RecommendationsResult_items(ctx, field)
+			case "next_cursor":
+				return ec.fieldContext_RecommendationsResult_next_cursor(ctx, field)
+			case "has_more":
+				return ec.fieldContext_RecommendationsResult_has_more(ctx, field)
+			case "fetched_at":
+				return ec.fieldContext_RecommendationsResult_fetched_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecommendationsResult", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_recommendations_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
+func (ec *executionContext) _Query_mySwipes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_mySwipes,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().MySwipes(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
 
-// region    **************************** input.gotpl *****************************
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
 
-func (ec *executionContext) unmarshalInputAddressInput(ctx context.Context, obj any) (model.AddressInput, error) {
-	var it model.AddressInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+			next = directive1
+			return next
+		},
+		ec.marshalNSwipedProfile2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipedProfileᚄ,
+		true,
+		true,
+	)
+}
 
-	fieldsInOrder := [...]string{"city", "state", "country"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "city":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("city"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.City = data
-		case "state":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.State = data
-		case "country":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("country"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) fieldContext_Query_mySwipes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "profile":
+				return ec.fieldContext_SwipedProfile_profile(ctx, field)
+			case "swipe":
+				return ec.fieldContext_SwipedProfile_swipe(ctx, field)
 			}
-			it.Country = data
-		}
+			return nil, fmt.Errorf("no field named %q was found under type SwipedProfile", field.Name)
+		},
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputCommentFilterInput(ctx context.Context, obj any) (model.CommentFilterInput, error) {
-	var it model.CommentFilterInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_me,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Me(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
 
-	fieldsInOrder := [...]string{"post_id", "user_id", "reply_to_id", "parent_only", "search_content", "created_after", "created_before", "min_likes"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "post_id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("post_id"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.PostID = data
-		case "user_id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("user_id"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.UserID = data
-		case "reply_to_id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reply_to_id"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ReplyToID = data
-		case "parent_only":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("parent_only"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ParentOnly = data
-		case "search_content":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search_content"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.SearchContent = data
-		case "created_after":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_after"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CreatedAfter = data
-		case "created_before":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_before"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CreatedBefore = data
-		case "min_likes":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min_likes"))
-			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
-			if err != nil {
-				return it, err
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
 			}
-			it.MinLikes = data
-		}
-	}
 
-	return it, nil
+			next = directive1
+			return next
+		},
+		ec.marshalOUser2ᚖblindlyᚋinternalᚋmodelsᚐUser,
+		true,
+		false,
+	)
 }
 
-func (ec *executionContext) unmarshalInputCreateCommentInput(ctx context.Context, obj any) (model.CreateCommentInput, error) {
-	var it model.CreateCommentInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
-
-	fieldsInOrder := [...]string{"post_id", "reply_to_id", "content"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "post_id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("post_id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+func (ec *executionContext) fieldContext_Query_me(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "first_name":
+				return ec.fieldContext_User_first_name(ctx, field)
+			case "last_name":
+				return ec.fieldContext_User_last_name(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "dob":
+				return ec.fieldContext_User_dob(ctx, field)
+			case "pfp":
+				return ec.fieldContext_User_pfp(ctx, field)
+			case "gender":
+				return ec.fieldContext_User_gender(ctx, field)
+			case "bio":
+				return ec.fieldContext_User_bio(ctx, field)
+			case "hobbies":
+				return ec.fieldContext_User_hobbies(ctx, field)
+			case "interests":
+				return ec.fieldContext_User_interests(ctx, field)
+			case "user_prompts":
+				return ec.fieldContext_User_user_prompts(ctx, field)
+			case "personality_traits":
+				return ec.fieldContext_User_personality_traits(ctx, field)
+			case "photos":
+				return ec.fieldContext_User_photos(ctx, field)
+			case "is_verified":
+				return ec.fieldContext_User_is_verified(ctx, field)
+			case "address":
+				return ec.fieldContext_User_address(ctx, field)
+			case "extra":
+				return ec.fieldContext_User_extra(ctx, field)
+			case "hide_online":
+				return ec.fieldContext_User_hide_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_User_last_seen_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_User_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_User_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_user(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_user,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().User(ctx, fc.Args["id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_user(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserPublic_id(ctx, field)
+			case "name":
+				return ec.fieldContext_UserPublic_name(ctx, field)
+			case "pfp":
+				return ec.fieldContext_UserPublic_pfp(ctx, field)
+			case "bio":
+				return ec.fieldContext_UserPublic_bio(ctx, field)
+			case "dob":
+				return ec.fieldContext_UserPublic_dob(ctx, field)
+			case "gender":
+				return ec.fieldContext_UserPublic_gender(ctx, field)
+			case "hobbies":
+				return ec.fieldContext_UserPublic_hobbies(ctx, field)
+			case "interests":
+				return ec.fieldContext_UserPublic_interests(ctx, field)
+			case "user_prompts":
+				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
+			case "personality_traits":
+				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
+			case "photos":
+				return ec.fieldContext_UserPublic_photos(ctx, field)
+			case "is_verified":
+				return ec.fieldContext_UserPublic_is_verified(ctx, field)
+			case "extra":
+				return ec.fieldContext_UserPublic_extra(ctx, field)
+			case "created_at":
+				return ec.fieldContext_UserPublic_created_at(ctx, field)
+			case "is_online":
+				return ec.fieldContext_UserPublic_is_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_UserPublic_last_seen_at(ctx, field)
+			case "is_locked":
+				return ec.fieldContext_UserPublic_is_locked(ctx, field)
+			case "is_poked":
+				return ec.fieldContext_UserPublic_is_poked(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_UserPublic_chat_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_user_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getUserVerificationStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_getUserVerificationStatus,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().GetUserVerificationStatus(ctx)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNUserVerification2ᚖblindlyᚋinternalᚋmodelsᚐUserVerification,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_getUserVerificationStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserVerification_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_UserVerification_user_id(ctx, field)
+			case "media":
+				return ec.fieldContext_UserVerification_media(ctx, field)
+			case "status":
+				return ec.fieldContext_UserVerification_status(ctx, field)
+			case "created_at":
+				return ec.fieldContext_UserVerification_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_UserVerification_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserVerification", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Reaction_id(ctx context.Context, field graphql.CollectedField, obj *models.Reaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Reaction_id,
+		func(ctx context.Context) (any, error) {
+			return obj.Id, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Reaction_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Reaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Reaction_sender_id(ctx context.Context, field graphql.CollectedField, obj *models.Reaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Reaction_sender_id,
+		func(ctx context.Context) (any, error) {
+			return obj.SenderId, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Reaction_sender_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Reaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Reaction_content(ctx context.Context, field graphql.CollectedField, obj *models.Reaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Reaction_content,
+		func(ctx context.Context) (any, error) {
+			return obj.Content, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Reaction_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Reaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Reaction_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Reaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Reaction_created_at,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Reaction_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Reaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReactionCount_content(ctx context.Context, field graphql.CollectedField, obj *models.ReactionCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReactionCount_content,
+		func(ctx context.Context) (any, error) {
+			return obj.Content, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReactionCount_content(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReactionCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReactionCount_count(ctx context.Context, field graphql.CollectedField, obj *models.ReactionCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ReactionCount_count,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.ReactionCount().Count(ctx, obj)
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ReactionCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReactionCount",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendationsResult_items(ctx context.Context, field graphql.CollectedField, obj *model.RecommendationsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendationsResult_items,
+		func(ctx context.Context) (any, error) {
+			return obj.Items, nil
+		},
+		nil,
+		ec.marshalNRecommendedProfile2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐRecommendedProfileᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendationsResult_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendationsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "profile":
+				return ec.fieldContext_RecommendedProfile_profile(ctx, field)
+			case "match_score":
+				return ec.fieldContext_RecommendedProfile_match_score(ctx, field)
+			case "compatibility_score":
+				return ec.fieldContext_RecommendedProfile_compatibility_score(ctx, field)
+			case "common_interests":
+				return ec.fieldContext_RecommendedProfile_common_interests(ctx, field)
+			case "distance_km":
+				return ec.fieldContext_RecommendedProfile_distance_km(ctx, field)
+			case "reason":
+				return ec.fieldContext_RecommendedProfile_reason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RecommendedProfile", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendationsResult_next_cursor(ctx context.Context, field graphql.CollectedField, obj *model.RecommendationsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendationsResult_next_cursor,
+		func(ctx context.Context) (any, error) {
+			return obj.NextCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendationsResult_next_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendationsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendationsResult_has_more(ctx context.Context, field graphql.CollectedField, obj *model.RecommendationsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendationsResult_has_more,
+		func(ctx context.Context) (any, error) {
+			return obj.HasMore, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendationsResult_has_more(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendationsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendationsResult_fetched_at(ctx context.Context, field graphql.CollectedField, obj *model.RecommendationsResult) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendationsResult_fetched_at,
+		func(ctx context.Context) (any, error) {
+			return obj.FetchedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendationsResult_fetched_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendationsResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendedProfile_profile(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendedProfile_profile,
+		func(ctx context.Context) (any, error) {
+			return obj.Profile, nil
+		},
+		nil,
+		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendedProfile_profile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendedProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserPublic_id(ctx, field)
+			case "name":
+				return ec.fieldContext_UserPublic_name(ctx, field)
+			case "pfp":
+				return ec.fieldContext_UserPublic_pfp(ctx, field)
+			case "bio":
+				return ec.fieldContext_UserPublic_bio(ctx, field)
+			case "dob":
+				return ec.fieldContext_UserPublic_dob(ctx, field)
+			case "gender":
+				return ec.fieldContext_UserPublic_gender(ctx, field)
+			case "hobbies":
+				return ec.fieldContext_UserPublic_hobbies(ctx, field)
+			case "interests":
+				return ec.fieldContext_UserPublic_interests(ctx, field)
+			case "user_prompts":
+				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
+			case "personality_traits":
+				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
+			case "photos":
+				return ec.fieldContext_UserPublic_photos(ctx, field)
+			case "is_verified":
+				return ec.fieldContext_UserPublic_is_verified(ctx, field)
+			case "extra":
+				return ec.fieldContext_UserPublic_extra(ctx, field)
+			case "created_at":
+				return ec.fieldContext_UserPublic_created_at(ctx, field)
+			case "is_online":
+				return ec.fieldContext_UserPublic_is_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_UserPublic_last_seen_at(ctx, field)
+			case "is_locked":
+				return ec.fieldContext_UserPublic_is_locked(ctx, field)
+			case "is_poked":
+				return ec.fieldContext_UserPublic_is_poked(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_UserPublic_chat_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendedProfile_match_score(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendedProfile_match_score,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchScore, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendedProfile_match_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendedProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendedProfile_compatibility_score(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendedProfile_compatibility_score,
+		func(ctx context.Context) (any, error) {
+			return obj.CompatibilityScore, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendedProfile_compatibility_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendedProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendedProfile_common_interests(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendedProfile_common_interests,
+		func(ctx context.Context) (any, error) {
+			return obj.CommonInterests, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendedProfile_common_interests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendedProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendedProfile_distance_km(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendedProfile_distance_km,
+		func(ctx context.Context) (any, error) {
+			return obj.DistanceKm, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendedProfile_distance_km(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendedProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RecommendedProfile_reason(ctx context.Context, field graphql.CollectedField, obj *model.RecommendedProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RecommendedProfile_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RecommendedProfile_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RecommendedProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_id(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Report_id,
+		func(ctx context.Context) (any, error) {
+			return obj.Id, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Report_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_user_id(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Report_user_id,
+		func(ctx context.Context) (any, error) {
+			return obj.UserId, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Report_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_target_id(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Report_target_id,
+		func(ctx context.Context) (any, error) {
+			return obj.TargetId, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Report_target_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_reason(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Report_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Report_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_additional_info(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Report_additional_info,
+		func(ctx context.Context) (any, error) {
+			return obj.AdditionalInfo, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Report_additional_info(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_media(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Report_media,
+		func(ctx context.Context) (any, error) {
+			return obj.Media, nil
+		},
+		nil,
+		ec.marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Report_media(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Media_id(ctx, field)
+			case "url":
+				return ec.fieldContext_Media_url(ctx, field)
+			case "type":
+				return ec.fieldContext_Media_type(ctx, field)
+			case "view_once":
+				return ec.fieldContext_Media_view_once(ctx, field)
+			case "opened_at":
+				return ec.fieldContext_Media_opened_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Media_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Media", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_status(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Report_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Report_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Report_created_at,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Report_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_updated_at(ctx context.Context, field graphql.CollectedField, obj *models.Report) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Report_updated_at,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Report_updated_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledMessage_id(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledMessage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScheduledMessage_id,
+		func(ctx context.Context) (any, error) {
+			return obj.Id, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScheduledMessage_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledMessage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledMessage_chat_id(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledMessage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScheduledMessage_chat_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ChatId, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScheduledMessage_chat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledMessage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledMessage_message(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledMessage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScheduledMessage_message,
+		func(ctx context.Context) (any, error) {
+			return obj.Message, nil
+		},
+		nil,
+		ec.marshalNChatMessage2blindlyᚋinternalᚋmodelsᚐMessage,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScheduledMessage_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledMessage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ChatMessage_id(ctx, field)
+			case "type":
+				return ec.fieldContext_ChatMessage_type(ctx, field)
+			case "content":
+				return ec.fieldContext_ChatMessage_content(ctx, field)
+			case "sender_id":
+				return ec.fieldContext_ChatMessage_sender_id(ctx, field)
+			case "received":
+				return ec.fieldContext_ChatMessage_received(ctx, field)
+			case "seen":
+				return ec.fieldContext_ChatMessage_seen(ctx, field)
+			case "media":
+				return ec.fieldContext_ChatMessage_media(ctx, field)
+			case "reactions":
+				return ec.fieldContext_ChatMessage_reactions(ctx, field)
+			case "reaction_counts":
+				return ec.fieldContext_ChatMessage_reaction_counts(ctx, field)
+			case "reply_to_id":
+				return ec.fieldContext_ChatMessage_reply_to_id(ctx, field)
+			case "reply_to":
+				return ec.fieldContext_ChatMessage_reply_to(ctx, field)
+			case "date_proposal":
+				return ec.fieldContext_ChatMessage_date_proposal(ctx, field)
+			case "call":
+				return ec.fieldContext_ChatMessage_call(ctx, field)
+			case "edited_at":
+				return ec.fieldContext_ChatMessage_edited_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_ChatMessage_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_ChatMessage_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChatMessage", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledMessage_deliver_at(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledMessage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScheduledMessage_deliver_at,
+		func(ctx context.Context) (any, error) {
+			return obj.DeliverAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScheduledMessage_deliver_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledMessage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledMessage_status(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledMessage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScheduledMessage_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScheduledMessage_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledMessage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScheduledMessage_created_at(ctx context.Context, field graphql.CollectedField, obj *models.ScheduledMessage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScheduledMessage_created_at,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScheduledMessage_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScheduledMessage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_chatEvents(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_chatEvents,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().ChatEvents(ctx, fc.Args["chat_id"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				return builtInDirectiveAuth(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNChatEvent2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐChatEvent,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_chatEvents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_ChatEvent_type(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_ChatEvent_chat_id(ctx, field)
+			case "message":
+				return ec.fieldContext_ChatEvent_message(ctx, field)
+			case "change":
+				return ec.fieldContext_ChatEvent_change(ctx, field)
+			case "message_ids":
+				return ec.fieldContext_ChatEvent_message_ids(ctx, field)
+			case "user_id":
+				return ec.fieldContext_ChatEvent_user_id(ctx, field)
+			case "is_typing":
+				return ec.fieldContext_ChatEvent_is_typing(ctx, field)
+			case "is_online":
+				return ec.fieldContext_ChatEvent_is_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_ChatEvent_last_seen_at(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_ChatEvent_timestamp(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChatEvent", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_chatEvents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Swipe_id(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Swipe_id,
+		func(ctx context.Context) (any, error) {
+			return obj.Id, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Swipe_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Swipe",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Swipe_user_id(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Swipe_user_id,
+		func(ctx context.Context) (any, error) {
+			return obj.UserId, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Swipe_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Swipe",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Swipe_target_id(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Swipe_target_id,
+		func(ctx context.Context) (any, error) {
+			return obj.TargetId, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Swipe_target_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Swipe",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Swipe_action_type(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Swipe_action_type,
+		func(ctx context.Context) (any, error) {
+			return obj.ActionType, nil
+		},
+		nil,
+		ec.marshalNSwipeType2blindlyᚋinternalᚋmodelsᚐSwipeType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Swipe_action_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Swipe",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SwipeType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Swipe_created_at(ctx context.Context, field graphql.CollectedField, obj *models.Swipe) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Swipe_created_at,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Swipe_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Swipe",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SwipeResponse_swipe(ctx context.Context, field graphql.CollectedField, obj *model.SwipeResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SwipeResponse_swipe,
+		func(ctx context.Context) (any, error) {
+			return obj.Swipe, nil
+		},
+		nil,
+		ec.marshalNSwipe2ᚖblindlyᚋinternalᚋmodelsᚐSwipe,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SwipeResponse_swipe(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SwipeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Swipe_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Swipe_user_id(ctx, field)
+			case "target_id":
+				return ec.fieldContext_Swipe_target_id(ctx, field)
+			case "action_type":
+				return ec.fieldContext_Swipe_action_type(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Swipe_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Swipe", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SwipeResponse_match(ctx context.Context, field graphql.CollectedField, obj *model.SwipeResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SwipeResponse_match,
+		func(ctx context.Context) (any, error) {
+			return obj.Match, nil
+		},
+		nil,
+		ec.marshalOMatch2ᚖblindlyᚋinternalᚋmodelsᚐMatch,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SwipeResponse_match(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SwipeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Match_id(ctx, field)
+			case "she_id":
+				return ec.fieldContext_Match_she_id(ctx, field)
+			case "he_id":
+				return ec.fieldContext_Match_he_id(ctx, field)
+			case "score":
+				return ec.fieldContext_Match_score(ctx, field)
+			case "post_unlock_rating":
+				return ec.fieldContext_Match_post_unlock_rating(ctx, field)
+			case "is_unlocked":
+				return ec.fieldContext_Match_is_unlocked(ctx, field)
+			case "matched_at":
+				return ec.fieldContext_Match_matched_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Match", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SwipedProfile_profile(ctx context.Context, field graphql.CollectedField, obj *model.SwipedProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SwipedProfile_profile,
+		func(ctx context.Context) (any, error) {
+			return obj.Profile, nil
+		},
+		nil,
+		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SwipedProfile_profile(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SwipedProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserPublic_id(ctx, field)
+			case "name":
+				return ec.fieldContext_UserPublic_name(ctx, field)
+			case "pfp":
+				return ec.fieldContext_UserPublic_pfp(ctx, field)
+			case "bio":
+				return ec.fieldContext_UserPublic_bio(ctx, field)
+			case "dob":
+				return ec.fieldContext_UserPublic_dob(ctx, field)
+			case "gender":
+				return ec.fieldContext_UserPublic_gender(ctx, field)
+			case "hobbies":
+				return ec.fieldContext_UserPublic_hobbies(ctx, field)
+			case "interests":
+				return ec.fieldContext_UserPublic_interests(ctx, field)
+			case "user_prompts":
+				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
+			case "personality_traits":
+				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
+			case "photos":
+				return ec.fieldContext_UserPublic_photos(ctx, field)
+			case "is_verified":
+				return ec.fieldContext_UserPublic_is_verified(ctx, field)
+			case "extra":
+				return ec.fieldContext_UserPublic_extra(ctx, field)
+			case "created_at":
+				return ec.fieldContext_UserPublic_created_at(ctx, field)
+			case "is_online":
+				return ec.fieldContext_UserPublic_is_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_UserPublic_last_seen_at(ctx, field)
+			case "is_locked":
+				return ec.fieldContext_UserPublic_is_locked(ctx, field)
+			case "is_poked":
+				return ec.fieldContext_UserPublic_is_poked(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_UserPublic_chat_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SwipedProfile_swipe(ctx context.Context, field graphql.CollectedField, obj *model.SwipedProfile) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SwipedProfile_swipe,
+		func(ctx context.Context) (any, error) {
+			return obj.Swipe, nil
+		},
+		nil,
+		ec.marshalNSwipe2ᚖblindlyᚋinternalᚋmodelsᚐSwipe,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SwipedProfile_swipe(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SwipedProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Swipe_id(ctx, field)
+			case "user_id":
+				return ec.fieldContext_Swipe_user_id(ctx, field)
+			case "target_id":
+				return ec.fieldContext_Swipe_target_id(ctx, field)
+			case "action_type":
+				return ec.fieldContext_Swipe_action_type(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Swipe_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Swipe", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrustedContact_id(ctx context.Context, field graphql.CollectedField, obj *models.TrustedContact) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrustedContact_id,
+		func(ctx context.Context) (any, error) {
+			return obj.Id, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrustedContact_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrustedContact",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrustedContact_name(ctx context.Context, field graphql.CollectedField, obj *models.TrustedContact) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrustedContact_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrustedContact_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrustedContact",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrustedContact_email(ctx context.Context, field graphql.CollectedField, obj *models.TrustedContact) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrustedContact_email,
+		func(ctx context.Context) (any, error) {
+			return obj.Email, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrustedContact_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrustedContact",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TrustedContact_created_at(ctx context.Context, field graphql.CollectedField, obj *models.TrustedContact) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TrustedContact_created_at,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TrustedContact_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TrustedContact",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_id,
+		func(ctx context.Context) (any, error) {
+			return obj.Id, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_first_name(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_first_name,
+		func(ctx context.Context) (any, error) {
+			return obj.FirstName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_first_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_last_name(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_last_name,
+		func(ctx context.Context) (any, error) {
+			return obj.LastName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_last_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_email,
+		func(ctx context.Context) (any, error) {
+			return obj.Email, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_dob(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_dob,
+		func(ctx context.Context) (any, error) {
+			return obj.Dob, nil
+		},
+		nil,
+		ec.marshalOTime2timeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_dob(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_pfp(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_pfp,
+		func(ctx context.Context) (any, error) {
+			return obj.Pfp, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_pfp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_gender(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_gender,
+		func(ctx context.Context) (any, error) {
+			return obj.Gender, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_gender(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_bio(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_bio,
+		func(ctx context.Context) (any, error) {
+			return obj.Bio, nil
+		},
+		nil,
+		ec.marshalOString2string,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_bio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_hobbies(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_hobbies,
+		func(ctx context.Context) (any, error) {
+			return obj.Hobbies, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_hobbies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_interests(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_interests,
+		func(ctx context.Context) (any, error) {
+			return obj.Interests, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_interests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_user_prompts(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_user_prompts,
+		func(ctx context.Context) (any, error) {
+			return obj.UserPrompts, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_user_prompts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_personality_traits(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_personality_traits,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.User().PersonalityTraits(ctx, obj)
+		},
+		nil,
+		ec.marshalNPersonalityTrait2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_personality_traits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_PersonalityTrait_key(ctx, field)
+			case "value":
+				return ec.fieldContext_PersonalityTrait_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PersonalityTrait", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_photos(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_photos,
+		func(ctx context.Context) (any, error) {
+			return obj.Photos, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_photos(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_is_verified(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_is_verified,
+		func(ctx context.Context) (any, error) {
+			return obj.IsVerified, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_is_verified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_address(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_address,
+		func(ctx context.Context) (any, error) {
+			return obj.Address, nil
+		},
+		nil,
+		ec.marshalOAddress2blindlyᚋinternalᚋmodelsᚐAddress,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "city":
+				return ec.fieldContext_Address_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Address_state(ctx, field)
+			case "country":
+				return ec.fieldContext_Address_country(ctx, field)
+			case "coordinates":
+				return ec.fieldContext_Address_coordinates(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_extra(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_extra,
+		func(ctx context.Context) (any, error) {
+			return obj.Extra, nil
+		},
+		nil,
+		ec.marshalOExtraMetadata2blindlyᚋinternalᚋmodelsᚐExtraMetadata,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_extra(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "school":
+				return ec.fieldContext_ExtraMetadata_school(ctx, field)
+			case "work":
+				return ec.fieldContext_ExtraMetadata_work(ctx, field)
+			case "looking_for":
+				return ec.fieldContext_ExtraMetadata_looking_for(ctx, field)
+			case "zodiac":
+				return ec.fieldContext_ExtraMetadata_zodiac(ctx, field)
+			case "languages":
+				return ec.fieldContext_ExtraMetadata_languages(ctx, field)
+			case "excercise":
+				return ec.fieldContext_ExtraMetadata_excercise(ctx, field)
+			case "drinking":
+				return ec.fieldContext_ExtraMetadata_drinking(ctx, field)
+			case "smoking":
+				return ec.fieldContext_ExtraMetadata_smoking(ctx, field)
+			case "kids":
+				return ec.fieldContext_ExtraMetadata_kids(ctx, field)
+			case "religion":
+				return ec.fieldContext_ExtraMetadata_religion(ctx, field)
+			case "ethnicity":
+				return ec.fieldContext_ExtraMetadata_ethnicity(ctx, field)
+			case "sexuality":
+				return ec.fieldContext_ExtraMetadata_sexuality(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExtraMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_hide_online(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_hide_online,
+		func(ctx context.Context) (any, error) {
+			return obj.HideOnline, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_hide_online(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_last_seen_at(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_last_seen_at,
+		func(ctx context.Context) (any, error) {
+			return obj.LastSeenAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_last_seen_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_created_at(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_created_at,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2timeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_updated_at(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_updated_at,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2timeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_updated_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserProfileActivity_id(ctx context.Context, field graphql.CollectedField, obj *models.UserProfileActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserProfileActivity_id,
+		func(ctx context.Context) (any, error) {
+			return obj.Id, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserProfileActivity_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserProfileActivity",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserProfileActivity_type(ctx context.Context, field graphql.CollectedField, obj *models.UserProfileActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserProfileActivity_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNActivityType2blindlyᚋinternalᚋmodelsᚐActivityType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserProfileActivity_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserProfileActivity",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ActivityType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserProfileActivity_target_user(ctx context.Context, field graphql.CollectedField, obj *models.UserProfileActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserProfileActivity_target_user,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.UserProfileActivity().TargetUser(ctx, obj)
+		},
+		nil,
+		ec.marshalNUserPublic2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐUserPublic,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserProfileActivity_target_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserProfileActivity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserPublic_id(ctx, field)
+			case "name":
+				return ec.fieldContext_UserPublic_name(ctx, field)
+			case "pfp":
+				return ec.fieldContext_UserPublic_pfp(ctx, field)
+			case "bio":
+				return ec.fieldContext_UserPublic_bio(ctx, field)
+			case "dob":
+				return ec.fieldContext_UserPublic_dob(ctx, field)
+			case "gender":
+				return ec.fieldContext_UserPublic_gender(ctx, field)
+			case "hobbies":
+				return ec.fieldContext_UserPublic_hobbies(ctx, field)
+			case "interests":
+				return ec.fieldContext_UserPublic_interests(ctx, field)
+			case "user_prompts":
+				return ec.fieldContext_UserPublic_user_prompts(ctx, field)
+			case "personality_traits":
+				return ec.fieldContext_UserPublic_personality_traits(ctx, field)
+			case "photos":
+				return ec.fieldContext_UserPublic_photos(ctx, field)
+			case "is_verified":
+				return ec.fieldContext_UserPublic_is_verified(ctx, field)
+			case "extra":
+				return ec.fieldContext_UserPublic_extra(ctx, field)
+			case "created_at":
+				return ec.fieldContext_UserPublic_created_at(ctx, field)
+			case "is_online":
+				return ec.fieldContext_UserPublic_is_online(ctx, field)
+			case "last_seen_at":
+				return ec.fieldContext_UserPublic_last_seen_at(ctx, field)
+			case "is_locked":
+				return ec.fieldContext_UserPublic_is_locked(ctx, field)
+			case "is_poked":
+				return ec.fieldContext_UserPublic_is_poked(ctx, field)
+			case "chat_id":
+				return ec.fieldContext_UserPublic_chat_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserPublic", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserProfileActivity_class(ctx context.Context, field graphql.CollectedField, obj *models.UserProfileActivity) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserProfileActivity_class,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.UserProfileActivity().Class(ctx, obj)
+		},
+		nil,
+		ec.marshalNActivityClass2blindlyᚋinternalᚋgraphᚋmodelᚐActivityClass,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserProfileActivity_class(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserProfileActivity",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ActivityClass does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_id(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_name(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_pfp(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_pfp,
+		func(ctx context.Context) (any, error) {
+			return obj.Pfp, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_pfp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_bio(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_bio,
+		func(ctx context.Context) (any, error) {
+			return obj.Bio, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_bio(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_dob(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_dob,
+		func(ctx context.Context) (any, error) {
+			return obj.Dob, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_dob(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_gender(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_gender,
+		func(ctx context.Context) (any, error) {
+			return obj.Gender, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_gender(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_hobbies(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_hobbies,
+		func(ctx context.Context) (any, error) {
+			return obj.Hobbies, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_hobbies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_interests(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_interests,
+		func(ctx context.Context) (any, error) {
+			return obj.Interests, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_interests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_user_prompts(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_user_prompts,
+		func(ctx context.Context) (any, error) {
+			return obj.UserPrompts, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_user_prompts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_personality_traits(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_personality_traits,
+		func(ctx context.Context) (any, error) {
+			return obj.PersonalityTraits, nil
+		},
+		nil,
+		ec.marshalNPersonalityTrait2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_personality_traits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_PersonalityTrait_key(ctx, field)
+			case "value":
+				return ec.fieldContext_PersonalityTrait_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PersonalityTrait", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_photos(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_photos,
+		func(ctx context.Context) (any, error) {
+			return obj.Photos, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_photos(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_is_verified(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_is_verified,
+		func(ctx context.Context) (any, error) {
+			return obj.IsVerified, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_is_verified(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_extra(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_extra,
+		func(ctx context.Context) (any, error) {
+			return obj.Extra, nil
+		},
+		nil,
+		ec.marshalOExtraMetadata2ᚖblindlyᚋinternalᚋmodelsᚐExtraMetadata,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_extra(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "school":
+				return ec.fieldContext_ExtraMetadata_school(ctx, field)
+			case "work":
+				return ec.fieldContext_ExtraMetadata_work(ctx, field)
+			case "looking_for":
+				return ec.fieldContext_ExtraMetadata_looking_for(ctx, field)
+			case "zodiac":
+				return ec.fieldContext_ExtraMetadata_zodiac(ctx, field)
+			case "languages":
+				return ec.fieldContext_ExtraMetadata_languages(ctx, field)
+			case "excercise":
+				return ec.fieldContext_ExtraMetadata_excercise(ctx, field)
+			case "drinking":
+				return ec.fieldContext_ExtraMetadata_drinking(ctx, field)
+			case "smoking":
+				return ec.fieldContext_ExtraMetadata_smoking(ctx, field)
+			case "kids":
+				return ec.fieldContext_ExtraMetadata_kids(ctx, field)
+			case "religion":
+				return ec.fieldContext_ExtraMetadata_religion(ctx, field)
+			case "ethnicity":
+				return ec.fieldContext_ExtraMetadata_ethnicity(ctx, field)
+			case "sexuality":
+				return ec.fieldContext_ExtraMetadata_sexuality(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ExtraMetadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_created_at(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_created_at,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_is_online(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_is_online,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOnline, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_is_online(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_last_seen_at(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_last_seen_at,
+		func(ctx context.Context) (any, error) {
+			return obj.LastSeenAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_last_seen_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_is_locked(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_is_locked,
+		func(ctx context.Context) (any, error) {
+			return obj.IsLocked, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_is_locked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_is_poked(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_is_poked,
+		func(ctx context.Context) (any, error) {
+			return obj.IsPoked, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_is_poked(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserPublic_chat_id(ctx context.Context, field graphql.CollectedField, obj *model.UserPublic) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserPublic_chat_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ChatID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserPublic_chat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserPublic",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserVerification_id(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserVerification_id,
+		func(ctx context.Context) (any, error) {
+			return obj.Id, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserVerification_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserVerification_user_id(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserVerification_user_id,
+		func(ctx context.Context) (any, error) {
+			return obj.UserId, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserVerification_user_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserVerification_media(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserVerification_media,
+		func(ctx context.Context) (any, error) {
+			return obj.Media, nil
+		},
+		nil,
+		ec.marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMediaᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserVerification_media(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Media_id(ctx, field)
+			case "url":
+				return ec.fieldContext_Media_url(ctx, field)
+			case "type":
+				return ec.fieldContext_Media_type(ctx, field)
+			case "view_once":
+				return ec.fieldContext_Media_view_once(ctx, field)
+			case "opened_at":
+				return ec.fieldContext_Media_opened_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_Media_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Media", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserVerification_status(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserVerification_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserVerification_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserVerification_created_at(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserVerification_created_at,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserVerification_created_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserVerification_updated_at(ctx context.Context, field graphql.CollectedField, obj *models.UserVerification) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserVerification_updated_at,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserVerification_updated_at(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserVerification",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAddressInput(ctx context.Context, obj any) (model.AddressInput, error) {
+	var it model.AddressInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"city", "state", "country"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "city":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("city"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.City = data
+		case "state":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.State = data
+		case "country":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("country"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Country = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputChatMediaInput(ctx context.Context, obj any) (model.ChatMediaInput, error) {
+	var it model.ChatMediaInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"type", "url", "file_id", "view_once"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNMediaType2blindlyᚋinternalᚋgraphᚋmodelᚐMediaType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.URL = data
+		case "file_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("file_id"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FileID = data
+		case "view_once":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("view_once"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ViewOnce = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCommentFilterInput(ctx context.Context, obj any) (model.CommentFilterInput, error) {
+	var it model.CommentFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"post_id", "user_id", "reply_to_id", "parent_only", "search_content", "created_after", "created_before", "min_likes"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "post_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("post_id"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PostID = data
+		case "user_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("user_id"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserID = data
+		case "reply_to_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reply_to_id"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReplyToID = data
+		case "parent_only":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("parent_only"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ParentOnly = data
+		case "search_content":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search_content"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SearchContent = data
+		case "created_after":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_after"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedAfter = data
+		case "created_before":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_before"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedBefore = data
+		case "min_likes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min_likes"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinLikes = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputConnectionSettingsInput(ctx context.Context, obj any) (model.ConnectionSettingsInput, error) {
+	var it model.ConnectionSettingsInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"pinned", "archived", "muted_until", "unmute", "nickname"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "pinned":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pinned"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pinned = data
+		case "archived":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("archived"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Archived = data
+		case "muted_until":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("muted_until"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MutedUntil = data
+		case "unmute":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unmute"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Unmute = data
+		case "nickname":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nickname"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Nickname = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateCommentInput(ctx context.Context, obj any) (model.CreateCommentInput, error) {
+	var it model.CreateCommentInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"post_id", "reply_to_id", "content"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "post_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("post_id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PostID = data
+		case "reply_to_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reply_to_id"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReplyToID = data
+		case "content":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Content = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreatePostInput(ctx context.Context, obj any) (model.CreatePostInput, error) {
+	var it model.CreatePostInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"content", "media"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "content":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Content = data
+		case "media":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("media"))
+			data, err := ec.unmarshalOMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Media = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateReportInput(ctx context.Context, obj any) (model.CreateReportInput, error) {
+	var it model.CreateReportInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"target_id", "reason", "additional_info", "media"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "target_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("target_id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.TargetID = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		case "additional_info":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("additional_info"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AdditionalInfo = data
+		case "media":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("media"))
+			data, err := ec.unmarshalNMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Media = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateUserInput(ctx context.Context, obj any) (model.CreateUserInput, error) {
+	var it model.CreateUserInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"email", "password", "first_name", "last_name", "dob", "gender", "pfp", "bio", "hobbies", "interests", "user_prompts", "photos", "address"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "password":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Password = data
+		case "first_name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first_name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FirstName = data
+		case "last_name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last_name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LastName = data
+		case "dob":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dob"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Dob = data
+		case "gender":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gender"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Gender = data
+		case "pfp":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pfp"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pfp = data
+		case "bio":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bio"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Bio = data
+		case "hobbies":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hobbies"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Hobbies = data
+		case "interests":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("interests"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Interests = data
+		case "user_prompts":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("user_prompts"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserPrompts = data
+		case "photos":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("photos"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Photos = data
+		case "address":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+			data, err := ec.unmarshalOAddressInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAddressInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Address = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDateProposalInput(ctx context.Context, obj any) (model.DateProposalInput, error) {
+	var it model.DateProposalInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"starts_at", "venue", "note"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "starts_at":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("starts_at"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StartsAt = data
+		case "venue":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("venue"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Venue = data
+		case "note":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("note"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Note = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputMediaInput(ctx context.Context, obj any) (model.MediaInput, error) {
+	var it model.MediaInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"id", "url", "type", "created_at"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.PostID = data
-		case "reply_to_id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reply_to_id"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			it.ID = data
+		case "url":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.ReplyToID = data
-		case "content":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
+			it.URL = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNMediaType2blindlyᚋinternalᚋgraphᚋmodelᚐMediaType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "created_at":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_at"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Content = data
+			it.CreatedAt = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCreatePostInput(ctx context.Context, obj any) (model.CreatePostInput, error) {
-	var it model.CreatePostInput
+func (ec *executionContext) unmarshalInputPersonalityTraitInput(ctx context.Context, obj any) (model.PersonalityTraitInput, error) {
+	var it model.PersonalityTraitInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"content", "media"}
+	fieldsInOrder := [...]string{"key", "value"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Key = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPostFilterInput(ctx context.Context, obj any) (model.PostFilterInput, error) {
+	var it model.PostFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"user_id", "search_content", "created_after", "created_before", "min_likes", "min_comments", "min_views", "has_media"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "user_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("user_id"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserID = data
+		case "search_content":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search_content"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SearchContent = data
+		case "created_after":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_after"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedAfter = data
+		case "created_before":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_before"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CreatedBefore = data
+		case "min_likes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min_likes"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinLikes = data
+		case "min_comments":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min_comments"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinComments = data
+		case "min_views":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min_views"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinViews = data
+		case "has_media":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("has_media"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HasMedia = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSendMessageInput(ctx context.Context, obj any) (model.SendMessageInput, error) {
+	var it model.SendMessageInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"chat_id", "type", "content", "media", "reply_to_id", "date_proposal"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
+		case "chat_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chat_id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ChatID = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNMessageType2blindlyᚋinternalᚋmodelsᚐMessageType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
 		case "content":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
 			data, err := ec.unmarshalNString2string(ctx, v)
@@ -10622,55 +17795,130 @@ func (ec *executionContext) unmarshalInputCreatePostInput(ctx context.Context, o
 			it.Content = data
 		case "media":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("media"))
-			data, err := ec.unmarshalOMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, v)
+			data, err := ec.unmarshalOChatMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐChatMediaInputᚄ(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Media = data
+		case "reply_to_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reply_to_id"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReplyToID = data
+		case "date_proposal":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("date_proposal"))
+			data, err := ec.unmarshalODateProposalInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐDateProposalInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DateProposal = data
 		}
 	}
 
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateReportInput(ctx context.Context, obj any) (model.CreateReportInput, error) {
-	var it model.CreateReportInput
+func (ec *executionContext) unmarshalInputSortInput(ctx context.Context, obj any) (model.SortInput, error) {
+	var it model.SortInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"target_id", "reason", "additional_info", "media"}
+	fieldsInOrder := [...]string{"field", "order"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "order":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+			data, err := ec.unmarshalNSortOrder2blindlyᚋinternalᚋgraphᚋmodelᚐSortOrder(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Order = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateCommentInput(ctx context.Context, obj any) (model.UpdateCommentInput, error) {
+	var it model.UpdateCommentInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"comment_id", "content"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "comment_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("comment_id"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.CommentID = data
+		case "content":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Content = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdatePostInput(ctx context.Context, obj any) (model.UpdatePostInput, error) {
+	var it model.UpdatePostInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"post_id", "content", "media"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "target_id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("target_id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.TargetID = data
-		case "reason":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+		case "post_id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("post_id"))
 			data, err := ec.unmarshalNString2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Reason = data
-		case "additional_info":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("additional_info"))
+			it.PostID = data
+		case "content":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.AdditionalInfo = data
+			it.Content = data
 		case "media":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("media"))
-			data, err := ec.unmarshalNMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, v)
+			data, err := ec.unmarshalOMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -10681,62 +17929,41 @@ func (ec *executionContext) unmarshalInputCreateReportInput(ctx context.Context,
 	return it, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateUserInput(ctx context.Context, obj any) (model.CreateUserInput, error) {
-	var it model.CreateUserInput
+func (ec *executionContext) unmarshalInputUpdateUserInput(ctx context.Context, obj any) (model.UpdateUserInput, error) {
+	var it model.UpdateUserInput
 	asMap := map[string]any{}
 	for k, v := range obj.(map[string]any) {
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"email", "password", "first_name", "last_name", "dob", "gender", "pfp", "bio", "hobbies", "interests", "user_prompts", "photos", "address"}
+	fieldsInOrder := [...]string{"first_name", "last_name", "dob", "pfp", "bio", "gender", "hobbies", "interests", "user_prompts", "personality_traits", "photos", "is_verified", "address", "hide_online"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
 		switch k {
-		case "email":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Email = data
-		case "password":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Password = data
 		case "first_name":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first_name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.FirstName = data
 		case "last_name":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last_name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.LastName = data
 		case "dob":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dob"))
-			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.Dob = data
-		case "gender":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gender"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Gender = data
 		case "pfp":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pfp"))
 			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
@@ -10751,6 +17978,13 @@ func (ec *executionContext) unmarshalInputCreateUserInput(ctx context.Context, o
 				return it, err
 			}
 			it.Bio = data
+		case "gender":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gender"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Gender = data
 		case "hobbies":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hobbies"))
 			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
@@ -10772,6 +18006,13 @@ func (ec *executionContext) unmarshalInputCreateUserInput(ctx context.Context, o
 				return it, err
 			}
 			it.UserPrompts = data
+		case "personality_traits":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("personality_traits"))
+			data, err := ec.unmarshalOPersonalityTraitInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PersonalityTraits = data
 		case "photos":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("photos"))
 			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
@@ -10779,451 +18020,669 @@ func (ec *executionContext) unmarshalInputCreateUserInput(ctx context.Context, o
 				return it, err
 			}
 			it.Photos = data
+		case "is_verified":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("is_verified"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsVerified = data
 		case "address":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
 			data, err := ec.unmarshalOAddressInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAddressInput(ctx, v)
 			if err != nil {
 				return it, err
 			}
-			it.Address = data
+			it.Address = data
+		case "hide_online":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hide_online"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HideOnline = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUserVerificationInput(ctx context.Context, obj any) (model.UserVerificationInput, error) {
+	var it model.UserVerificationInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"media"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "media":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("media"))
+			data, err := ec.unmarshalNMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Media = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var addressImplementors = []string{"Address"}
+
+func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *models.Address) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, addressImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Address")
+		case "city":
+			out.Values[i] = ec._Address_city(ctx, field, obj)
+		case "state":
+			out.Values[i] = ec._Address_state(ctx, field, obj)
+		case "country":
+			out.Values[i] = ec._Address_country(ctx, field, obj)
+		case "coordinates":
+			out.Values[i] = ec._Address_coordinates(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var authPayloadImplementors = []string{"AuthPayload"}
+
+func (ec *executionContext) _AuthPayload(ctx context.Context, sel ast.SelectionSet, obj *model.AuthPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, authPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuthPayload")
+		case "access_token":
+			out.Values[i] = ec._AuthPayload_access_token(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "user":
+			out.Values[i] = ec._AuthPayload_user(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var callLogImplementors = []string{"CallLog"}
+
+func (ec *executionContext) _CallLog(ctx context.Context, sel ast.SelectionSet, obj *models.CallLog) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, callLogImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CallLog")
+		case "call_id":
+			out.Values[i] = ec._CallLog_call_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "media":
+			out.Values[i] = ec._CallLog_media(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._CallLog_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "started_at":
+			out.Values[i] = ec._CallLog_started_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "answered_at":
+			out.Values[i] = ec._CallLog_answered_at(ctx, field, obj)
+		case "ended_at":
+			out.Values[i] = ec._CallLog_ended_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "duration_sec":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._CallLog_duration_sec(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "ended_by":
+			out.Values[i] = ec._CallLog_ended_by(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputMediaInput(ctx context.Context, obj any) (model.MediaInput, error) {
-	var it model.MediaInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"id", "url", "type", "created_at"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
+	return out
+}
+
+var chatImplementors = []string{"Chat"}
+
+func (ec *executionContext) _Chat(ctx context.Context, sel ast.SelectionSet, obj *models.Chat) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chatImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Chat")
 		case "id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ID = data
-		case "url":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._Chat_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.URL = data
-		case "type":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
-			data, err := ec.unmarshalNMediaType2blindlyᚋinternalᚋgraphᚋmodelᚐMediaType(ctx, v)
-			if err != nil {
-				return it, err
+		case "match_id":
+			out.Values[i] = ec._Chat_match_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Type = data
 		case "created_at":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_at"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._Chat_created_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.CreatedAt = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputPersonalityTraitInput(ctx context.Context, obj any) (model.PersonalityTraitInput, error) {
-	var it model.PersonalityTraitInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	fieldsInOrder := [...]string{"key", "value"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "key":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Key = data
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalNInt2int32(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Value = data
-		}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputPostFilterInput(ctx context.Context, obj any) (model.PostFilterInput, error) {
-	var it model.PostFilterInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+var chatEventImplementors = []string{"ChatEvent"}
 
-	fieldsInOrder := [...]string{"user_id", "search_content", "created_after", "created_before", "min_likes", "min_comments", "min_views", "has_media"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "user_id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("user_id"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.UserID = data
-		case "search_content":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search_content"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.SearchContent = data
-		case "created_after":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_after"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CreatedAfter = data
-		case "created_before":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("created_before"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.CreatedBefore = data
-		case "min_likes":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min_likes"))
-			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.MinLikes = data
-		case "min_comments":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min_comments"))
-			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _ChatEvent(ctx context.Context, sel ast.SelectionSet, obj *model.ChatEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chatEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChatEvent")
+		case "type":
+			out.Values[i] = ec._ChatEvent_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.MinComments = data
-		case "min_views":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min_views"))
-			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
-			if err != nil {
-				return it, err
+		case "chat_id":
+			out.Values[i] = ec._ChatEvent_chat_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.MinViews = data
-		case "has_media":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("has_media"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+		case "message":
+			out.Values[i] = ec._ChatEvent_message(ctx, field, obj)
+		case "change":
+			out.Values[i] = ec._ChatEvent_change(ctx, field, obj)
+		case "message_ids":
+			out.Values[i] = ec._ChatEvent_message_ids(ctx, field, obj)
+		case "user_id":
+			out.Values[i] = ec._ChatEvent_user_id(ctx, field, obj)
+		case "is_typing":
+			out.Values[i] = ec._ChatEvent_is_typing(ctx, field, obj)
+		case "is_online":
+			out.Values[i] = ec._ChatEvent_is_online(ctx, field, obj)
+		case "last_seen_at":
+			out.Values[i] = ec._ChatEvent_last_seen_at(ctx, field, obj)
+		case "timestamp":
+			out.Values[i] = ec._ChatEvent_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.HasMedia = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputSortInput(ctx context.Context, obj any) (model.SortInput, error) {
-	var it model.SortInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	fieldsInOrder := [...]string{"field", "order"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "field":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Field = data
-		case "order":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
-			data, err := ec.unmarshalNSortOrder2blindlyᚋinternalᚋgraphᚋmodelᚐSortOrder(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Order = data
-		}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputUpdateCommentInput(ctx context.Context, obj any) (model.UpdateCommentInput, error) {
-	var it model.UpdateCommentInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+var chatExportImplementors = []string{"ChatExport"}
 
-	fieldsInOrder := [...]string{"comment_id", "content"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "comment_id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("comment_id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _ChatExport(ctx context.Context, sel ast.SelectionSet, obj *models.ChatExport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chatExportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChatExport")
+		case "id":
+			out.Values[i] = ec._ChatExport_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.CommentID = data
-		case "content":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "chat_id":
+			out.Values[i] = ec._ChatExport_chat_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.Content = data
-		}
-	}
+		case "format":
+			out.Values[i] = ec._ChatExport_format(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._ChatExport_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "error":
+			out.Values[i] = ec._ChatExport_error(ctx, field, obj)
+		case "download_url":
+			field := field
 
-	return it, nil
-}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ChatExport_download_url(ctx, field, obj)
+				return res
+			}
 
-func (ec *executionContext) unmarshalInputUpdatePostInput(ctx context.Context, obj any) (model.UpdatePostInput, error) {
-	var it model.UpdatePostInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-	fieldsInOrder := [...]string{"post_id", "content", "media"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "post_id":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("post_id"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			it.PostID = data
-		case "content":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("content"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "created_at":
+			out.Values[i] = ec._ChatExport_created_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.Content = data
-		case "media":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("media"))
-			data, err := ec.unmarshalOMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "updated_at":
+			out.Values[i] = ec._ChatExport_updated_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
 			}
-			it.Media = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputUpdateUserInput(ctx context.Context, obj any) (model.UpdateUserInput, error) {
-	var it model.UpdateUserInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"first_name", "last_name", "dob", "pfp", "bio", "gender", "hobbies", "interests", "user_prompts", "personality_traits", "photos", "is_verified", "address"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "first_name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first_name"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.FirstName = data
-		case "last_name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last_name"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.LastName = data
-		case "dob":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dob"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Dob = data
-		case "pfp":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pfp"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Pfp = data
-		case "bio":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bio"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Bio = data
-		case "gender":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gender"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Gender = data
-		case "hobbies":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hobbies"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var chatMessageImplementors = []string{"ChatMessage"}
+
+func (ec *executionContext) _ChatMessage(ctx context.Context, sel ast.SelectionSet, obj *models.Message) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chatMessageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChatMessage")
+		case "id":
+			out.Values[i] = ec._ChatMessage_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Hobbies = data
-		case "interests":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("interests"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "type":
+			out.Values[i] = ec._ChatMessage_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Interests = data
-		case "user_prompts":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("user_prompts"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "content":
+			out.Values[i] = ec._ChatMessage_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.UserPrompts = data
-		case "personality_traits":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("personality_traits"))
-			data, err := ec.unmarshalOPersonalityTraitInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "sender_id":
+			out.Values[i] = ec._ChatMessage_sender_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PersonalityTraits = data
-		case "photos":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("photos"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "received":
+			out.Values[i] = ec._ChatMessage_received(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Photos = data
-		case "is_verified":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("is_verified"))
-			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+		case "seen":
+			out.Values[i] = ec._ChatMessage_seen(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.IsVerified = data
-		case "address":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
-			data, err := ec.unmarshalOAddressInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAddressInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "media":
+			out.Values[i] = ec._ChatMessage_media(ctx, field, obj)
+		case "reactions":
+			out.Values[i] = ec._ChatMessage_reactions(ctx, field, obj)
+		case "reaction_counts":
+			out.Values[i] = ec._ChatMessage_reaction_counts(ctx, field, obj)
+		case "reply_to_id":
+			out.Values[i] = ec._ChatMessage_reply_to_id(ctx, field, obj)
+		case "reply_to":
+			out.Values[i] = ec._ChatMessage_reply_to(ctx, field, obj)
+		case "date_proposal":
+			out.Values[i] = ec._ChatMessage_date_proposal(ctx, field, obj)
+		case "call":
+			out.Values[i] = ec._ChatMessage_call(ctx, field, obj)
+		case "edited_at":
+			out.Values[i] = ec._ChatMessage_edited_at(ctx, field, obj)
+		case "created_at":
+			out.Values[i] = ec._ChatMessage_created_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Address = data
+		case "updated_at":
+			out.Values[i] = ec._ChatMessage_updated_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputUserVerificationInput(ctx context.Context, obj any) (model.UserVerificationInput, error) {
-	var it model.UserVerificationInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	fieldsInOrder := [...]string{"media"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "media":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("media"))
-			data, err := ec.unmarshalNMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Media = data
-		}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	return it, nil
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
-
-// endregion ************************** interface.gotpl ***************************
-
-// region    **************************** object.gotpl ****************************
-
-var addressImplementors = []string{"Address"}
+var commentImplementors = []string{"Comment"}
 
-func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet, obj *models.Address) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, addressImplementors)
+func (ec *executionContext) _Comment(ctx context.Context, sel ast.SelectionSet, obj *models.Comment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, commentImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Address")
-		case "city":
-			out.Values[i] = ec._Address_city(ctx, field, obj)
-		case "state":
-			out.Values[i] = ec._Address_state(ctx, field, obj)
-		case "country":
-			out.Values[i] = ec._Address_country(ctx, field, obj)
-		case "coordinates":
-			out.Values[i] = ec._Address_coordinates(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Comment")
+		case "id":
+			out.Values[i] = ec._Comment_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "post_id":
+			out.Values[i] = ec._Comment_post_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "user_id":
+			out.Values[i] = ec._Comment_user_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "reply_to_id":
+			out.Values[i] = ec._Comment_reply_to_id(ctx, field, obj)
+		case "created_at":
+			out.Values[i] = ec._Comment_created_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "content":
+			out.Values[i] = ec._Comment_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "likes":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Comment_likes(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "user":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Comment_user(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "is_liked":
+			out.Values[i] = ec._Comment_is_liked(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11247,24 +18706,29 @@ func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var authPayloadImplementors = []string{"AuthPayload"}
+var commentsConnectionImplementors = []string{"CommentsConnection"}
 
-func (ec *executionContext) _AuthPayload(ctx context.Context, sel ast.SelectionSet, obj *model.AuthPayload) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, authPayloadImplementors)
+func (ec *executionContext) _CommentsConnection(ctx context.Context, sel ast.SelectionSet, obj *model.CommentsConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, commentsConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AuthPayload")
-		case "access_token":
-			out.Values[i] = ec._AuthPayload_access_token(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("CommentsConnection")
+		case "comments":
+			out.Values[i] = ec._CommentsConnection_comments(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "user":
-			out.Values[i] = ec._AuthPayload_user(ctx, field, obj)
+		case "page_info":
+			out.Values[i] = ec._CommentsConnection_page_info(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total_count":
+			out.Values[i] = ec._CommentsConnection_total_count(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -11291,29 +18755,59 @@ func (ec *executionContext) _AuthPayload(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var chatImplementors = []string{"Chat"}
+var connectionImplementors = []string{"Connection"}
 
-func (ec *executionContext) _Chat(ctx context.Context, sel ast.SelectionSet, obj *models.Chat) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, chatImplementors)
+func (ec *executionContext) _Connection(ctx context.Context, sel ast.SelectionSet, obj *model.Connection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, connectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Chat")
-		case "id":
-			out.Values[i] = ec._Chat_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Connection")
+		case "chat":
+			out.Values[i] = ec._Connection_chat(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "match_id":
-			out.Values[i] = ec._Chat_match_id(ctx, field, obj)
+		case "match":
+			out.Values[i] = ec._Connection_match(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "created_at":
-			out.Values[i] = ec._Chat_created_at(ctx, field, obj)
+		case "last_message":
+			out.Values[i] = ec._Connection_last_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unread_messages":
+			out.Values[i] = ec._Connection_unread_messages(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "percentage_complete":
+			out.Values[i] = ec._Connection_percentage_complete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "connection_profile":
+			out.Values[i] = ec._Connection_connection_profile(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "settings":
+			out.Values[i] = ec._Connection_settings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "last_activity_at":
+			out.Values[i] = ec._Connection_last_activity_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cursor":
+			out.Values[i] = ec._Connection_cursor(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -11340,81 +18834,35 @@ func (ec *executionContext) _Chat(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
-var commentImplementors = []string{"Comment"}
+var connectionSettingsImplementors = []string{"ConnectionSettings"}
 
-func (ec *executionContext) _Comment(ctx context.Context, sel ast.SelectionSet, obj *models.Comment) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, commentImplementors)
+func (ec *executionContext) _ConnectionSettings(ctx context.Context, sel ast.SelectionSet, obj *models.ConnectionSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, connectionSettingsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Comment")
-		case "id":
-			out.Values[i] = ec._Comment_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "post_id":
-			out.Values[i] = ec._Comment_post_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "user_id":
-			out.Values[i] = ec._Comment_user_id(ctx, field, obj)
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConnectionSettings")
+		case "match_id":
+			out.Values[i] = ec._ConnectionSettings_match_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "reply_to_id":
-			out.Values[i] = ec._Comment_reply_to_id(ctx, field, obj)
-		case "created_at":
-			out.Values[i] = ec._Comment_created_at(ctx, field, obj)
+		case "pinned":
+			out.Values[i] = ec._ConnectionSettings_pinned(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "content":
-			out.Values[i] = ec._Comment_content(ctx, field, obj)
+		case "archived":
+			out.Values[i] = ec._ConnectionSettings_archived(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "likes":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Comment_likes(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "user":
+		case "muted_until":
+			out.Values[i] = ec._ConnectionSettings_muted_until(ctx, field, obj)
+		case "is_muted":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -11423,7 +18871,7 @@ func (ec *executionContext) _Comment(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Comment_user(ctx, field, obj)
+				res = ec._ConnectionSettings_is_muted(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -11450,8 +18898,8 @@ func (ec *executionContext) _Comment(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "is_liked":
-			out.Values[i] = ec._Comment_is_liked(ctx, field, obj)
+		case "nickname":
+			out.Values[i] = ec._ConnectionSettings_nickname(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
@@ -11478,29 +18926,43 @@ func (ec *executionContext) _Comment(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var commentsConnectionImplementors = []string{"CommentsConnection"}
+var dateCheckInImplementors = []string{"DateCheckIn"}
 
-func (ec *executionContext) _CommentsConnection(ctx context.Context, sel ast.SelectionSet, obj *model.CommentsConnection) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, commentsConnectionImplementors)
+func (ec *executionContext) _DateCheckIn(ctx context.Context, sel ast.SelectionSet, obj *models.DateCheckIn) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dateCheckInImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CommentsConnection")
-		case "comments":
-			out.Values[i] = ec._CommentsConnection_comments(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("DateCheckIn")
+		case "id":
+			out.Values[i] = ec._DateCheckIn_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "page_info":
-			out.Values[i] = ec._CommentsConnection_page_info(ctx, field, obj)
+		case "date_id":
+			out.Values[i] = ec._DateCheckIn_date_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "total_count":
-			out.Values[i] = ec._CommentsConnection_total_count(ctx, field, obj)
+		case "check_in_at":
+			out.Values[i] = ec._DateCheckIn_check_in_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._DateCheckIn_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "checked_in_at":
+			out.Values[i] = ec._DateCheckIn_checked_in_at(ctx, field, obj)
+		case "alerted_at":
+			out.Values[i] = ec._DateCheckIn_alerted_at(ctx, field, obj)
+		case "created_at":
+			out.Values[i] = ec._DateCheckIn_created_at(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -11527,47 +18989,83 @@ func (ec *executionContext) _CommentsConnection(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var connectionImplementors = []string{"Connection"}
+var dateProposalImplementors = []string{"DateProposal"}
 
-func (ec *executionContext) _Connection(ctx context.Context, sel ast.SelectionSet, obj *model.Connection) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, connectionImplementors)
+func (ec *executionContext) _DateProposal(ctx context.Context, sel ast.SelectionSet, obj *models.DateProposal) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dateProposalImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Connection")
-		case "chat":
-			out.Values[i] = ec._Connection_chat(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "match":
-			out.Values[i] = ec._Connection_match(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "last_message":
-			out.Values[i] = ec._Connection_last_message(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("DateProposal")
+		case "starts_at":
+			out.Values[i] = ec._DateProposal_starts_at(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "unread_messages":
-			out.Values[i] = ec._Connection_unread_messages(ctx, field, obj)
+		case "venue":
+			out.Values[i] = ec._DateProposal_venue(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "percentage_complete":
-			out.Values[i] = ec._Connection_percentage_complete(ctx, field, obj)
+		case "note":
+			out.Values[i] = ec._DateProposal_note(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._DateProposal_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "connection_profile":
-			out.Values[i] = ec._Connection_connection_profile(ctx, field, obj)
+		case "responded_by":
+			out.Values[i] = ec._DateProposal_responded_by(ctx, field, obj)
+		case "responded_at":
+			out.Values[i] = ec._DateProposal_responded_at(ctx, field, obj)
+		case "counter_id":
+			out.Values[i] = ec._DateProposal_counter_id(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dateProposalResponseImplementors = []string{"DateProposalResponse"}
+
+func (ec *executionContext) _DateProposalResponse(ctx context.Context, sel ast.SelectionSet, obj *chatservice.DateResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dateProposalResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DateProposalResponse")
+		case "proposal":
+			out.Values[i] = ec._DateProposalResponse_proposal(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "counter":
+			out.Values[i] = ec._DateProposalResponse_counter(ctx, field, obj)
+		case "date":
+			out.Values[i] = ec._DateProposalResponse_date(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -11715,22 +19213,168 @@ func (ec *executionContext) _Match(ctx context.Context, sel ast.SelectionSet, ob
 				out.Values[i] = graphql.Null
 				continue
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "post_unlock_rating":
-			out.Values[i] = ec._Match_post_unlock_rating(ctx, field, obj)
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "post_unlock_rating":
+			out.Values[i] = ec._Match_post_unlock_rating(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "is_unlocked":
+			out.Values[i] = ec._Match_is_unlocked(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "matched_at":
+			out.Values[i] = ec._Match_matched_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mediaImplementors = []string{"Media"}
+
+func (ec *executionContext) _Media(ctx context.Context, sel ast.SelectionSet, obj *models.Media) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mediaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Media")
+		case "id":
+			out.Values[i] = ec._Media_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "url":
+			out.Values[i] = ec._Media_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "type":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Media_type(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "view_once":
+			out.Values[i] = ec._Media_view_once(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "opened_at":
+			out.Values[i] = ec._Media_opened_at(ctx, field, obj)
+		case "created_at":
+			out.Values[i] = ec._Media_created_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mediaGrantImplementors = []string{"MediaGrant"}
+
+func (ec *executionContext) _MediaGrant(ctx context.Context, sel ast.SelectionSet, obj *chatservice.MediaGrant) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mediaGrantImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MediaGrant")
+		case "message_id":
+			out.Values[i] = ec._MediaGrant_message_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "media_id":
+			out.Values[i] = ec._MediaGrant_media_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "is_unlocked":
-			out.Values[i] = ec._Match_is_unlocked(ctx, field, obj)
+		case "url":
+			out.Values[i] = ec._MediaGrant_url(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "matched_at":
-			out.Values[i] = ec._Match_matched_at(ctx, field, obj)
+		case "expires_at":
+			out.Values[i] = ec._MediaGrant_expires_at(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -11755,67 +19399,102 @@ func (ec *executionContext) _Match(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var mediaImplementors = []string{"Media"}
+var messagePreviewImplementors = []string{"MessagePreview"}
 
-func (ec *executionContext) _Media(ctx context.Context, sel ast.SelectionSet, obj *models.Media) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mediaImplementors)
+func (ec *executionContext) _MessagePreview(ctx context.Context, sel ast.SelectionSet, obj *models.MessagePreview) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, messagePreviewImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Media")
+			out.Values[i] = graphql.MarshalString("MessagePreview")
 		case "id":
-			out.Values[i] = ec._Media_id(ctx, field, obj)
+			out.Values[i] = ec._MessagePreview_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "url":
-			out.Values[i] = ec._Media_url(ctx, field, obj)
+		case "sender_id":
+			out.Values[i] = ec._MessagePreview_sender_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
+			}
+		case "content":
+			out.Values[i] = ec._MessagePreview_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
 		case "type":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Media_type(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+			out.Values[i] = ec._MessagePreview_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	return out
+}
+
+var messageRevisionImplementors = []string{"MessageRevision"}
+
+func (ec *executionContext) _MessageRevision(ctx context.Context, sel ast.SelectionSet, obj *models.MessageRevision) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, messageRevisionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MessageRevision")
+		case "id":
+			out.Values[i] = ec._MessageRevision_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message_id":
+			out.Values[i] = ec._MessageRevision_message_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sender_id":
+			out.Values[i] = ec._MessageRevision_sender_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "content":
+			out.Values[i] = ec._MessageRevision_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "media":
+			out.Values[i] = ec._MessageRevision_media(ctx, field, obj)
 		case "created_at":
-			out.Values[i] = ec._Media_created_at(ctx, field, obj)
+			out.Values[i] = ec._MessageRevision_created_at(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
+			}
+		case "replaced_at":
+			out.Values[i] = ec._MessageRevision_replaced_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -11859,6 +19538,118 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Mutation")
+		case "sendMessage":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_sendMessage(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "markSeen":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_markSeen(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "react":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_react(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unreact":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_unreact(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "openViewOnce":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_openViewOnce(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "scheduleMessage":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_scheduleMessage(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateConnectionSettings":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateConnectionSettings(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cancelScheduledMessage":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelScheduledMessage(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "respondToDateProposal":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_respondToDateProposal(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addTrustedContact":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addTrustedContact(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removeTrustedContact":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removeTrustedContact(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shareDate":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_shareDate(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "scheduleCheckIn":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_scheduleCheckIn(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "checkIn":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_checkIn(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cancelCheckIn":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelCheckIn(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "exportChat":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_exportChat(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "create_post":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
 				return ec._Mutation_create_post(ctx, field)
@@ -12079,8 +19870,92 @@ func (ec *executionContext) _PersonalityTrait(ctx context.Context, sel ast.Selec
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "value":
-			out.Values[i] = ec._PersonalityTrait_value(ctx, field, obj)
+		case "value":
+			out.Values[i] = ec._PersonalityTrait_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var plannedDateImplementors = []string{"PlannedDate"}
+
+func (ec *executionContext) _PlannedDate(ctx context.Context, sel ast.SelectionSet, obj *models.PlannedDate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, plannedDateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlannedDate")
+		case "id":
+			out.Values[i] = ec._PlannedDate_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "match_id":
+			out.Values[i] = ec._PlannedDate_match_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "chat_id":
+			out.Values[i] = ec._PlannedDate_chat_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message_id":
+			out.Values[i] = ec._PlannedDate_message_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "proposed_by":
+			out.Values[i] = ec._PlannedDate_proposed_by(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "accepted_by":
+			out.Values[i] = ec._PlannedDate_accepted_by(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "starts_at":
+			out.Values[i] = ec._PlannedDate_starts_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "venue":
+			out.Values[i] = ec._PlannedDate_venue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "note":
+			out.Values[i] = ec._PlannedDate_note(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "created_at":
+			out.Values[i] = ec._PlannedDate_created_at(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -12510,6 +20385,160 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getUnreadBadge":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getUnreadBadge(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "scheduledMessages":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_scheduledMessages(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "messageRevisions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_messageRevisions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "plannedDates":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_plannedDates(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "trustedContacts":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_trustedContacts(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "dateCheckIns":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_dateCheckIns(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "chatExports":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_chatExports(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "get_posts":
 			field := field
@@ -12742,9 +20771,132 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				return ec.OperationContext.RootResolverMiddleware(ctx,
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getUserVerificationStatus":
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getUserVerificationStatus":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getUserVerificationStatus(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var reactionImplementors = []string{"Reaction"}
+
+func (ec *executionContext) _Reaction(ctx context.Context, sel ast.SelectionSet, obj *models.Reaction) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reactionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Reaction")
+		case "id":
+			out.Values[i] = ec._Reaction_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sender_id":
+			out.Values[i] = ec._Reaction_sender_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "content":
+			out.Values[i] = ec._Reaction_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "created_at":
+			out.Values[i] = ec._Reaction_created_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var reactionCountImplementors = []string{"ReactionCount"}
+
+func (ec *executionContext) _ReactionCount(ctx context.Context, sel ast.SelectionSet, obj *models.ReactionCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, reactionCountImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ReactionCount")
+		case "content":
+			out.Values[i] = ec._ReactionCount_content(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "count":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -12753,27 +20905,33 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_getUserVerificationStatus(ctx, field)
+				res = ec._ReactionCount_count(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
 				return res
 			}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -12985,6 +21143,90 @@ func (ec *executionContext) _Report(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
+var scheduledMessageImplementors = []string{"ScheduledMessage"}
+
+func (ec *executionContext) _ScheduledMessage(ctx context.Context, sel ast.SelectionSet, obj *models.ScheduledMessage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, scheduledMessageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ScheduledMessage")
+		case "id":
+			out.Values[i] = ec._ScheduledMessage_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "chat_id":
+			out.Values[i] = ec._ScheduledMessage_chat_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ScheduledMessage_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deliver_at":
+			out.Values[i] = ec._ScheduledMessage_deliver_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._ScheduledMessage_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "created_at":
+			out.Values[i] = ec._ScheduledMessage_created_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		graphql.AddErrorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "chatEvents":
+		return ec._Subscription_chatEvents(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var swipeImplementors = []string{"Swipe"}
 
 func (ec *executionContext) _Swipe(ctx context.Context, sel ast.SelectionSet, obj *models.Swipe) graphql.Marshaler {
@@ -13129,6 +21371,60 @@ func (ec *executionContext) _SwipedProfile(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var trustedContactImplementors = []string{"TrustedContact"}
+
+func (ec *executionContext) _TrustedContact(ctx context.Context, sel ast.SelectionSet, obj *models.TrustedContact) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, trustedContactImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TrustedContact")
+		case "id":
+			out.Values[i] = ec._TrustedContact_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._TrustedContact_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "email":
+			out.Values[i] = ec._TrustedContact_email(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "created_at":
+			out.Values[i] = ec._TrustedContact_created_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var userImplementors = []string{"User"}
 
 func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *models.User) graphql.Marshaler {
@@ -13233,6 +21529,13 @@ func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj
 			out.Values[i] = ec._User_address(ctx, field, obj)
 		case "extra":
 			out.Values[i] = ec._User_extra(ctx, field, obj)
+		case "hide_online":
+			out.Values[i] = ec._User_hide_online(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "last_seen_at":
+			out.Values[i] = ec._User_last_seen_at(ctx, field, obj)
 		case "created_at":
 			out.Values[i] = ec._User_created_at(ctx, field, obj)
 		case "updated_at":
@@ -13459,6 +21762,8 @@ func (ec *executionContext) _UserPublic(ctx context.Context, sel ast.SelectionSe
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "last_seen_at":
+			out.Values[i] = ec._UserPublic_last_seen_at(ctx, field, obj)
 		case "is_locked":
 			out.Values[i] = ec._UserPublic_is_locked(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -13923,44 +22228,172 @@ func (ec *executionContext) marshalNActivityType2blindlyᚋinternalᚋmodelsᚐA
 	return res
 }
 
-func (ec *executionContext) marshalNAuthPayload2blindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v model.AuthPayload) graphql.Marshaler {
-	return ec._AuthPayload(ctx, sel, &v)
+func (ec *executionContext) marshalNAuthPayload2blindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v model.AuthPayload) graphql.Marshaler {
+	return ec._AuthPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v *model.AuthPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AuthPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNCallStatus2blindlyᚋinternalᚋmodelsᚐCallStatus(ctx context.Context, v any) (models.CallStatus, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := models.CallStatus(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCallStatus2blindlyᚋinternalᚋmodelsᚐCallStatus(ctx context.Context, sel ast.SelectionSet, v models.CallStatus) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(string(v))
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNChat2ᚖblindlyᚋinternalᚋmodelsᚐChat(ctx context.Context, sel ast.SelectionSet, v *models.Chat) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Chat(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNChatEvent2blindlyᚋinternalᚋgraphᚋmodelᚐChatEvent(ctx context.Context, sel ast.SelectionSet, v model.ChatEvent) graphql.Marshaler {
+	return ec._ChatEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNChatEvent2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐChatEvent(ctx context.Context, sel ast.SelectionSet, v *model.ChatEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ChatEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNChatEventType2blindlyᚋinternalᚋgraphᚋmodelᚐChatEventType(ctx context.Context, v any) (model.ChatEventType, error) {
+	var res model.ChatEventType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNChatEventType2blindlyᚋinternalᚋgraphᚋmodelᚐChatEventType(ctx context.Context, sel ast.SelectionSet, v model.ChatEventType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNChatExport2blindlyᚋinternalᚋmodelsᚐChatExport(ctx context.Context, sel ast.SelectionSet, v models.ChatExport) graphql.Marshaler {
+	return ec._ChatExport(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNChatExport2ᚕᚖblindlyᚋinternalᚋmodelsᚐChatExportᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.ChatExport) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNChatExport2ᚖblindlyᚋinternalᚋmodelsᚐChatExport(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNAuthPayload2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐAuthPayload(ctx context.Context, sel ast.SelectionSet, v *model.AuthPayload) graphql.Marshaler {
+func (ec *executionContext) marshalNChatExport2ᚖblindlyᚋinternalᚋmodelsᚐChatExport(ctx context.Context, sel ast.SelectionSet, v *models.ChatExport) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AuthPayload(ctx, sel, v)
+	return ec._ChatExport(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNChatExportFormat2blindlyᚋinternalᚋgraphᚋmodelᚐChatExportFormat(ctx context.Context, v any) (model.ChatExportFormat, error) {
+	var res model.ChatExportFormat
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+func (ec *executionContext) marshalNChatExportFormat2blindlyᚋinternalᚋgraphᚋmodelᚐChatExportFormat(ctx context.Context, sel ast.SelectionSet, v model.ChatExportFormat) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNChat2ᚖblindlyᚋinternalᚋmodelsᚐChat(ctx context.Context, sel ast.SelectionSet, v *models.Chat) graphql.Marshaler {
+func (ec *executionContext) unmarshalNChatMediaInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐChatMediaInput(ctx context.Context, v any) (*model.ChatMediaInput, error) {
+	res, err := ec.unmarshalInputChatMediaInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNChatMessage2blindlyᚋinternalᚋmodelsᚐMessage(ctx context.Context, sel ast.SelectionSet, v models.Message) graphql.Marshaler {
+	return ec._ChatMessage(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNChatMessage2ᚖblindlyᚋinternalᚋmodelsᚐMessage(ctx context.Context, sel ast.SelectionSet, v *models.Message) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Chat(ctx, sel, v)
+	return ec._ChatMessage(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNComment2blindlyᚋinternalᚋmodelsᚐComment(ctx context.Context, sel ast.SelectionSet, v models.Comment) graphql.Marshaler {
@@ -14072,6 +22505,25 @@ func (ec *executionContext) marshalNConnection2ᚕᚖblindlyᚋinternalᚋgraph
 	return ret
 }
 
+func (ec *executionContext) marshalNConnectionSettings2blindlyᚋinternalᚋmodelsᚐConnectionSettings(ctx context.Context, sel ast.SelectionSet, v models.ConnectionSettings) graphql.Marshaler {
+	return ec._ConnectionSettings(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNConnectionSettings2ᚖblindlyᚋinternalᚋmodelsᚐConnectionSettings(ctx context.Context, sel ast.SelectionSet, v *models.ConnectionSettings) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ConnectionSettings(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNConnectionSettingsInput2blindlyᚋinternalᚋgraphᚋmodelᚐConnectionSettingsInput(ctx context.Context, v any) (model.ConnectionSettingsInput, error) {
+	res, err := ec.unmarshalInputConnectionSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNCreateCommentInput2blindlyᚋinternalᚋgraphᚋmodelᚐCreateCommentInput(ctx context.Context, v any) (model.CreateCommentInput, error) {
 	res, err := ec.unmarshalInputCreateCommentInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -14092,6 +22544,112 @@ func (ec *executionContext) unmarshalNCreateUserInput2blindlyᚋinternalᚋgraph
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalNDateCheckIn2blindlyᚋinternalᚋmodelsᚐDateCheckIn(ctx context.Context, sel ast.SelectionSet, v models.DateCheckIn) graphql.Marshaler {
+	return ec._DateCheckIn(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDateCheckIn2ᚕᚖblindlyᚋinternalᚋmodelsᚐDateCheckInᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.DateCheckIn) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDateCheckIn2ᚖblindlyᚋinternalᚋmodelsᚐDateCheckIn(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDateCheckIn2ᚖblindlyᚋinternalᚋmodelsᚐDateCheckIn(ctx context.Context, sel ast.SelectionSet, v *models.DateCheckIn) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DateCheckIn(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDateProposalAction2blindlyᚋinternalᚋchat_serviceᚐDateAction(ctx context.Context, v any) (chatservice.DateAction, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := chatservice.DateAction(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDateProposalAction2blindlyᚋinternalᚋchat_serviceᚐDateAction(ctx context.Context, sel ast.SelectionSet, v chatservice.DateAction) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(string(v))
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNDateProposalResponse2blindlyᚋinternalᚋchat_serviceᚐDateResponse(ctx context.Context, sel ast.SelectionSet, v chatservice.DateResponse) graphql.Marshaler {
+	return ec._DateProposalResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDateProposalResponse2ᚖblindlyᚋinternalᚋchat_serviceᚐDateResponse(ctx context.Context, sel ast.SelectionSet, v *chatservice.DateResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DateProposalResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDateProposalStatus2blindlyᚋinternalᚋmodelsᚐDateProposalStatus(ctx context.Context, v any) (models.DateProposalStatus, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := models.DateProposalStatus(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDateProposalStatus2blindlyᚋinternalᚋmodelsᚐDateProposalStatus(ctx context.Context, sel ast.SelectionSet, v models.DateProposalStatus) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(string(v))
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
 	res, err := graphql.UnmarshalFloat(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -14150,11 +22708,152 @@ func (ec *executionContext) marshalNMatch2ᚖblindlyᚋinternalᚋmodelsᚐMatch
 	return ec._Match(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNMedia2blindlyᚋinternalᚋmodelsᚐMedia(ctx context.Context, sel ast.SelectionSet, v models.Media) graphql.Marshaler {
-	return ec._Media(ctx, sel, &v)
+func (ec *executionContext) marshalNMedia2blindlyᚋinternalᚋmodelsᚐMedia(ctx context.Context, sel ast.SelectionSet, v models.Media) graphql.Marshaler {
+	return ec._Media(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia(ctx context.Context, sel ast.SelectionSet, v []models.Media) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOMedia2blindlyᚋinternalᚋmodelsᚐMedia(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
+func (ec *executionContext) marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMediaᚄ(ctx context.Context, sel ast.SelectionSet, v []models.Media) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMedia2blindlyᚋinternalᚋmodelsᚐMedia(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNMediaGrant2blindlyᚋinternalᚋchat_serviceᚐMediaGrant(ctx context.Context, sel ast.SelectionSet, v chatservice.MediaGrant) graphql.Marshaler {
+	return ec._MediaGrant(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNMediaGrant2ᚖblindlyᚋinternalᚋchat_serviceᚐMediaGrant(ctx context.Context, sel ast.SelectionSet, v *chatservice.MediaGrant) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MediaGrant(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx context.Context, v any) ([]*model.MediaInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.MediaInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalOMediaInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInputᚄ(ctx context.Context, v any) ([]*model.MediaInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.MediaInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNMediaInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNMediaInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx context.Context, v any) (*model.MediaInput, error) {
+	res, err := ec.unmarshalInputMediaInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNMediaType2blindlyᚋinternalᚋgraphᚋmodelᚐMediaType(ctx context.Context, v any) (model.MediaType, error) {
+	var res model.MediaType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia(ctx context.Context, sel ast.SelectionSet, v []models.Media) graphql.Marshaler {
+func (ec *executionContext) marshalNMediaType2blindlyᚋinternalᚋgraphᚋmodelᚐMediaType(ctx context.Context, sel ast.SelectionSet, v model.MediaType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNMessageRevision2ᚕᚖblindlyᚋinternalᚋmodelsᚐMessageRevisionᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.MessageRevision) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14178,7 +22877,7 @@ func (ec *executionContext) marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOMedia2blindlyᚋinternalᚋmodelsᚐMedia(ctx, sel, v[i])
+			ret[i] = ec.marshalNMessageRevision2ᚖblindlyᚋinternalᚋmodelsᚐMessageRevision(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14189,10 +22888,53 @@ func (ec *executionContext) marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia
 	}
 	wg.Wait()
 
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
 	return ret
 }
 
-func (ec *executionContext) marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMediaᚄ(ctx context.Context, sel ast.SelectionSet, v []models.Media) graphql.Marshaler {
+func (ec *executionContext) marshalNMessageRevision2ᚖblindlyᚋinternalᚋmodelsᚐMessageRevision(ctx context.Context, sel ast.SelectionSet, v *models.MessageRevision) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MessageRevision(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNMessageType2blindlyᚋinternalᚋmodelsᚐMessageType(ctx context.Context, v any) (models.MessageType, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := models.MessageType(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMessageType2blindlyᚋinternalᚋmodelsᚐMessageType(ctx context.Context, sel ast.SelectionSet, v models.MessageType) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(string(v))
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPersonalityTrait2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PersonalityTrait) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14216,7 +22958,7 @@ func (ec *executionContext) marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNMedia2blindlyᚋinternalᚋmodelsᚐMedia(ctx, sel, v[i])
+			ret[i] = ec.marshalNPersonalityTrait2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTrait(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14236,62 +22978,22 @@ func (ec *executionContext) marshalNMedia2ᚕblindlyᚋinternalᚋmodelsᚐMedia
 	return ret
 }
 
-func (ec *executionContext) unmarshalNMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx context.Context, v any) ([]*model.MediaInput, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]*model.MediaInput, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalOMediaInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInputᚄ(ctx context.Context, v any) ([]*model.MediaInput, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]*model.MediaInput, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNMediaInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNMediaInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx context.Context, v any) (*model.MediaInput, error) {
-	res, err := ec.unmarshalInputMediaInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) unmarshalNMediaType2blindlyᚋinternalᚋgraphᚋmodelᚐMediaType(ctx context.Context, v any) (model.MediaType, error) {
-	var res model.MediaType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNMediaType2blindlyᚋinternalᚋgraphᚋmodelᚐMediaType(ctx context.Context, sel ast.SelectionSet, v model.MediaType) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNPageInfo2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+func (ec *executionContext) marshalNPersonalityTrait2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTrait(ctx context.Context, sel ast.SelectionSet, v *model.PersonalityTrait) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._PageInfo(ctx, sel, v)
+	return ec._PersonalityTrait(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPersonalityTrait2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PersonalityTrait) graphql.Marshaler {
+func (ec *executionContext) unmarshalNPersonalityTraitInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitInput(ctx context.Context, v any) (*model.PersonalityTraitInput, error) {
+	res, err := ec.unmarshalInputPersonalityTraitInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPlannedDate2ᚕᚖblindlyᚋinternalᚋmodelsᚐPlannedDateᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.PlannedDate) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14315,7 +23017,7 @@ func (ec *executionContext) marshalNPersonalityTrait2ᚕᚖblindlyᚋinternalᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNPersonalityTrait2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTrait(ctx, sel, v[i])
+			ret[i] = ec.marshalNPlannedDate2ᚖblindlyᚋinternalᚋmodelsᚐPlannedDate(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14335,19 +23037,14 @@ func (ec *executionContext) marshalNPersonalityTrait2ᚕᚖblindlyᚋinternalᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNPersonalityTrait2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTrait(ctx context.Context, sel ast.SelectionSet, v *model.PersonalityTrait) graphql.Marshaler {
+func (ec *executionContext) marshalNPlannedDate2ᚖblindlyᚋinternalᚋmodelsᚐPlannedDate(ctx context.Context, sel ast.SelectionSet, v *models.PlannedDate) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._PersonalityTrait(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNPersonalityTraitInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitInput(ctx context.Context, v any) (*model.PersonalityTraitInput, error) {
-	res, err := ec.unmarshalInputPersonalityTraitInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ec._PlannedDate(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNPost2blindlyᚋinternalᚋmodelsᚐPost(ctx context.Context, sel ast.SelectionSet, v models.Post) graphql.Marshaler {
@@ -14420,6 +23117,14 @@ func (ec *executionContext) marshalNPostsConnection2ᚖblindlyᚋinternalᚋgrap
 	return ec._PostsConnection(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNReaction2blindlyᚋinternalᚋmodelsᚐReaction(ctx context.Context, sel ast.SelectionSet, v models.Reaction) graphql.Marshaler {
+	return ec._Reaction(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNReactionCount2blindlyᚋinternalᚋmodelsᚐReactionCount(ctx context.Context, sel ast.SelectionSet, v models.ReactionCount) graphql.Marshaler {
+	return ec._ReactionCount(ctx, sel, &v)
+}
+
 func (ec *executionContext) marshalNRecommendationsResult2blindlyᚋinternalᚋgraphᚋmodelᚐRecommendationsResult(ctx context.Context, sel ast.SelectionSet, v model.RecommendationsResult) graphql.Marshaler {
 	return ec._RecommendationsResult(ctx, sel, &v)
 }
@@ -14502,6 +23207,69 @@ func (ec *executionContext) marshalNReport2ᚖblindlyᚋinternalᚋmodelsᚐRepo
 	return ec._Report(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNScheduledMessage2blindlyᚋinternalᚋmodelsᚐScheduledMessage(ctx context.Context, sel ast.SelectionSet, v models.ScheduledMessage) graphql.Marshaler {
+	return ec._ScheduledMessage(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNScheduledMessage2ᚕᚖblindlyᚋinternalᚋmodelsᚐScheduledMessageᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.ScheduledMessage) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNScheduledMessage2ᚖblindlyᚋinternalᚋmodelsᚐScheduledMessage(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNScheduledMessage2ᚖblindlyᚋinternalᚋmodelsᚐScheduledMessage(ctx context.Context, sel ast.SelectionSet, v *models.ScheduledMessage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ScheduledMessage(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSendMessageInput2blindlyᚋinternalᚋgraphᚋmodelᚐSendMessageInput(ctx context.Context, v any) (model.SendMessageInput, error) {
+	res, err := ec.unmarshalInputSendMessageInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNSortOrder2blindlyᚋinternalᚋgraphᚋmodelᚐSortOrder(ctx context.Context, v any) (model.SortOrder, error) {
 	var res model.SortOrder
 	err := res.UnmarshalGQL(v)
@@ -14582,39 +23350,109 @@ func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel
 	return ret
 }
 
-func (ec *executionContext) marshalNSwipe2ᚖblindlyᚋinternalᚋmodelsᚐSwipe(ctx context.Context, sel ast.SelectionSet, v *models.Swipe) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) marshalNSwipe2ᚖblindlyᚋinternalᚋmodelsᚐSwipe(ctx context.Context, sel ast.SelectionSet, v *models.Swipe) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Swipe(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSwipeResponse2blindlyᚋinternalᚋgraphᚋmodelᚐSwipeResponse(ctx context.Context, sel ast.SelectionSet, v model.SwipeResponse) graphql.Marshaler {
+	return ec._SwipeResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSwipeResponse2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipeResponse(ctx context.Context, sel ast.SelectionSet, v *model.SwipeResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SwipeResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSwipeType2blindlyᚋinternalᚋmodelsᚐSwipeType(ctx context.Context, v any) (models.SwipeType, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := models.SwipeType(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSwipeType2blindlyᚋinternalᚋmodelsᚐSwipeType(ctx context.Context, sel ast.SelectionSet, v models.SwipeType) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(string(v))
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNSwipedProfile2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipedProfileᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SwipedProfile) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSwipedProfile2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipedProfile(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._Swipe(ctx, sel, v)
-}
 
-func (ec *executionContext) marshalNSwipeResponse2blindlyᚋinternalᚋgraphᚋmodelᚐSwipeResponse(ctx context.Context, sel ast.SelectionSet, v model.SwipeResponse) graphql.Marshaler {
-	return ec._SwipeResponse(ctx, sel, &v)
+	return ret
 }
 
-func (ec *executionContext) marshalNSwipeResponse2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipeResponse(ctx context.Context, sel ast.SelectionSet, v *model.SwipeResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNSwipedProfile2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipedProfile(ctx context.Context, sel ast.SelectionSet, v *model.SwipedProfile) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._SwipeResponse(ctx, sel, v)
+	return ec._SwipedProfile(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNSwipeType2blindlyᚋinternalᚋmodelsᚐSwipeType(ctx context.Context, v any) (models.SwipeType, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := models.SwipeType(tmp)
+func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNSwipeType2blindlyᚋinternalᚋmodelsᚐSwipeType(ctx context.Context, sel ast.SelectionSet, v models.SwipeType) graphql.Marshaler {
+func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
 	_ = sel
-	res := graphql.MarshalString(string(v))
+	res := graphql.MarshalTime(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
@@ -14623,7 +23461,11 @@ func (ec *executionContext) marshalNSwipeType2blindlyᚋinternalᚋmodelsᚐSwip
 	return res
 }
 
-func (ec *executionContext) marshalNSwipedProfile2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipedProfileᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SwipedProfile) graphql.Marshaler {
+func (ec *executionContext) marshalNTrustedContact2blindlyᚋinternalᚋmodelsᚐTrustedContact(ctx context.Context, sel ast.SelectionSet, v models.TrustedContact) graphql.Marshaler {
+	return ec._TrustedContact(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNTrustedContact2ᚕᚖblindlyᚋinternalᚋmodelsᚐTrustedContactᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.TrustedContact) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -14647,7 +23489,7 @@ func (ec *executionContext) marshalNSwipedProfile2ᚕᚖblindlyᚋinternalᚋgra
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNSwipedProfile2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipedProfile(ctx, sel, v[i])
+			ret[i] = ec.marshalNTrustedContact2ᚖblindlyᚋinternalᚋmodelsᚐTrustedContact(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -14667,30 +23509,14 @@ func (ec *executionContext) marshalNSwipedProfile2ᚕᚖblindlyᚋinternalᚋgra
 	return ret
 }
 
-func (ec *executionContext) marshalNSwipedProfile2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐSwipedProfile(ctx context.Context, sel ast.SelectionSet, v *model.SwipedProfile) graphql.Marshaler {
+func (ec *executionContext) marshalNTrustedContact2ᚖblindlyᚋinternalᚋmodelsᚐTrustedContact(ctx context.Context, sel ast.SelectionSet, v *models.TrustedContact) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._SwipedProfile(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
-	res, err := graphql.UnmarshalTime(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalTime(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+	return ec._TrustedContact(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNUpdateCommentInput2blindlyᚋinternalᚋgraphᚋmodelᚐUpdateCommentInput(ctx context.Context, v any) (model.UpdateCommentInput, error) {
@@ -15118,6 +23944,38 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) marshalOCallLog2ᚖblindlyᚋinternalᚋmodelsᚐCallLog(ctx context.Context, sel ast.SelectionSet, v *models.CallLog) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CallLog(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOChatMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐChatMediaInputᚄ(ctx context.Context, v any) ([]*model.ChatMediaInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.ChatMediaInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNChatMediaInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐChatMediaInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOChatMessage2ᚖblindlyᚋinternalᚋmodelsᚐMessage(ctx context.Context, sel ast.SelectionSet, v *models.Message) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ChatMessage(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOComment2ᚖblindlyᚋinternalᚋmodelsᚐComment(ctx context.Context, sel ast.SelectionSet, v *models.Comment) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -15132,6 +23990,53 @@ func (ec *executionContext) marshalOConnection2ᚖblindlyᚋinternalᚋgraphᚋm
 	return ec._Connection(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOConnectionFilter2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐConnectionFilter(ctx context.Context, v any) (*model.ConnectionFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ConnectionFilter)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOConnectionFilter2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐConnectionFilter(ctx context.Context, sel ast.SelectionSet, v *model.ConnectionFilter) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOConnectionSort2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐConnectionSort(ctx context.Context, v any) (*model.ConnectionSort, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ConnectionSort)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOConnectionSort2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐConnectionSort(ctx context.Context, sel ast.SelectionSet, v *model.ConnectionSort) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalODateProposal2ᚖblindlyᚋinternalᚋmodelsᚐDateProposal(ctx context.Context, sel ast.SelectionSet, v *models.DateProposal) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DateProposal(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalODateProposalInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐDateProposalInput(ctx context.Context, v any) (*model.DateProposalInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputDateProposalInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOExtraMetadata2blindlyᚋinternalᚋmodelsᚐExtraMetadata(ctx context.Context, sel ast.SelectionSet, v models.ExtraMetadata) graphql.Marshaler {
 	return ec._ExtraMetadata(ctx, sel, &v)
 }
@@ -15226,6 +24131,53 @@ func (ec *executionContext) marshalOMedia2blindlyᚋinternalᚋmodelsᚐMedia(ct
 	return ec._Media(ctx, sel, &v)
 }
 
+func (ec *executionContext) marshalOMedia2ᚕblindlyᚋinternalᚋmodelsᚐMediaᚄ(ctx context.Context, sel ast.SelectionSet, v []models.Media) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMedia2blindlyᚋinternalᚋmodelsᚐMedia(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOMediaInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐMediaInput(ctx context.Context, v any) ([]*model.MediaInput, error) {
 	if v == nil {
 		return nil, nil
@@ -15252,6 +24204,13 @@ func (ec *executionContext) unmarshalOMediaInput2ᚖblindlyᚋinternalᚋgraph
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOMessagePreview2ᚖblindlyᚋinternalᚋmodelsᚐMessagePreview(ctx context.Context, sel ast.SelectionSet, v *models.MessagePreview) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MessagePreview(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOPersonalityTraitInput2ᚕᚖblindlyᚋinternalᚋgraphᚋmodelᚐPersonalityTraitInputᚄ(ctx context.Context, v any) ([]*model.PersonalityTraitInput, error) {
 	if v == nil {
 		return nil, nil
@@ -15270,6 +24229,13 @@ func (ec *executionContext) unmarshalOPersonalityTraitInput2ᚕᚖblindlyᚋinte
 	return res, nil
 }
 
+func (ec *executionContext) marshalOPlannedDate2ᚖblindlyᚋinternalᚋmodelsᚐPlannedDate(ctx context.Context, sel ast.SelectionSet, v *models.PlannedDate) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PlannedDate(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOPost2ᚖblindlyᚋinternalᚋmodelsᚐPost(ctx context.Context, sel ast.SelectionSet, v *models.Post) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -15285,6 +24251,100 @@ func (ec *executionContext) unmarshalOPostFilterInput2ᚖblindlyᚋinternalᚋgr
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOReaction2ᚕblindlyᚋinternalᚋmodelsᚐReactionᚄ(ctx context.Context, sel ast.SelectionSet, v []models.Reaction) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNReaction2blindlyᚋinternalᚋmodelsᚐReaction(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOReactionCount2ᚕblindlyᚋinternalᚋmodelsᚐReactionCountᚄ(ctx context.Context, sel ast.SelectionSet, v []models.ReactionCount) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNReactionCount2blindlyᚋinternalᚋmodelsᚐReactionCount(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOSortInput2ᚖblindlyᚋinternalᚋgraphᚋmodelᚐSortInput(ctx context.Context, v any) (*model.SortInput, error) {
 	if v == nil {
 		return nil, nil
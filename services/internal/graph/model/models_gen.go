@@ -23,6 +23,27 @@ type AuthPayload struct {
 	User        *models.User `json:"user"`
 }
 
+// A realtime chat event, mirroring what the /v1/chat/ws socket delivers.
+type ChatEvent struct {
+	Type       ChatEventType   `json:"type"`
+	ChatID     string          `json:"chat_id"`
+	Message    *models.Message `json:"message,omitempty"`
+	Change     *string         `json:"change,omitempty"`
+	MessageIds []string        `json:"message_ids,omitempty"`
+	UserID     *string         `json:"user_id,omitempty"`
+	IsTyping   *bool           `json:"is_typing,omitempty"`
+	IsOnline   *bool           `json:"is_online,omitempty"`
+	LastSeenAt *time.Time      `json:"last_seen_at,omitempty"`
+	Timestamp  time.Time       `json:"timestamp"`
+}
+
+type ChatMediaInput struct {
+	Type     MediaType `json:"type"`
+	URL      string    `json:"url"`
+	FileID   *string   `json:"file_id,omitempty"`
+	ViewOnce *bool     `json:"view_once,omitempty"`
+}
+
 type CommentFilterInput struct {
 	PostID        *string    `json:"post_id,omitempty"`
 	UserID        *string    `json:"user_id,omitempty"`
@@ -41,12 +62,23 @@ type CommentsConnection struct {
 }
 
 type Connection struct {
-	Chat               *models.Chat  `json:"chat"`
-	Match              *models.Match `json:"match"`
-	LastMessage        string        `json:"last_message"`
-	UnreadMessages     int32         `json:"unread_messages"`
-	PercentageComplete float64       `json:"percentage_complete"`
-	ConnectionProfile  *UserPublic   `json:"connection_profile"`
+	Chat               *models.Chat               `json:"chat"`
+	Match              *models.Match              `json:"match"`
+	LastMessage        string                     `json:"last_message"`
+	UnreadMessages     int32                      `json:"unread_messages"`
+	PercentageComplete float64                    `json:"percentage_complete"`
+	ConnectionProfile  *UserPublic                `json:"connection_profile"`
+	Settings           *models.ConnectionSettings `json:"settings"`
+	LastActivityAt     time.Time                  `json:"last_activity_at"`
+	Cursor             string                     `json:"cursor"`
+}
+
+type ConnectionSettingsInput struct {
+	Pinned     *bool      `json:"pinned,omitempty"`
+	Archived   *bool      `json:"archived,omitempty"`
+	MutedUntil *time.Time `json:"muted_until,omitempty"`
+	Unmute     *bool      `json:"unmute,omitempty"`
+	Nickname   *string    `json:"nickname,omitempty"`
 }
 
 type CreateCommentInput struct {
@@ -83,6 +115,12 @@ type CreateUserInput struct {
 	Address     *AddressInput `json:"address,omitempty"`
 }
 
+type DateProposalInput struct {
+	StartsAt time.Time `json:"starts_at"`
+	Venue    string    `json:"venue"`
+	Note     *string   `json:"note,omitempty"`
+}
+
 type MediaInput struct {
 	ID        string    `json:"id"`
 	URL       string    `json:"url"`
@@ -146,11 +184,23 @@ type RecommendedProfile struct {
 	Reason             *string     `json:"reason,omitempty"`
 }
 
+type SendMessageInput struct {
+	ChatID       string             `json:"chat_id"`
+	Type         models.MessageType `json:"type"`
+	Content      string             `json:"content"`
+	Media        []*ChatMediaInput  `json:"media,omitempty"`
+	ReplyToID    *string            `json:"reply_to_id,omitempty"`
+	DateProposal *DateProposalInput `json:"date_proposal,omitempty"`
+}
+
 type SortInput struct {
 	Field string    `json:"field"`
 	Order SortOrder `json:"order"`
 }
 
+type Subscription struct {
+}
+
 type SwipeResponse struct {
 	Swipe *models.Swipe `json:"swipe"`
 	Match *models.Match `json:"match,omitempty"`
@@ -186,6 +236,7 @@ type UpdateUserInput struct {
 	Photos            []string                 `json:"photos,omitempty"`
 	IsVerified        *bool                    `json:"is_verified,omitempty"`
 	Address           *AddressInput            `json:"address,omitempty"`
+	HideOnline        *bool                    `json:"hide_online,omitempty"`
 }
 
 type UserPublic struct {
@@ -204,6 +255,7 @@ type UserPublic struct {
 	Extra             *models.ExtraMetadata `json:"extra,omitempty"`
 	CreatedAt         time.Time             `json:"created_at"`
 	IsOnline          bool                  `json:"is_online"`
+	LastSeenAt        *time.Time            `json:"last_seen_at,omitempty"`
 	IsLocked          bool                  `json:"is_locked"`
 	IsPoked           bool                  `json:"is_poked"`
 	ChatID            string                `json:"chat_id"`
@@ -270,6 +322,124 @@ func (e ActivityClass) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ChatEventType string
+
+const (
+	ChatEventTypeMessage  ChatEventType = "MESSAGE"
+	ChatEventTypeUpdate   ChatEventType = "UPDATE"
+	ChatEventTypeSeen     ChatEventType = "SEEN"
+	ChatEventTypeReceived ChatEventType = "RECEIVED"
+	ChatEventTypeTyping   ChatEventType = "TYPING"
+	ChatEventTypePresence ChatEventType = "PRESENCE"
+)
+
+var AllChatEventType = []ChatEventType{
+	ChatEventTypeMessage,
+	ChatEventTypeUpdate,
+	ChatEventTypeSeen,
+	ChatEventTypeReceived,
+	ChatEventTypeTyping,
+	ChatEventTypePresence,
+}
+
+func (e ChatEventType) IsValid() bool {
+	switch e {
+	case ChatEventTypeMessage, ChatEventTypeUpdate, ChatEventTypeSeen, ChatEventTypeReceived, ChatEventTypeTyping, ChatEventTypePresence:
+		return true
+	}
+	return false
+}
+
+func (e ChatEventType) String() string {
+	return string(e)
+}
+
+func (e *ChatEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChatEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChatEventType", str)
+	}
+	return nil
+}
+
+func (e ChatEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ChatEventType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ChatEventType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ChatExportFormat string
+
+const (
+	ChatExportFormatJSON ChatExportFormat = "JSON"
+	ChatExportFormatHTML ChatExportFormat = "HTML"
+)
+
+var AllChatExportFormat = []ChatExportFormat{
+	ChatExportFormatJSON,
+	ChatExportFormatHTML,
+}
+
+func (e ChatExportFormat) IsValid() bool {
+	switch e {
+	case ChatExportFormatJSON, ChatExportFormatHTML:
+		return true
+	}
+	return false
+}
+
+func (e ChatExportFormat) String() string {
+	return string(e)
+}
+
+func (e *ChatExportFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChatExportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChatExportFormat", str)
+	}
+	return nil
+}
+
+func (e ChatExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ChatExportFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ChatExportFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type CommentSortField string
 
 const (
@@ -325,6 +495,124 @@ func (e CommentSortField) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ConnectionFilter string
+
+const (
+	ConnectionFilterActive   ConnectionFilter = "ACTIVE"
+	ConnectionFilterArchived ConnectionFilter = "ARCHIVED"
+	ConnectionFilterPinned   ConnectionFilter = "PINNED"
+	ConnectionFilterUnread   ConnectionFilter = "UNREAD"
+	ConnectionFilterMuted    ConnectionFilter = "MUTED"
+	ConnectionFilterAll      ConnectionFilter = "ALL"
+)
+
+var AllConnectionFilter = []ConnectionFilter{
+	ConnectionFilterActive,
+	ConnectionFilterArchived,
+	ConnectionFilterPinned,
+	ConnectionFilterUnread,
+	ConnectionFilterMuted,
+	ConnectionFilterAll,
+}
+
+func (e ConnectionFilter) IsValid() bool {
+	switch e {
+	case ConnectionFilterActive, ConnectionFilterArchived, ConnectionFilterPinned, ConnectionFilterUnread, ConnectionFilterMuted, ConnectionFilterAll:
+		return true
+	}
+	return false
+}
+
+func (e ConnectionFilter) String() string {
+	return string(e)
+}
+
+func (e *ConnectionFilter) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConnectionFilter(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConnectionFilter", str)
+	}
+	return nil
+}
+
+func (e ConnectionFilter) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ConnectionFilter) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ConnectionFilter) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ConnectionSort string
+
+const (
+	ConnectionSortMatchedAt    ConnectionSort = "MATCHED_AT"
+	ConnectionSortLastActivity ConnectionSort = "LAST_ACTIVITY"
+)
+
+var AllConnectionSort = []ConnectionSort{
+	ConnectionSortMatchedAt,
+	ConnectionSortLastActivity,
+}
+
+func (e ConnectionSort) IsValid() bool {
+	switch e {
+	case ConnectionSortMatchedAt, ConnectionSortLastActivity:
+		return true
+	}
+	return false
+}
+
+func (e ConnectionSort) String() string {
+	return string(e)
+}
+
+func (e *ConnectionSort) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConnectionSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConnectionSort", str)
+	}
+	return nil
+}
+
+func (e ConnectionSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ConnectionSort) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ConnectionSort) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type MediaType string
 
 const (
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"blindly/internal/graph/model"
+	"blindly/internal/helpers/users"
 	"blindly/internal/models"
 	"encoding/json"
 	"fmt"
@@ -59,6 +60,8 @@ type DBUserProfile struct {
 	Photos            []string              `json:"photos"`
 	IsVerified        bool                  `json:"is_verified"`
 	Extra             *models.ExtraMetadata `json:"extra"`
+	HideOnline        bool                  `json:"hide_online"`
+	LastSeenAt        *FlexibleTime         `json:"last_seen_at"`
 	CreatedAt         FlexibleTime          `json:"created_at"`
 	UpdatedAt         string                `json:"updated_at"`
 }
@@ -92,6 +95,13 @@ func (d *DBUserProfile) ToUserPublic() *model.UserPublic {
 		})
 	}
 
+	var storedLastSeen *time.Time
+	if d.LastSeenAt != nil {
+		t := d.LastSeenAt.Time()
+		storedLastSeen = &t
+	}
+	isOnline, lastSeen := users.Presence(d.ID, d.HideOnline, storedLastSeen)
+
 	return &model.UserPublic{
 		ID:                d.ID,
 		Name:              d.FirstName + " " + d.LastName,
@@ -107,7 +117,8 @@ func (d *DBUserProfile) ToUserPublic() *model.UserPublic {
 		IsVerified:        d.IsVerified,
 		Extra:             d.Extra,
 		CreatedAt:         d.CreatedAt.Time(),
-		IsOnline:          false,
+		IsOnline:          isOnline,
+		LastSeenAt:        lastSeen,
 	}
 }
 
@@ -2,9 +2,11 @@ package swipes
 
 import (
 	"blindly/internal/anal"
+	chatservice "blindly/internal/chat_service"
 	"blindly/internal/graph/directives"
 	"blindly/internal/graph/model"
 	"blindly/internal/graph/shared"
+	"blindly/internal/helpers/users"
 	"blindly/internal/models"
 	"context"
 	"database/sql"
@@ -186,7 +188,7 @@ func (r *Resolver) createMatchAndChat(userID1, userID2 string) (*models.Match, e
 	_, err = db.Exec(`
 		INSERT INTO chats (id, match_id, created_at, messages)
 		VALUES ($1, $2, $3, $4)
-	`, chatID, matchID, now, "[]")
+	`, chatID, matchID, now, initialChatMessages(userID1, userID2))
 
 	if err != nil {
 		log.Printf("[ERROR] Failed to insert chat: %v", err)
@@ -198,6 +200,23 @@ func (r *Resolver) createMatchAndChat(userID1, userID2 string) (*models.Match, e
 	return match, nil
 }
 
+// initialChatMessages opens a new chat with an icebreaker drawn from what the
+// pair has in common. A chat is still created if profiles cannot be loaded.
+func initialChatMessages(userID1, userID2 string) string {
+	u1, err1 := users.GetUserById(userID1)
+	u2, err2 := users.GetUserById(userID2)
+	if err1 != nil || err2 != nil {
+		log.Printf("[ERROR] Failed to load users for icebreaker: %v %v", err1, err2)
+		return "[]"
+	}
+
+	data, err := json.Marshal([]models.Message{chatservice.IcebreakerMessage(*u1, *u2)})
+	if err != nil {
+		return "[]"
+	}
+	return string(data)
+}
+
 func (r *Resolver) Recommendations(ctx context.Context, cursor *string, limit *int32) (*model.RecommendationsResult, error) {
 	claims, ae, err := directives.GetAuthClaims(ctx)
 	if err != nil {
@@ -4,13 +4,17 @@ import (
 	"blindly/internal/anal"
 	"blindly/internal/auth"
 	"blindly/internal/auth/workos"
+	chatservice "blindly/internal/chat_service"
 	"blindly/internal/graph/directives"
 	"blindly/internal/graph/model"
+	"blindly/internal/helpers/socketstate"
 	"blindly/internal/helpers/users"
 	"blindly/internal/models"
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/MelloB1989/karma/config"
 )
@@ -110,8 +114,33 @@ func (r *Resolver) UpdateMe(ctx context.Context, input model.UpdateUserInput) (*
 		}
 		user.PersonalityTraits = pt
 	}
+	hideChanged := input.HideOnline != nil && *input.HideOnline != user.HideOnline
+	if input.HideOnline != nil {
+		user.HideOnline = *input.HideOnline
+	}
+
+	updated, err := users.UpdateUser(*user)
+	if err != nil {
+		return nil, err
+	}
+
+	// Matches see the change right away: hiding looks like going offline and
+	// unhiding like coming back, if the user is connected.
+	if hideChanged {
+		online, _ := socketstate.IsOnline(user.Id)
+		if online {
+			var lastSeen *time.Time
+			if user.HideOnline {
+				now := time.Now()
+				lastSeen = &now
+			}
+			if err := chatservice.PublishPresence(user.Id, !user.HideOnline, lastSeen); err != nil {
+				log.Printf("failed to publish presence for %s: %v", user.Id, err)
+			}
+		}
+	}
 
-	return users.UpdateUser(*user)
+	return updated, nil
 }
 
 func (r *Resolver) RefreshToken(ctx context.Context) (*model.AuthPayload, error) {
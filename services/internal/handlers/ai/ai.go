@@ -1,16 +1,19 @@
 package ai
 
 import (
+	chatservice "blindly/internal/chat_service"
 	"blindly/internal/constants"
 	hai "blindly/internal/helpers/ai"
+	"blindly/internal/helpers/ratelimit"
 	"blindly/internal/helpers/socketstate"
 	"blindly/internal/helpers/users"
+	"blindly/internal/helpers/wsproto"
 	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/MelloB1989/karma/ai"
@@ -142,34 +145,6 @@ func GetAIBio(c *fiber.Ctx) error {
 	return nil
 }
 
-type incomingMessageTypes string
-
-const (
-	incomingChatCompletion  incomingMessageTypes = "chat_completion"
-	incomingUpdateChatTitle incomingMessageTypes = "update_chat_title"
-	incomingCreateNewChat   incomingMessageTypes = "create_new_chat"
-	incomingProfileAbout    incomingMessageTypes = "profile_about"
-	incomingGetChat         incomingMessageTypes = "get_chat"
-	incomingGetChats        incomingMessageTypes = "get_chats"
-	incomingOnlineStatus    incomingMessageTypes = "online_status"
-	incomingAIRequest       incomingMessageTypes = "ai_request"
-	incomingImageGeneration incomingMessageTypes = "image_generation" // For future use
-)
-
-type outgoingMessageType string
-
-const (
-	outgoingChatCompletionType outgoingMessageType = "chat_completion"
-	outgoingCreateNewChat      outgoingMessageType = "create_new_chat"
-	outgoingUpdateChatTitle    outgoingMessageType = "update_chat_title"
-	outgoingError              outgoingMessageType = "error"
-	outgoingGetChat            outgoingMessageType = "get_chat"
-	outgoingGetChats           outgoingMessageType = "get_chats"
-	outgoingOnlineStatus       outgoingMessageType = "online_status"
-	outgoingAIRequest          outgoingMessageType = "ai_request"
-	outgoingImageGeneration    outgoingMessageType = "image_generation" // For future use
-)
-
 const (
 	writeWait      = 30 * time.Second
 	pongWait       = 60 * time.Second
@@ -184,41 +159,12 @@ func AIChatHandler(c *websocket.Conn) {
 		return
 	}
 
-	// Thread-safe write function
-	var writeMu sync.Mutex
-	writeJSON := func(v any) error {
-		writeMu.Lock()
-		defer writeMu.Unlock()
-		c.SetWriteDeadline(time.Now().Add(writeWait))
-		err := c.WriteJSON(v)
-		c.SetWriteDeadline(time.Time{}) // Clear deadline after write
-		return err
-	}
-
-	// Message types
-	var incoming struct {
-		Message *string              `json:"message,omitempty"`
-		Type    incomingMessageTypes `json:"type"`
-		Id      *string              `json:"id,omitempty"`
-		ChatId  *string              `json:"chatId,omitempty"`
-		Media   []string             `json:"media,omitempty"`
-		Data    json.RawMessage      `json:"data,omitempty"`
-	}
-
-	type outgoing struct {
-		MessageChunk string              `json:"message"`
-		Type         outgoingMessageType `json:"type"`
-		Id           string              `json:"id"`
-		Error        string              `json:"error,omitempty"`
-		ChatId       *string             `json:"chatId,omitempty"`
-		Data         any                 `json:"data,omitempty"`
-	}
-
-	getIncomingID := func() string {
-		if incoming.Id != nil {
-			return *incoming.Id
-		}
-		return ""
+	format, verr := wsproto.Negotiate(c)
+	conn := wsproto.NewConn(c, format, writeWait, legacyFrame)
+	if verr != nil {
+		conn.SendError(string(outgoingError), "", verr)
+		c.Close()
+		return
 	}
 
 	// Set up ping/pong handlers
@@ -235,10 +181,7 @@ func AIChatHandler(c *websocket.Conn) {
 	// Initialize AI service
 	currentUserDetails, err := users.GetUserPublicById(uid)
 	if err != nil {
-		writeJSON(outgoing{
-			Type:  outgoingError,
-			Error: fmt.Sprintf("Failed to get user details: %v", err),
-		})
+		conn.SendError(string(outgoingError), "", wsproto.Failed(fmt.Errorf("Failed to get user details: %v", err)))
 		c.Close()
 		return
 	}
@@ -258,6 +201,19 @@ func AIChatHandler(c *websocket.Conn) {
 		ai.WithUserPrePrompt(fmt.Sprintf("Current user details are: %s", string(cud))),
 	)
 
+	guard := ratelimit.NewGuard(ratelimit.Shared(), uid)
+
+	// The assistant socket keeps the user online just like a chat socket.
+	connId := strings.ToUpper(utils.GenerateID(12))
+	presence := chatservice.TrackPresence(uid, connId)
+	defer presence.Close()
+
+	if err := conn.Hello(connId); err != nil {
+		log.Printf("[AI Chat %s] failed to greet client: %v", uid, err)
+		c.Close()
+		return
+	}
+
 	// Graceful shutdown coordination
 	done := make(chan struct{})
 	defer close(done)
@@ -273,13 +229,7 @@ func AIChatHandler(c *websocket.Conn) {
 			case <-done:
 				return
 			case <-ticker.C:
-				writeMu.Lock()
-				c.SetWriteDeadline(time.Now().Add(writeWait))
-				err := c.WriteMessage(websocket.PingMessage, nil)
-				c.SetWriteDeadline(time.Time{})
-				writeMu.Unlock()
-
-				if err != nil {
+				if err := conn.Ping(); err != nil {
 					pingFailures++
 					log.Printf("[AI Chat %s] ping failed (attempt %d/%d): %v", uid, pingFailures, maxPingRetries, err)
 					if pingFailures >= maxPingRetries {
@@ -311,231 +261,68 @@ func AIChatHandler(c *websocket.Conn) {
 
 		c.SetReadDeadline(time.Now().Add(pongWait))
 
-		if err := json.Unmarshal(msgBytes, &incoming); err != nil {
-			writeJSON(outgoing{
-				Type:  outgoingError,
-				Error: fmt.Sprintf("Invalid JSON: %v", err.Error()),
-				Id:    getIncomingID(),
-			})
-			continue
-		}
-
-		// Handle incoming messages
-		switch incoming.Type {
-		case incomingChatCompletion:
-			if incoming.Message == nil || incoming.ChatId == nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "message and chatId are required",
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-
-			mid := *incoming.Message
-			chatId := *incoming.ChatId
-			if strings.TrimSpace(mid) == "" || strings.TrimSpace(chatId) == "" {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "message and chatId cannot be empty",
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-
-			oldMgs, err := hai.GetChatById(chatId, uid)
-			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: err.Error(),
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-
-			callback := func(chunk models.StreamedResponse) error {
-				return writeJSON(outgoing{
-					MessageChunk: chunk.AIResponse,
-					Type:         outgoingChatCompletionType,
-					Id:           getIncomingID(),
-					ChatId:       incoming.ChatId,
-				})
-			}
-
-			chatHistory := models.AIChatHistory{
-				Messages: oldMgs.Messages,
-				ChatId:   chatId,
-			}
-			chatHistory.Messages = append(chatHistory.Messages, models.AIMessage{
-				UniqueId: strings.ToUpper(utils.GenerateID(10)),
-				Message:  *incoming.Message,
-				Role:     models.User,
-			})
+		req, decodeErr := protocol.Decode(conn.Format(), msgBytes)
 
-			resp, err := kai.ChatCompletionStream(chatHistory, callback)
-			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: err.Error(),
-					Id:    getIncomingID(),
-				})
-				continue
+		buckets := []ratelimit.Bucket{ratelimit.BucketFrames}
+		if b := eventBucket(incomingMessageTypes(req.Event)); b != "" {
+			buckets = append(buckets, b)
+		}
+		if res, bucket, disconnect := guard.Check(buckets...); !res.Allowed {
+			conn.SendError(string(outgoingRateLimited), req.Id, rateLimited(bucket, res.RetryAfter))
+			if disconnect {
+				log.Printf("[AI Chat %s] closing connection after repeated rate limit violations", uid)
+				conn.ClosePolicyViolation("rate limit exceeded")
+				return
 			}
+			continue
+		}
 
-			if resp.AIResponse != "" {
-				chatHistory.Messages = append(chatHistory.Messages, models.AIMessage{
-					UniqueId: strings.ToUpper(utils.GenerateID(10)),
-					Message:  resp.AIResponse,
-					Role:     models.Assistant,
-				})
-				_, err = hai.UpdateChatMessages(chatId, uid, chatHistory.Messages)
-				if err != nil {
-					writeJSON(outgoing{
-						Type:  outgoingError,
-						Error: err.Error(),
-						Id:    getIncomingID(),
-					})
-					continue
-				}
-			}
+		if decodeErr != nil {
+			conn.SendError(string(outgoingError), req.Id, decodeErr)
+			continue
+		}
 
-		case incomingUpdateChatTitle:
-			if incoming.ChatId == nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "chatId is required",
-					Id:    getIncomingID(),
-				})
-				continue
-			}
+		reply := func(event outgoingMessageType, data any) error {
+			return conn.Send(string(event), req.Id, data)
+		}
+		fail := func(err error) {
+			conn.SendError(string(outgoingError), req.Id, wsproto.Failed(err))
+		}
 
-			chatId := *incoming.ChatId
-			if strings.TrimSpace(chatId) == "" {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "chatId cannot be empty",
-					Id:    getIncomingID(),
-				})
-				continue
+		// Handle incoming messages
+		switch p := req.Payload.(type) {
+		case *completionRequest:
+			if err := streamCompletion(kai, uid, p.ChatId, p.Message, reply); err != nil {
+				fail(err)
 			}
 
-			updatedChat, err := hai.UpdateChatTitle(chatId, uid)
+		case *titleRequest:
+			updatedChat, err := hai.UpdateChatTitle(p.ChatId, uid)
 			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: err.Error(),
-					Id:    getIncomingID(),
-				})
+				fail(err)
 				continue
 			}
+			reply(outgoingUpdateChatTitle, chatTitle{ChatId: p.ChatId, Title: updatedChat.Title})
 
-			writeJSON(outgoing{
-				Type:   outgoingUpdateChatTitle,
-				Id:     getIncomingID(),
-				ChatId: incoming.ChatId,
-				Data: map[string]any{
-					"title": updatedChat.Title,
-				},
-			})
-
-		case incomingCreateNewChat:
+		case *newChatRequest:
 			chat, err := hai.CreateNewChat(uid)
 			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: err.Error(),
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-
-			writeJSON(outgoing{
-				Type: outgoingCreateNewChat,
-				Id:   getIncomingID(),
-				Data: map[string]any{
-					"chat": chat,
-				},
-			})
-
-		case incomingProfileAbout:
-			data := make(map[string]any)
-			if err := json.Unmarshal(incoming.Data, &data); err != nil {
-				log.Printf("failed to unmarshal profile data: %v", err)
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "invalid data format",
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-
-			if data["user_id"] == nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "user_id is required",
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-
-			if incoming.Message == nil || incoming.ChatId == nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "message and chatId are required",
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-
-			mid := *incoming.Message
-			chatId := *incoming.ChatId
-			if strings.TrimSpace(mid) == "" || strings.TrimSpace(chatId) == "" {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "message and chatId cannot be empty",
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-
-			oldMgs, err := hai.GetChatById(chatId, uid)
-			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: err.Error(),
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-
-			targetUserId, ok := data["user_id"].(string)
-			if !ok {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "invalid user_id format",
-					Id:    getIncomingID(),
-				})
+				fail(err)
 				continue
 			}
+			reply(outgoingCreateNewChat, newChat{Chat: chat})
 
-			targetUserDetails, err := users.GetUserPublicById(targetUserId)
+		case *profileRequest:
+			targetUserDetails, err := users.GetUserPublicById(p.Data.UserId)
 			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: fmt.Sprintf("Failed to get target user details: %v", err),
-					Id:    getIncomingID(),
-				})
+				fail(fmt.Errorf("Failed to get target user details: %v", err))
 				continue
 			}
 
 			tud, err := json.MarshalIndent(targetUserDetails, "", "  ")
 			if err != nil {
 				log.Printf("Failed to marshal target user details: %v", err)
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "Failed to process user details",
-					Id:    getIncomingID(),
-				})
+				fail(errors.New("Failed to process user details"))
 				continue
 			}
 
@@ -552,116 +339,81 @@ func AIChatHandler(c *websocket.Conn) {
 				)),
 			)
 
-			callback := func(chunk models.StreamedResponse) error {
-				return writeJSON(outgoing{
-					MessageChunk: chunk.AIResponse,
-					Type:         outgoingChatCompletionType,
-					Id:           getIncomingID(),
-					ChatId:       incoming.ChatId,
-				})
+			if err := streamCompletion(profileKai, uid, p.ChatId, p.Message, reply); err != nil {
+				fail(err)
 			}
 
-			chatHistory := models.AIChatHistory{
-				Messages: oldMgs.Messages,
-				ChatId:   chatId,
-			}
-			chatHistory.Messages = append(chatHistory.Messages, models.AIMessage{
-				UniqueId: strings.ToUpper(utils.GenerateID(10)),
-				Message:  *incoming.Message,
-				Role:     models.User,
-			})
-
-			resp, err := profileKai.ChatCompletionStream(chatHistory, callback)
+		case *getChatRequest:
+			chat, err := hai.GetChatById(p.ChatId, uid)
 			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: err.Error(),
-					Id:    getIncomingID(),
-				})
+				fail(err)
 				continue
 			}
-			if resp.AIResponse != "" {
-				chatHistory.Messages = append(chatHistory.Messages, models.AIMessage{
-					UniqueId: strings.ToUpper(utils.GenerateID(10)),
-					Message:  resp.AIResponse,
-					Role:     models.Assistant,
-				})
-				_, err = hai.UpdateChatMessages(chatId, uid, chatHistory.Messages)
-				if err != nil {
-					writeJSON(outgoing{
-						Type:  outgoingError,
-						Error: err.Error(),
-						Id:    getIncomingID(),
-					})
-					continue
-				}
-			}
+			reply(outgoingGetChat, chatData{ChatId: p.ChatId, Chat: chat})
 
-		case incomingGetChat:
-			chatId := *incoming.ChatId
-			if strings.TrimSpace(chatId) == "" {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: "message and chatId cannot be empty",
-					Id:    getIncomingID(),
-				})
-				continue
-			}
-			chat, err := hai.GetChatById(chatId, uid)
+		case *getChatsRequest:
+			chats, err := hai.GetAllChatsForUser(uid)
 			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: err.Error(),
-				})
+				fail(err)
 				continue
 			}
+			reply(outgoingGetChats, chatsData{Chats: chats})
 
-			writeJSON(outgoing{
-				Type:   outgoingGetChat,
-				Data:   chat,
-				ChatId: incoming.ChatId,
-			})
-
-		case incomingGetChats:
-			chats, err := hai.GetAllChatsForUser(uid)
+		case *onlineStatusRequest:
+			presence.Heartbeat()
+			online, err := socketstate.NewPublicUserState(uid).GetOnlineStatus()
 			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: err.Error(),
-				})
+				fail(err)
 				continue
 			}
+			reply(outgoingOnlineStatus, onlineData{Online: online})
 
-			writeJSON(outgoing{
-				Type: outgoingGetChats,
-				Data: chats,
-			})
+		case *aiRequest:
+			// Need to implement
+		}
+	}
+}
 
-		case incomingOnlineStatus:
-			userAppState := socketstate.NewPublicUserState(uid)
-			err := userAppState.SetOnlineStatus(true)
-			if err != nil {
-				writeJSON(outgoing{
-					Type:  outgoingError,
-					Error: err.Error(),
-				})
-				continue
-			} else {
-				writeJSON(outgoing{
-					Type: outgoingOnlineStatus,
-					Data: true,
-				})
-			}
+// streamCompletion appends message to the stored chat, streams the answer
+// back as chat_completion chunks and stores it.
+func streamCompletion(
+	kai *ai.KarmaAI,
+	uid string,
+	chatId string,
+	message string,
+	reply func(outgoingMessageType, any) error,
+) error {
+	oldMgs, err := hai.GetChatById(chatId, uid)
+	if err != nil {
+		return err
+	}
 
-		case incomingAIRequest:
-		// Need to implement
+	callback := func(chunk models.StreamedResponse) error {
+		return reply(outgoingChatCompletionType, completionChunk{Message: chunk.AIResponse, ChatId: chatId})
+	}
 
-		default:
-			writeJSON(outgoing{
-				Type:  outgoingError,
-				Error: fmt.Sprintf("unknown message type: %s", incoming.Type),
-				Id:    getIncomingID(),
-			})
-		}
+	chatHistory := models.AIChatHistory{
+		Messages: oldMgs.Messages,
+		ChatId:   chatId,
 	}
+	chatHistory.Messages = append(chatHistory.Messages, models.AIMessage{
+		UniqueId: strings.ToUpper(utils.GenerateID(10)),
+		Message:  message,
+		Role:     models.User,
+	})
+
+	resp, err := kai.ChatCompletionStream(chatHistory, callback)
+	if err != nil {
+		return err
+	}
+	if resp.AIResponse == "" {
+		return nil
+	}
+	chatHistory.Messages = append(chatHistory.Messages, models.AIMessage{
+		UniqueId: strings.ToUpper(utils.GenerateID(10)),
+		Message:  resp.AIResponse,
+		Role:     models.Assistant,
+	})
+	_, err = hai.UpdateChatMessages(chatId, uid, chatHistory.Messages)
+	return err
 }
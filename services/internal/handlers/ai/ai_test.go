@@ -0,0 +1,22 @@
+package ai
+
+import (
+	"blindly/internal/helpers/ratelimit"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBucket(t *testing.T) {
+	cases := map[incomingMessageTypes]ratelimit.Bucket{
+		incomingChatCompletion:  ratelimit.BucketAIRequests,
+		incomingAIRequest:       ratelimit.BucketAIRequests,
+		incomingProfileAbout:    ratelimit.BucketAIRequests,
+		incomingImageGeneration: ratelimit.BucketAIRequests,
+		incomingGetChats:        "",
+		incomingOnlineStatus:    "",
+	}
+	for e, bucket := range cases {
+		assert.Equal(t, bucket, eventBucket(e), e)
+	}
+}
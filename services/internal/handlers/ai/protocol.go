@@ -0,0 +1,206 @@
+package ai
+
+import (
+	"blindly/internal/helpers/ratelimit"
+	"blindly/internal/helpers/wsproto"
+	m "blindly/internal/models"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type incomingMessageTypes string
+
+const (
+	incomingChatCompletion  incomingMessageTypes = "chat_completion"
+	incomingUpdateChatTitle incomingMessageTypes = "update_chat_title"
+	incomingCreateNewChat   incomingMessageTypes = "create_new_chat"
+	incomingProfileAbout    incomingMessageTypes = "profile_about"
+	incomingGetChat         incomingMessageTypes = "get_chat"
+	incomingGetChats        incomingMessageTypes = "get_chats"
+	incomingOnlineStatus    incomingMessageTypes = "online_status"
+	incomingAIRequest       incomingMessageTypes = "ai_request"
+	incomingImageGeneration incomingMessageTypes = "image_generation" // For future use
+)
+
+type outgoingMessageType string
+
+const (
+	outgoingChatCompletionType outgoingMessageType = "chat_completion"
+	outgoingCreateNewChat      outgoingMessageType = "create_new_chat"
+	outgoingUpdateChatTitle    outgoingMessageType = "update_chat_title"
+	outgoingError              outgoingMessageType = "error"
+	outgoingGetChat            outgoingMessageType = "get_chat"
+	outgoingGetChats           outgoingMessageType = "get_chats"
+	outgoingOnlineStatus       outgoingMessageType = "online_status"
+	outgoingAIRequest          outgoingMessageType = "ai_request"
+	outgoingRateLimited        outgoingMessageType = "rate_limited"
+	outgoingImageGeneration    outgoingMessageType = "image_generation" // For future use
+)
+
+// Client payloads, one per event.
+type (
+	completionRequest struct {
+		Message string `json:"message" validate:"required"`
+		ChatId  string `json:"chatId" validate:"required"`
+	}
+	titleRequest struct {
+		ChatId string `json:"chatId" validate:"required"`
+	}
+	newChatRequest struct{}
+	profileTarget  struct {
+		UserId string `json:"user_id" validate:"required"`
+	}
+	profileRequest struct {
+		Message string         `json:"message" validate:"required"`
+		ChatId  string         `json:"chatId" validate:"required"`
+		Data    *profileTarget `json:"data" validate:"required"`
+	}
+	getChatRequest struct {
+		ChatId string `json:"chatId" validate:"required"`
+	}
+	getChatsRequest     struct{}
+	onlineStatusRequest struct{}
+	// aiRequest is accepted but not handled yet.
+	aiRequest struct {
+		Message string          `json:"message"`
+		ChatId  string          `json:"chatId"`
+		Media   []string        `json:"media"`
+		Data    json.RawMessage `json:"data"`
+	}
+)
+
+func (r *completionRequest) Validate() *wsproto.Error {
+	if err := notBlank("message", r.Message); err != nil {
+		return err
+	}
+	return notBlank("chatId", r.ChatId)
+}
+
+func (r *profileRequest) Validate() *wsproto.Error {
+	if err := notBlank("message", r.Message); err != nil {
+		return err
+	}
+	return notBlank("chatId", r.ChatId)
+}
+
+func (r *titleRequest) Validate() *wsproto.Error { return notBlank("chatId", r.ChatId) }
+
+func (r *getChatRequest) Validate() *wsproto.Error { return notBlank("chatId", r.ChatId) }
+
+func notBlank(field string, value string) *wsproto.Error {
+	if strings.TrimSpace(value) == "" {
+		return wsproto.Invalid(field, field+" cannot be empty")
+	}
+	return nil
+}
+
+// Server payloads.
+type (
+	completionChunk struct {
+		Message string `json:"message"`
+		ChatId  string `json:"chatId"`
+	}
+	chatTitle struct {
+		ChatId string `json:"chatId"`
+		Title  string `json:"title"`
+	}
+	newChat struct {
+		Chat string `json:"chat"` // id of the new chat
+	}
+	chatData struct {
+		ChatId string    `json:"chatId"`
+		Chat   *m.AIChat `json:"chat"`
+	}
+	chatsData struct {
+		Chats []m.AIChat `json:"chats"`
+	}
+	onlineData struct {
+		Online bool `json:"online"`
+	}
+)
+
+var protocol = newProtocol()
+
+func newProtocol() *wsproto.Protocol {
+	p := wsproto.NewProtocol("ai", "type")
+
+	p.On(string(incomingChatCompletion), &completionRequest{}, "Ask the assistant; the answer streams back as chat_completion chunks.")
+	p.On(string(incomingUpdateChatTitle), &titleRequest{}, "Let the assistant title a chat.")
+	p.On(string(incomingCreateNewChat), &newChatRequest{}, "Start a new assistant chat.")
+	p.On(string(incomingProfileAbout), &profileRequest{}, "Ask the assistant about another user's profile.")
+	p.On(string(incomingGetChat), &getChatRequest{}, "Load an assistant chat.")
+	p.On(string(incomingGetChats), &getChatsRequest{}, "List your assistant chats.")
+	p.On(string(incomingOnlineStatus), &onlineStatusRequest{}, "Heartbeat; replies with your online status.")
+	p.On(string(incomingAIRequest), &aiRequest{}, "Reserved.")
+
+	p.Emits(string(outgoingChatCompletionType), completionChunk{}, "One chunk of a streamed answer.")
+	p.Emits(string(outgoingUpdateChatTitle), chatTitle{}, "Reply to update_chat_title.")
+	p.Emits(string(outgoingCreateNewChat), newChat{}, "Reply to create_new_chat.")
+	p.Emits(string(outgoingGetChat), chatData{}, "Reply to get_chat.")
+	p.Emits(string(outgoingGetChats), chatsData{}, "Reply to get_chats.")
+	p.Emits(string(outgoingOnlineStatus), onlineData{}, "Reply to online_status.")
+	p.Emits(string(outgoingError), nil, "A frame was rejected or could not be handled.")
+	p.Emits(string(outgoingRateLimited), nil, "A frame was dropped by a rate limit; the code is the bucket.")
+
+	return p
+}
+
+// Protocol describes the assistant socket, for generating its schema.
+func Protocol() *wsproto.Protocol { return protocol }
+
+type legacyOutgoing struct {
+	MessageChunk string              `json:"message"`
+	Type         outgoingMessageType `json:"type"`
+	Id           string              `json:"id"`
+	Error        string              `json:"error,omitempty"`
+	ChatId       *string             `json:"chatId,omitempty"`
+	Data         any                 `json:"data,omitempty"`
+}
+
+// legacyFrame builds the frames unversioned clients read, where chunks go in
+// message and everything else in data.
+func legacyFrame(event string, id string, data any, e *wsproto.Error) any {
+	out := legacyOutgoing{Type: outgoingMessageType(event), Id: id}
+	switch d := data.(type) {
+	case completionChunk:
+		out.MessageChunk, out.ChatId = d.Message, &d.ChatId
+	case chatTitle:
+		out.ChatId, out.Data = &d.ChatId, map[string]any{"title": d.Title}
+	case newChat:
+		out.Data = map[string]any{"chat": d.Chat}
+	case chatData:
+		out.ChatId, out.Data = &d.ChatId, d.Chat
+	case chatsData:
+		out.Data = d.Chats
+	case onlineData:
+		out.Data = d.Online
+	}
+	if e != nil {
+		out.Error = e.Message
+		if out.Type == outgoingRateLimited {
+			out.Data = map[string]any{"bucket": e.Code, "retry_after_ms": e.RetryAfter}
+		}
+	}
+	return out
+}
+
+func rateLimited(bucket ratelimit.Bucket, retryAfter time.Duration) *wsproto.Error {
+	return &wsproto.Error{
+		Code:       string(bucket),
+		Message:    fmt.Sprintf("too many %s, slow down", bucket),
+		RetryAfter: retryAfter.Milliseconds(),
+	}
+}
+
+// eventBucket maps client events to the rate limit bucket they draw from, on
+// top of the per-frame limit.
+func eventBucket(t incomingMessageTypes) ratelimit.Bucket {
+	switch t {
+	case incomingChatCompletion, incomingAIRequest, incomingProfileAbout, incomingImageGeneration:
+		return ratelimit.BucketAIRequests
+	default:
+		return ""
+	}
+}
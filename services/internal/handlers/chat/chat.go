@@ -2,11 +2,14 @@ package chat
 
 import (
 	chatservice "blindly/internal/chat_service"
+	"blindly/internal/helpers/ratelimit"
+	"blindly/internal/helpers/wsproto"
 	"blindly/internal/models"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -55,66 +58,158 @@ func FlushHandler(c *fiber.Ctx) error {
 	return c.Status(http.StatusOK).JSON(fiber.Map{"success": true})
 }
 
-type events string
+// ViewOncePurgeHandler is called by QStash once a view-once grant has expired
+// to delete the opened file.
+func ViewOncePurgeHandler(c *fiber.Ctx) error {
+	signature := c.Get("Upstash-Signature")
+	if signature == "" {
+		log.Println("Missing Upstash-Signature header")
+		return fiber.ErrUnauthorized
+	}
 
-const (
-	// Chat events
-	messageSent     events = "message_sent"
-	messageReceived events = "message_received"
-	messageSeen     events = "message_seen"
-	// messageDeleted  events = "message_deleted" -> Future feature
-	messageUpdated  events = "message_updated"
-	typingStarted   events = "typing_started"
-	typingStopped   events = "typing_stopped"
-	reactionAdded   events = "reaction_added"
-	reactionRemoved events = "reaction_removed"
-
-	// Query events
-	queryMessages events = "query_messages"
-
-	// Service events
-	errorEvent           events = "error"
-	unauthorizedEvent    events = "unauthorized"
-	endChatEvent         events = "end_chat"
-	messagesQuerySuccess events = "messages_query_success"
-)
+	backendURL := config.GetEnvRaw("BACKEND_URL")
+	purgeURL := fmt.Sprintf("%s/v1/chat/view-once/purge", backendURL)
 
-type reaction struct {
-	MessageId string `json:"message_id"`
-	Reaction  string `json:"reaction"`
-}
+	if err := chatservice.VerifyQStashSignature(signature, c.Body(), purgeURL); err != nil {
+		log.Printf("Invalid Upstash-Signature header: %v", err)
+		return fiber.ErrUnauthorized
+	}
+
+	req := new(chatservice.ViewOncePurgeRequest)
+	if err := c.BodyParser(req); err != nil || req.FileId == "" {
+		log.Println("Failed to parse purge request body")
+		return fiber.ErrBadRequest
+	}
+
+	if err := chatservice.PurgeViewOnceFile(req.FileId); err != nil {
+		log.Printf("view-once purge failed for file %s: %v", req.FileId, err)
+		return fiber.ErrServiceUnavailable
+	}
 
-type messageQuery struct {
-	Limit    int    `json:"limit"`
-	BeforeId string `json:"before_id"`
+	return c.Status(http.StatusOK).JSON(fiber.Map{"success": true})
 }
 
-type incomingMedia struct {
-	Type      string    `json:"type"`
-	Url       string    `json:"url"`
-	CreatedAt time.Time `json:"created_at"`
+// ScheduledDeliveryHandler is called by QStash when a scheduled message is
+// due.
+func ScheduledDeliveryHandler(c *fiber.Ctx) error {
+	signature := c.Get("Upstash-Signature")
+	if signature == "" {
+		log.Println("Missing Upstash-Signature header")
+		return fiber.ErrUnauthorized
+	}
+
+	backendURL := config.GetEnvRaw("BACKEND_URL")
+	deliverURL := fmt.Sprintf("%s/v1/chat/scheduled/deliver", backendURL)
+
+	if err := chatservice.VerifyQStashSignature(signature, c.Body(), deliverURL); err != nil {
+		log.Printf("Invalid Upstash-Signature header: %v", err)
+		return fiber.ErrUnauthorized
+	}
+
+	req := new(chatservice.ScheduledDeliveryRequest)
+	if err := c.BodyParser(req); err != nil || req.Id == "" {
+		log.Println("Failed to parse scheduled delivery request body")
+		return fiber.ErrBadRequest
+	}
+
+	if err := chatservice.DeliverScheduledMessage(req.Id); err != nil {
+		log.Printf("scheduled delivery failed for %s: %v", req.Id, err)
+		return fiber.ErrServiceUnavailable
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"success": true})
 }
 
-type incomingMessage struct {
-	Id        *string            `json:"id"` //For updating
-	Type      models.MessageType `json:"type"`
-	Content   string             `json:"content"`
-	Media     []incomingMedia    `json:"media"`
-	CreatedAt time.Time          `json:"created_at"`
+// CheckInDueHandler is called by QStash once a date check-in's grace period
+// is over, to alert trusted contacts if it was missed.
+func CheckInDueHandler(c *fiber.Ctx) error {
+	signature := c.Get("Upstash-Signature")
+	if signature == "" {
+		log.Println("Missing Upstash-Signature header")
+		return fiber.ErrUnauthorized
+	}
+
+	backendURL := config.GetEnvRaw("BACKEND_URL")
+	dueURL := fmt.Sprintf("%s/v1/chat/checkins/due", backendURL)
+
+	if err := chatservice.VerifyQStashSignature(signature, c.Body(), dueURL); err != nil {
+		log.Printf("Invalid Upstash-Signature header: %v", err)
+		return fiber.ErrUnauthorized
+	}
+
+	req := new(chatservice.CheckInDueRequest)
+	if err := c.BodyParser(req); err != nil || req.Id == "" {
+		log.Println("Failed to parse check-in request body")
+		return fiber.ErrBadRequest
+	}
+
+	if err := chatservice.AlertMissedCheckIn(req.Id); err != nil {
+		log.Printf("check-in alert failed for %s: %v", req.Id, err)
+		return fiber.ErrServiceUnavailable
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"success": true})
 }
 
-var incoming struct {
-	Message      *incomingMessage `json:"message"`
-	Reaction     *reaction        `json:"reaction"`
-	Event        events           `json:"event"`
-	MarkSeen     []string         `json:"mark_seen"`
-	MessageQuery *messageQuery    `json:"message_query"`
+// ExportRunHandler is called by QStash to build a requested chat transcript.
+func ExportRunHandler(c *fiber.Ctx) error {
+	signature := c.Get("Upstash-Signature")
+	if signature == "" {
+		log.Println("Missing Upstash-Signature header")
+		return fiber.ErrUnauthorized
+	}
+
+	backendURL := config.GetEnvRaw("BACKEND_URL")
+	runURL := fmt.Sprintf("%s/v1/chat/exports/run", backendURL)
+
+	if err := chatservice.VerifyQStashSignature(signature, c.Body(), runURL); err != nil {
+		log.Printf("Invalid Upstash-Signature header: %v", err)
+		return fiber.ErrUnauthorized
+	}
+
+	req := new(chatservice.ExportRequest)
+	if err := c.BodyParser(req); err != nil || req.Id == "" {
+		log.Println("Failed to parse export request body")
+		return fiber.ErrBadRequest
+	}
+
+	if err := chatservice.RunExport(req.Id); err != nil {
+		log.Printf("export failed for %s: %v", req.Id, err)
+		return fiber.ErrServiceUnavailable
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"success": true})
 }
 
-type outgoing struct {
-	Messages []models.Message `json:"message"`
-	Event    events           `json:"event"`
-	Error    string           `json:"error"`
+// DatesICSHandler exports the caller's planned dates as an iCalendar file:
+// one date when a dateId is given, otherwise all upcoming dates, optionally
+// for a single match_id.
+func DatesICSHandler(c *fiber.Ctx) error {
+	uid, ok := c.Locals("uid").(string)
+	if !ok {
+		return fiber.ErrUnauthorized
+	}
+
+	dateId := c.Params("dateId")
+	dates, err := chatservice.PlannedDates(uid, c.Query("match_id"), dateId == "")
+	if err != nil {
+		log.Printf("failed to export dates for %s: %v", uid, err)
+		return fiber.ErrInternalServerError
+	}
+
+	filename := "blindly-dates.ics"
+	if dateId != "" {
+		idx := slices.IndexFunc(dates, func(d models.PlannedDate) bool { return d.Id == dateId })
+		if idx < 0 {
+			return fiber.ErrNotFound
+		}
+		dates = dates[idx : idx+1]
+		filename = fmt.Sprintf("blindly-date-%s.ics", dateId)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+	return c.Status(http.StatusOK).Send(chatservice.DatesICS(dates, time.Now()))
 }
 
 const (
@@ -137,14 +232,12 @@ func WSHandler(c *websocket.Conn) {
 		return
 	}
 
-	var writeMu sync.Mutex
-	writeJSON := func(v any) error {
-		writeMu.Lock()
-		defer writeMu.Unlock()
-		c.SetWriteDeadline(time.Now().Add(writeWait))
-		err := c.WriteJSON(v)
-		c.SetWriteDeadline(time.Time{}) // Clear deadline after write
-		return err
+	format, verr := wsproto.Negotiate(c)
+	conn := wsproto.NewConn(c, format, writeWait, legacyFrame)
+	if verr != nil {
+		conn.SendError(string(errorEvent), "", verr)
+		c.Close()
+		return
 	}
 
 	c.SetPongHandler(func(string) error {
@@ -160,21 +253,34 @@ func WSHandler(c *websocket.Conn) {
 	store, err := chatservice.NewStore(chatId, userId)
 	if err != nil {
 		if err == chatservice.ErrUnauthorized {
-			c.WriteJSON(outgoing{
-				Event: unauthorizedEvent,
-				Error: "you are not a participant of this chat",
+			conn.SendError(string(unauthorizedEvent), "", &wsproto.Error{
+				Code:    codeUnauthorized,
+				Message: "you are not a participant of this chat",
 			})
 		} else {
-			c.WriteJSON(outgoing{
-				Event: errorEvent,
-				Error: err.Error(),
-			})
+			conn.SendError(string(errorEvent), "", wsproto.Failed(err))
 		}
 		c.Close()
 		return
 	}
 	defer store.Close()
 
+	// Each socket gets its own id so the user's other devices still receive
+	// what this one sends.
+	connId := strings.ToUpper(utils.GenerateID(12))
+	store.SetOrigin(connId)
+
+	if err := conn.Hello(connId); err != nil {
+		log.Printf("[%s] failed to greet client: %v", chatId, err)
+		c.Close()
+		return
+	}
+
+	presence := chatservice.TrackPresence(userId, connId)
+	defer presence.Close()
+
+	guard := ratelimit.NewGuard(ratelimit.Shared(), userId)
+
 	sub := store.Subscribe()
 	defer sub.Close()
 
@@ -190,13 +296,7 @@ func WSHandler(c *websocket.Conn) {
 			case <-done:
 				return
 			case <-ticker.C:
-				writeMu.Lock()
-				c.SetWriteDeadline(time.Now().Add(writeWait))
-				err := c.WriteMessage(websocket.PingMessage, nil)
-				// Clear write deadline after write attempt
-				c.SetWriteDeadline(time.Time{})
-				writeMu.Unlock()
-				if err != nil {
+				if err := conn.Ping(); err != nil {
 					pingFailures++
 					log.Printf("[%s] ping failed (attempt %d/%d): %v", chatId, pingFailures, maxPingRetries, err)
 					if pingFailures >= maxPingRetries {
@@ -221,15 +321,19 @@ func WSHandler(c *websocket.Conn) {
 			}
 			switch event.Type {
 			case chatservice.MessageEventMessage:
-				if event.Message == nil || event.Message.SenderId == userId {
+				if event.Message == nil || event.IsEcho(connId) {
 					continue
 				}
-				writeJSON(outgoing{
-					Messages: []models.Message{
-						*event.Message,
-					},
-					Event: messageSent,
-				})
+				if err := conn.Send(string(messageSent), "", messagesData{
+					Messages: []models.Message{*event.Message},
+				}); err != nil {
+					log.Printf("failed to deliver message %s: %v", event.Message.Id, err)
+					continue
+				}
+				// The frame reached this socket, so the message is delivered.
+				if err := markDelivered(store, event.Message, userId); err != nil {
+					log.Printf("failed to mark message %s received: %v", event.Message.Id, err)
+				}
 
 			case chatservice.MessageEventTyping:
 				if event.Data == nil {
@@ -245,111 +349,46 @@ func WSHandler(c *websocket.Conn) {
 					continue
 				}
 				if typingData.IsTyping {
-					writeJSON(outgoing{
-						Event: typingStarted,
-					})
+					conn.Send(string(typingStarted), "", empty{})
 				} else {
-					writeJSON(outgoing{
-						Event: typingStopped,
-					})
+					conn.Send(string(typingStopped), "", empty{})
 				}
 
 			case chatservice.MessageEventUpdate:
-				if event.Message == nil || event.Message.SenderId == userId {
-					continue
-				}
-				if event.Message.CreatedAt != event.Message.UpdatedAt {
-					writeJSON(outgoing{
-						Event: messageUpdated,
-						Messages: []models.Message{
-							*event.Message,
-						},
-					})
-				} else {
-					if event.Message.Received {
-						writeJSON(outgoing{
-							Event: messageReceived,
-							Messages: []models.Message{
-								*event.Message,
-							},
-						})
-					} else if event.Message.Seen {
-						writeJSON(outgoing{
-							Event: messageSeen,
-							Messages: []models.Message{
-								*event.Message,
-							},
-						})
-					}
+				if data, ok := updateFrame(event, connId); ok {
+					conn.Send(string(messageUpdated), "", data)
 				}
 
-			case chatservice.MessageEventSeen:
-				if event.Data == nil {
+			case chatservice.MessageEventSeen, chatservice.MessageEventReceived:
+				outEvent, messageIds, ok := receiptFrame(event, connId, userId)
+				if !ok {
 					continue
 				}
-
-				seenData := make(map[string]any)
-				if err := json.Unmarshal(event.Data, &seenData); err != nil {
-					log.Printf("failed to unmarshal seen data: %v", err)
-					continue
+				if err := conn.Send(string(outEvent), "", messagesData{
+					Messages: receiptMessages(store, messageIds),
+				}); err != nil {
+					log.Printf("failed to write %s JSON to client: %v", outEvent, err)
 				}
 
-				if seenUserId, ok := seenData["user_id"].(string); ok && seenUserId == userId {
+			case chatservice.MessageEventCall:
+				var sig chatservice.CallSignal
+				if err := json.Unmarshal(event.Data, &sig); err != nil || event.IsEcho(connId) {
 					continue
 				}
-
-				idsAny, ok := seenData["message_ids"].([]any)
-				if !ok {
-					if sids, ok2 := seenData["message_ids"].([]string); ok2 {
-						idsAny = make([]any, len(sids))
-						for i, v := range sids {
-							idsAny[i] = v
-						}
-					} else {
-						log.Printf("failed to get message ids from seen data, got type %T", seenData["message_ids"])
-						continue
-					}
-				}
-
-				mgsSeenPtr := make([]*models.Message, len(idsAny))
-				var wg sync.WaitGroup
-				for i, raw := range idsAny {
-					mid, ok := raw.(string)
-					if !ok {
-						continue
-					}
-
-					wg.Add(1)
-					go func(idx int, msgID string) {
-						defer wg.Done()
-						m, err := store.GetMessageById(msgID)
-						if err != nil {
-							log.Printf("failed to get message by id %s: %v", msgID, err)
-							return
-						}
-						if m == nil {
-							log.Printf("store.GetMessageById returned nil for id %s", msgID)
-							return
-						}
-						mgsSeenPtr[idx] = m
-					}(i, mid)
-				}
-				wg.Wait()
-
-				var mgsSeen []models.Message
-				mgsSeen = make([]models.Message, 0, len(mgsSeenPtr))
-				for _, mp := range mgsSeenPtr {
-					if mp != nil {
-						mgsSeen = append(mgsSeen, *mp)
-					}
+				// The user's other devices only need to know the call was
+				// picked up or ended, so they stop ringing.
+				if sig.UserId == userId && sig.Type != chatservice.CallAnswer &&
+					sig.Type != chatservice.CallHangup && sig.Type != chatservice.CallRingTimeout {
+					continue
 				}
+				conn.Send(string(callSignal), "", callData{Call: &sig})
 
-				if err := writeJSON(outgoing{
-					Event:    messageSeen,
-					Messages: mgsSeen,
-				}); err != nil {
-					log.Printf("failed to write message seen JSON to client: %v", err)
+			case chatservice.MessageEventPresence:
+				var p chatservice.PresenceEvent
+				if err := json.Unmarshal(event.Data, &p); err != nil || p.UserId == userId {
+					continue
 				}
+				conn.Send(string(presenceChanged), "", presenceData{Presence: &p})
 			}
 		}
 	}()
@@ -365,203 +404,331 @@ func WSHandler(c *websocket.Conn) {
 			return
 		}
 		c.SetReadDeadline(time.Now().Add(pongWait))
-		if err := json.Unmarshal(msgBytes, &incoming); err != nil {
-			writeJSON(outgoing{
-				Event: errorEvent,
-				Error: err.Error(),
-			})
+
+		// Every frame counts against the limits, even one that fails to
+		// decode.
+		req, decodeErr := protocol.Decode(conn.Format(), msgBytes)
+
+		buckets := []ratelimit.Bucket{ratelimit.BucketFrames}
+		if b := eventBucket(events(req.Event)); b != "" {
+			buckets = append(buckets, b)
+		}
+		if res, bucket, disconnect := guard.Check(buckets...); !res.Allowed {
+			conn.SendError(string(rateLimitedEvent), req.Id, rateLimited(bucket, res.RetryAfter))
+			if disconnect {
+				log.Printf("[%s] closing connection for %s after repeated rate limit violations", chatId, userId)
+				conn.ClosePolicyViolation("rate limit exceeded")
+				return
+			}
+			continue
+		}
+
+		if decodeErr != nil {
+			conn.SendError(string(errorEvent), req.Id, decodeErr)
 			continue
 		}
 
-		switch incoming.Event {
-		case messageSent:
-			if incoming.Message == nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: "message is required",
-				})
+		reply := func(event events, data any) error {
+			return conn.Send(string(event), req.Id, data)
+		}
+		fail := func(e *wsproto.Error) {
+			conn.SendError(string(errorEvent), req.Id, e)
+		}
+
+		switch p := req.Payload.(type) {
+		case *sendRequest:
+			userMgs, err := buildMessage(userId, p.Message)
+			if err != nil {
+				fail(requestError(err))
 				continue
 			}
-			userMgs := &models.Message{
-				Id:        strings.ToUpper(utils.GenerateID(20)),
-				SenderId:  userId,
-				Content:   incoming.Message.Content,
-				CreatedAt: incoming.Message.CreatedAt,
-				UpdatedAt: incoming.Message.CreatedAt,
-				Type:      incoming.Message.Type,
-			}
-			if len(incoming.Message.Media) > 0 {
-				for _, media := range incoming.Message.Media {
-					userMgs.Media = append(userMgs.Media, models.Media{
-						Id:        strings.ToUpper(utils.GenerateID(20)),
-						Type:      media.Type,
-						Url:       media.Url,
-						CreatedAt: media.CreatedAt,
-					})
-				}
-			}
 			if err := store.SendMessage(userMgs); err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
-			}
-		case messageUpdated:
-			if incoming.Message == nil || incoming.Message.Id == nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: "message is required",
-				})
-				continue
+				fail(requestError(err))
 			}
+		case *editRequest:
 			userMgs := &models.Message{
-				Id:       *incoming.Message.Id,
+				Id:       *p.Message.Id,
 				SenderId: userId,
-				Content:  incoming.Message.Content,
-			}
-			if len(incoming.Message.Media) > 0 {
-				for _, media := range incoming.Message.Media {
-					userMgs.Media = append(userMgs.Media, models.Media{
-						Id:        strings.ToUpper(utils.GenerateID(20)),
-						Type:      media.Type,
-						Url:       media.Url,
-						CreatedAt: media.CreatedAt,
-					})
+				Content:  p.Message.Content,
+			}
+			if userMgs.Content != "" {
+				// Edits cannot be held, so anything above a mask is refused.
+				verdict := store.Moderate(userId, userMgs.Content)
+				if verdict.Action >= chatservice.ModerationHold {
+					fail(requestError(&chatservice.ModerationError{Action: chatservice.ModerationReject, Reason: verdict.Reason}))
+					continue
+				}
+				if verdict.Action == chatservice.ModerationMask {
+					userMgs.Content = verdict.Content
+					fail(requestError(&chatservice.ModerationError{Action: verdict.Action, Reason: verdict.Reason}))
+				}
+			}
+			if len(p.Message.Media) > 0 {
+				media, err := buildMedia(userId, p.Message.Media)
+				if err != nil {
+					fail(requestError(err))
+					continue
 				}
+				userMgs.Media = media
 			}
-			if _, err := store.UpdateMessage(*incoming.Message.Id, userMgs); err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
+			if _, err := store.EditMessage(userMgs.Id, userId, userMgs.Content, userMgs.Media); err != nil {
+				fail(requestError(err))
 			}
-		case typingStarted:
+		case *scheduleRequest:
+			userMgs, err := buildMessage(userId, p.Message)
+			if err != nil {
+				fail(requestError(err))
+				continue
+			}
+			scheduled, err := store.ScheduleMessage(userMgs, *p.DeliverAt)
+			if err != nil {
+				fail(requestError(err))
+				continue
+			}
+			reply(messageScheduled, scheduledData{Scheduled: []models.ScheduledMessage{*scheduled}})
+		case *listScheduledRequest:
+			scheduled, err := store.ListScheduledMessages(userId)
+			if err != nil {
+				fail(requestError(err))
+				continue
+			}
+			reply(scheduledList, scheduledData{Scheduled: scheduled})
+		case *cancelScheduledRequest:
+			if err := store.CancelScheduledMessage(p.ScheduledId, userId); err != nil {
+				fail(requestError(err))
+				continue
+			}
+			reply(scheduledRemoved, scheduledData{
+				Scheduled: []models.ScheduledMessage{{Id: p.ScheduledId, Status: chatservice.ScheduledCancelled}},
+			})
+		case *typingStartRequest:
 			if err := store.SendTypingEvent(userId); err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
+				fail(requestError(err))
 			}
-		case typingStopped:
+		case *typingStopRequest:
 			if err := store.StopTypingEvent(userId); err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
-			}
-		case reactionAdded:
-			if incoming.Reaction == nil || incoming.Reaction.MessageId == "" || incoming.Reaction.Reaction == "" {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: "reaction is required",
-				})
+				fail(requestError(err))
+			}
+		case *addReactionRequest:
+			if _, err := store.AddReaction(p.Reaction.MessageId, userId, p.Reaction.Reaction); err != nil {
+				fail(requestError(err))
+			}
+		case *removeReactionRequest:
+			if _, err := store.RemoveReaction(p.Reaction.MessageId, userId); err != nil {
+				fail(requestError(err))
+			}
+		case *receivedRequest:
+			if err := store.MarkMessagesReceived([]string{p.Message.Id}, userId); err != nil {
+				fail(requestError(err))
+			}
+		case *seenRequest:
+			if err := store.MarkMessagesSeen(p.MarkSeen, userId); err != nil {
+				fail(requestError(err))
+			}
+		case *queryRequest:
+			limit := p.MessageQuery.Limit
+			if limit == 0 {
+				limit = 10
+			}
+			mgs, err := store.GetMessages(limit, p.MessageQuery.BeforeId)
+			if err != nil {
+				fail(requestError(err))
+				continue
+			}
+			if err := reply(messagesQuerySuccess, messagesData{Messages: mgs}); err != nil {
+				continue
+			}
+			// Acknowledge everything delivered during catch-up in one receipt.
+			var undelivered []string
+			for _, m := range mgs {
+				if m.SenderId != userId && !m.Received {
+					undelivered = append(undelivered, m.Id)
+				}
+			}
+			if err := store.MarkMessagesReceived(undelivered, userId); err != nil {
+				log.Printf("[%s] failed to mark catch-up messages received: %v", chatId, err)
+			}
+		case *searchRequest:
+			result, err := store.SearchMessages(p.MessageSearch.Query, p.MessageSearch.Limit, p.MessageSearch.Cursor)
+			if err != nil {
+				fail(requestError(err))
 				continue
 			}
-			mgs, err := store.GetMessageById(incoming.Reaction.MessageId)
+			reply(messagesSearchResult, searchData{Search: result})
+		case *viewOnceRequest:
+			grant, err := store.OpenViewOnce(p.ViewOnce.MessageId, p.ViewOnce.MediaId, userId)
 			if err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
+				fail(requestError(err))
 				continue
 			}
-			reaction := models.Reaction{
-				SenderId:  userId,
-				CreatedAt: time.Now(),
-				Content:   incoming.Message.Content,
-				Id:        utils.GenerateID(10),
-			}
-			mgs.Reactions = append(mgs.Reactions, reaction)
-			if _, err := store.UpdateMessage(mgs.Id, mgs); err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
-			}
-		case reactionRemoved:
-			if incoming.Reaction.MessageId == "" {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: "message id is required",
-				})
+			reply(viewOnceGrant, grantData{Grant: grant})
+		case *callRequest:
+			if err := store.Signal(userId, p.Call); err != nil {
+				fail(requestError(err))
 				continue
 			}
-			mgs, err := store.GetMessageById(incoming.Reaction.MessageId)
+			if p.Call.Type == chatservice.CallOffer {
+				// The caller needs the call id to send candidates and hang up.
+				ack := *p.Call
+				ack.Sdp = ""
+				reply(callSignal, callData{Call: &ack})
+			}
+		case *respondDateRequest:
+			resp, err := store.RespondToDateProposal(
+				userId,
+				p.DateResponse.MessageId,
+				p.DateResponse.Action,
+				p.DateResponse.Counter.toModel(),
+			)
 			if err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
+				fail(requestError(err))
 				continue
 			}
-			for i, r := range mgs.Reactions {
-				if r.SenderId == userId {
-					mgs.Reactions = append(mgs.Reactions[:i], mgs.Reactions[i+1:]...)
-					if _, err := store.UpdateMessage(mgs.Id, mgs); err != nil {
-						writeJSON(outgoing{
-							Event: errorEvent,
-							Error: err.Error(),
-						})
-					}
-					continue
-				}
+			reply(dateResponseResult, dateData{Date: resp})
+		case *icebreakersRequest:
+			limit := chatservice.DefaultIcebreakers
+			if p.Icebreakers != nil && p.Icebreakers.Limit > 0 {
+				limit = p.Icebreakers.Limit
 			}
-		case messageReceived:
-			if incoming.Message == nil || incoming.Message.Id == nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: "message id is required",
-				})
+			suggestions, err := store.Icebreakers(userId, limit)
+			if err != nil {
+				fail(requestError(err))
+				continue
 			}
-			mgs, err := store.GetMessageById(*incoming.Message.Id)
+			reply(icebreakersResult, icebreakersData{Icebreakers: suggestions})
+		case *historyRequest:
+			revisions, err := store.MessageRevisions(userId, p.History.MessageId)
 			if err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
-			}
-			mgs.Received = true
-			if _, err := store.UpdateMessage(mgs.Id, mgs); err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
-			}
-		case messageSeen:
-			if len(incoming.MarkSeen) == 0 {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: "mark_seen array is required",
-				})
-			}
-			if err := store.MarkMessagesSeen(incoming.MarkSeen, userId); err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
-			}
-		case queryMessages:
-			if incoming.MessageQuery == nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: "message query is required",
-				})
+				fail(requestError(err))
 				continue
 			}
-			if incoming.MessageQuery.Limit == 0 {
-				incoming.MessageQuery.Limit = 10
+			reply(messageHistoryResult, revisionsData{Revisions: revisions})
+		case *contextRequest:
+			before, after := p.MessageContext.Before, p.MessageContext.After
+			if before == 0 && after == 0 {
+				before, after = chatservice.DefaultContextSize, chatservice.DefaultContextSize
 			}
-			mgs, err := store.GetMessages(incoming.MessageQuery.Limit, incoming.MessageQuery.BeforeId)
+			mgs, err := store.GetMessageContext(p.MessageContext.MessageId, before, after)
 			if err != nil {
-				writeJSON(outgoing{
-					Event: errorEvent,
-					Error: err.Error(),
-				})
-			}
-			writeJSON(outgoing{
-				Event:    messagesQuerySuccess,
-				Messages: mgs,
-			})
+				fail(requestError(err))
+				continue
+			}
+			reply(messageContextResult, messagesData{Messages: mgs})
+		}
+	}
+}
+
+// buildMessage turns a client message into a new message from userId.
+func buildMessage(userId string, in *incomingMessage) (*models.Message, error) {
+	msg := &models.Message{
+		Id:        strings.ToUpper(utils.GenerateID(20)),
+		SenderId:  userId,
+		Content:   in.Content,
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.CreatedAt,
+		Type:      in.Type,
+		ReplyToId: in.ReplyToId,
+	}
+	if in.Type == models.DATE_PROPOSAL {
+		msg.DateProposal = in.DateProposal.toModel()
+	}
+	if len(in.Media) > 0 {
+		media, err := buildMedia(userId, in.Media)
+		if err != nil {
+			return nil, err
+		}
+		msg.Media = media
+	}
+	return msg, nil
+}
+
+// buildMedia converts client media into message media, resolving uploaded
+// files so their URL and metadata come from the server.
+func buildMedia(userId string, incoming []incomingMedia) ([]models.Media, error) {
+	media := make([]models.Media, 0, len(incoming))
+	for _, in := range incoming {
+		m := models.Media{
+			Id:        strings.ToUpper(utils.GenerateID(20)),
+			Type:      in.Type,
+			Url:       in.Url,
+			FileId:    in.FileId,
+			ViewOnce:  in.ViewOnce,
+			CreatedAt: in.CreatedAt,
+		}
+		if err := chatservice.ResolveMediaFile(userId, &m); err != nil {
+			return nil, err
+		}
+		media = append(media, m)
+	}
+	return media, nil
+}
+
+// markDelivered records that msg reached userId, unless userId sent it.
+func markDelivered(store *chatservice.Store, msg *models.Message, userId string) error {
+	if msg.SenderId == userId {
+		return nil
+	}
+	return store.MarkMessagesReceived([]string{msg.Id}, userId)
+}
+
+// updateFrame is the message_updated data an update event becomes on
+// connection connId. Edits, reactions, date answers and view-once openings
+// all reach the peer this way, whatever state the message is in, with change
+// saying which it was; receipts travel as seen and received events instead.
+func updateFrame(event *chatservice.PubSubEvent, connId string) (updateData, bool) {
+	if event.Message == nil || event.IsEcho(connId) {
+		return updateData{}, false
+	}
+	return updateData{Messages: []models.Message{*event.Message}, Change: event.Change}, true
+}
+
+// receiptFrame is the event a seen or received receipt becomes on connection
+// connId of userId, with the ids it covers. Seen state is synced across the
+// user's devices; delivery receipts only matter to the sender.
+func receiptFrame(event *chatservice.PubSubEvent, connId string, userId string) (events, []string, bool) {
+	if event.Data == nil || event.IsEcho(connId) {
+		return "", nil, false
+	}
+
+	var receipt chatservice.ReceiptEvent
+	if err := json.Unmarshal(event.Data, &receipt); err != nil {
+		log.Printf("failed to unmarshal %s data: %v", event.Type, err)
+		return "", nil, false
+	}
+
+	if event.Type == chatservice.MessageEventReceived {
+		if receipt.UserId == userId {
+			return "", nil, false
+		}
+		return messageReceived, receipt.MessageIds, true
+	}
+	return messageSeen, receipt.MessageIds, true
+}
+
+// receiptMessages resolves the messages referenced by a seen or received
+// receipt, skipping any that can no longer be found.
+func receiptMessages(store *chatservice.Store, messageIds []string) []models.Message {
+	msgPtrs := make([]*models.Message, len(messageIds))
+	var wg sync.WaitGroup
+	for i, id := range messageIds {
+		wg.Add(1)
+		go func(idx int, msgID string) {
+			defer wg.Done()
+			m, err := store.GetMessageById(msgID)
+			if err != nil {
+				log.Printf("failed to get message by id %s: %v", msgID, err)
+				return
+			}
+			msgPtrs[idx] = m
+		}(i, id)
+	}
+	wg.Wait()
+
+	msgs := make([]models.Message, 0, len(msgPtrs))
+	for _, mp := range msgPtrs {
+		if mp != nil {
+			msgs = append(msgs, *mp)
 		}
 	}
+	return msgs
 }
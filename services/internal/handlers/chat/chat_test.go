@@ -1,9 +1,14 @@
 package chat
 
 import (
+	chatservice "blindly/internal/chat_service"
+	"blindly/internal/helpers/ratelimit"
+	"blindly/internal/helpers/wsproto"
+	"blindly/internal/models"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -12,6 +17,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
 )
 
 const (
@@ -390,7 +396,7 @@ func TestMessageQueryPagination(t *testing.T) {
 	t.Logf("First query returned %d messages", len(payload1.Messages))
 
 	if len(payload1.Messages) > 0 {
-		beforeID := payload1.Messages[len(payload1.Messages)-1].ID
+		beforeID := payload1.Messages[0].ID
 		t.Logf("Querying before message ID: %s", beforeID)
 
 		err = client1.QueryMessages(5, beforeID)
@@ -581,3 +587,253 @@ func BenchmarkQueryMessages(b *testing.B) {
 		client1.WaitForEvent(MessagesQuerySuccess, 5*time.Second)
 	}
 }
+
+var (
+	legacyFormat = wsproto.Format{Version: wsproto.Legacy, Encoding: wsproto.JSON}
+	v1Format     = wsproto.Format{Version: wsproto.V1, Encoding: wsproto.JSON}
+	packedFormat = wsproto.Format{Version: wsproto.V1, Encoding: wsproto.MessagePack}
+)
+
+func TestProtocolDecode(t *testing.T) {
+	// Legacy frames keep their payload next to the event.
+	req, err := protocol.Decode(legacyFormat, []byte(`{"event":"message_seen","mark_seen":["A","B"],"message":null}`))
+	require.Nil(t, err)
+	seen, ok := req.Payload.(*seenRequest)
+	require.True(t, ok)
+	assert.Equal(t, []string{"A", "B"}, seen.MarkSeen)
+
+	req, err = protocol.Decode(v1Format, []byte(`{"v":1,"event":"reaction_added","id":"r1","data":{"reaction":{"message_id":"M","reaction":"🔥"}}}`))
+	require.Nil(t, err)
+	assert.Equal(t, "r1", req.Id)
+	assert.Equal(t, "M", req.Payload.(*addReactionRequest).Reaction.MessageId)
+
+	// Two frames decoded back to back must not share state.
+	first, err := protocol.Decode(legacyFormat, []byte(`{"event":"message_sent","message":{"type":"TEXT","content":"hi","reply_to_id":"X"}}`))
+	require.Nil(t, err)
+	second, err := protocol.Decode(legacyFormat, []byte(`{"event":"message_sent","message":{"type":"TEXT","content":"yo"}}`))
+	require.Nil(t, err)
+	assert.Equal(t, "X", first.Payload.(*sendRequest).Message.ReplyToId)
+	assert.Empty(t, second.Payload.(*sendRequest).Message.ReplyToId)
+
+	cases := []struct {
+		name   string
+		format wsproto.Format
+		frame  string
+		code   string
+		field  string
+	}{
+		{"garbage", legacyFormat, `not json`, wsproto.CodeInvalidFrame, ""},
+		{"unknown event", v1Format, `{"v":1,"event":"nope"}`, wsproto.CodeUnknownEvent, "event"},
+		{"version mismatch", v1Format, `{"v":2,"event":"typing_started"}`, wsproto.CodeUnsupportedVersion, "v"},
+		{"missing payload", v1Format, `{"v":1,"event":"message_sent","data":{}}`, wsproto.CodeMissingField, "message"},
+		{"missing nested", v1Format, `{"v":1,"event":"open_view_once","data":{"view_once":{"message_id":"M"}}}`, wsproto.CodeMissingField, "view_once.media_id"},
+		{"empty list", legacyFormat, `{"event":"message_seen","mark_seen":[]}`, wsproto.CodeMissingField, "mark_seen"},
+		{"edit without id", v1Format, `{"v":1,"event":"message_updated","data":{"message":{"content":"x"}}}`, wsproto.CodeMissingField, "message.id"},
+		{"unknown field", v1Format, `{"v":1,"event":"typing_started","data":{"typing":true}}`, wsproto.CodeInvalidPayload, ""},
+		{"wrong type", v1Format, `{"v":1,"event":"query_messages","data":{"message_query":{"limit":"ten"}}}`, wsproto.CodeInvalidPayload, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := protocol.Decode(tc.format, []byte(tc.frame))
+			require.NotNil(t, err)
+			assert.Equal(t, tc.code, err.Code)
+			assert.Equal(t, tc.field, err.Field)
+		})
+	}
+}
+
+func TestProtocolDecodeMessagePack(t *testing.T) {
+	frame, err := msgpack.Marshal(map[string]any{
+		"v":     wsproto.V1,
+		"event": "call_signal",
+		"id":    "c1",
+		"data": map[string]any{
+			"call": map[string]any{
+				"type":      "ice_candidate",
+				"call_id":   "CALL",
+				"candidate": map[string]any{"sdpMid": "0", "sdpMLineIndex": 0},
+			},
+		},
+	})
+	require.NoError(t, err)
+	req, derr := protocol.Decode(packedFormat, frame)
+	require.Nil(t, derr)
+	assert.Equal(t, "c1", req.Id)
+	call := req.Payload.(*callRequest).Call
+	assert.Equal(t, "CALL", call.CallId)
+	// Opaque JSON fields arrive as JSON whatever the encoding.
+	assert.JSONEq(t, `{"sdpMid":"0","sdpMLineIndex":0}`, string(call.Candidate))
+
+	frame, err = msgpack.Marshal(map[string]any{
+		"v": wsproto.V1, "event": "typing_started", "data": map[string]any{"typing": true},
+	})
+	require.NoError(t, err)
+	_, derr = protocol.Decode(packedFormat, frame)
+	require.NotNil(t, derr)
+	assert.Equal(t, wsproto.CodeInvalidPayload, derr.Code)
+
+	// A JSON frame on a MessagePack socket is not a frame at all.
+	_, derr = protocol.Decode(packedFormat, []byte(`{"v":1,"event":"typing_started"}`))
+	require.NotNil(t, derr)
+	assert.Equal(t, wsproto.CodeInvalidFrame, derr.Code)
+}
+
+func TestLegacyFrame(t *testing.T) {
+	raw, err := json.Marshal(legacyFrame(string(typingStarted), "", empty{}, nil))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"event":"typing_started","error":"","message":null}`, string(raw))
+
+	raw, err = json.Marshal(legacyFrame(string(rateLimitedEvent), "", nil, &wsproto.Error{
+		Code: "messages", Message: "too many messages, slow down", RetryAfter: 1500,
+	}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"event":"rate_limited","error":"too many messages, slow down","code":"messages","retry_after_ms":1500,"message":null}`, string(raw))
+}
+
+// The schema file is generated; refresh it with `go run . ws-schema chat`.
+func TestProtocolSchema(t *testing.T) {
+	schema, err := protocol.Schema()
+	require.NoError(t, err)
+	golden, err := os.ReadFile("protocol.schema.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, string(golden), string(schema), "protocol.schema.json is out of date")
+}
+
+// memoryChat opens chatId on a fresh memory backend with messages already
+// buffered, as if they had been sent.
+func memoryChat(t *testing.T, chatId string, messages ...models.Message) (*chatservice.Store, chatservice.Backend) {
+	t.Helper()
+	backend := chatservice.NewMemoryBackend()
+	chatservice.UseBackend(backend)
+	t.Cleanup(func() { chatservice.UseBackend(nil) })
+
+	for _, msg := range messages {
+		data, _ := json.Marshal(msg)
+		require.NoError(t, backend.AppendMessage(chatId, string(data), "{}", msg.CreatedAt))
+	}
+	store := chatservice.NewStoreWithoutAuth(chatId)
+	t.Cleanup(func() { store.Close() })
+	return store, backend
+}
+
+func TestReactionIsAnUpdate(t *testing.T) {
+	now := time.Now()
+	store, _ := memoryChat(t, "chat-reaction", models.Message{Id: "m1", SenderId: "user-1", Content: "hi", CreatedAt: now, UpdatedAt: now})
+	store.SetOrigin("conn-2")
+
+	sub := store.Subscribe()
+	defer sub.Close()
+
+	_, err := store.AddReaction("m1", "user-2", "❤️")
+	require.NoError(t, err)
+	event, err := sub.ReceiveEvent()
+	require.NoError(t, err)
+	assert.Equal(t, chatservice.ChangeReaction, event.Change)
+
+	// The message is neither received nor seen and its content is untouched,
+	// yet the peer still learns about the reaction.
+	data, ok := updateFrame(event, "conn-1")
+	require.True(t, ok)
+	assert.Equal(t, chatservice.ChangeReaction, data.Change)
+	require.Len(t, data.Messages, 1)
+	assert.False(t, data.Messages[0].Received || data.Messages[0].Seen)
+	assert.Equal(t, data.Messages[0].CreatedAt, data.Messages[0].UpdatedAt)
+	assert.Len(t, data.Messages[0].Reactions, 1)
+
+	_, ok = updateFrame(event, "conn-2")
+	assert.False(t, ok, "the reacting socket already applied its own reaction")
+}
+
+func TestDeliveryMarksReceived(t *testing.T) {
+	now := time.Now()
+	store, _ := memoryChat(t, "chat-delivery",
+		models.Message{Id: "m1", SenderId: "user-1", Content: "hi", CreatedAt: now},
+		models.Message{Id: "m2", SenderId: "user-2", Content: "hey", CreatedAt: now},
+	)
+	store.SetOrigin("conn-1")
+
+	sub := store.Subscribe()
+	defer sub.Close()
+
+	// user-1's socket gets its own message from another device and user-2's.
+	require.NoError(t, markDelivered(store, &models.Message{Id: "m1", SenderId: "user-1"}, "user-1"))
+	require.NoError(t, markDelivered(store, &models.Message{Id: "m2", SenderId: "user-2"}, "user-1"))
+
+	event, err := sub.ReceiveEvent()
+	require.NoError(t, err)
+	require.Equal(t, chatservice.MessageEventReceived, event.Type)
+	var receipt chatservice.ReceiptEvent
+	require.NoError(t, json.Unmarshal(event.Data, &receipt))
+	assert.Equal(t, []string{"m2"}, receipt.MessageIds)
+	assert.Equal(t, "user-1", receipt.UserId)
+
+	own, err := store.GetMessageById("m1")
+	require.NoError(t, err)
+	assert.False(t, own.Received, "a sender's own message is never marked received")
+	delivered, err := store.GetMessageById("m2")
+	require.NoError(t, err)
+	assert.True(t, delivered.Received)
+}
+
+func TestEchoSkipsOnlyTheOriginSocket(t *testing.T) {
+	now := time.Now()
+	phone, _ := memoryChat(t, "chat-echo",
+		models.Message{Id: "m1", SenderId: "user-2", Content: "hi", CreatedAt: now, UpdatedAt: now},
+		models.Message{Id: "m2", SenderId: "user-2", Content: "there?", CreatedAt: now, UpdatedAt: now},
+	)
+	phone.SetOrigin("conn-phone")
+
+	sub := phone.Subscribe()
+	defer sub.Close()
+
+	// user-1 reads and reacts on their phone; their laptop and user-2 follow.
+	require.NoError(t, phone.MarkMessagesSeen([]string{"m1"}, "user-1"))
+	seen, err := sub.ReceiveEvent()
+	require.NoError(t, err)
+
+	_, _, ok := receiptFrame(seen, "conn-phone", "user-1")
+	assert.False(t, ok, "the phone already shows the message as seen")
+	for _, socket := range []struct{ connId, userId string }{{"conn-laptop", "user-1"}, {"conn-peer", "user-2"}} {
+		event, ids, ok := receiptFrame(seen, socket.connId, socket.userId)
+		assert.True(t, ok, socket.connId)
+		assert.Equal(t, messageSeen, event)
+		assert.Equal(t, []string{"m1"}, ids)
+	}
+
+	_, err = phone.AddReaction("m1", "user-1", "🔥")
+	require.NoError(t, err)
+	reaction, err := sub.ReceiveEvent()
+	require.NoError(t, err)
+	_, ok = updateFrame(reaction, "conn-phone")
+	assert.False(t, ok)
+	_, ok = updateFrame(reaction, "conn-laptop")
+	assert.True(t, ok, "the sender's other device still gets the reaction")
+
+	// Delivery receipts are for the sender alone, even on the other device.
+	require.NoError(t, markDelivered(phone, &models.Message{Id: "m2", SenderId: "user-2"}, "user-1"))
+	received, err := sub.ReceiveEvent()
+	require.NoError(t, err)
+	_, _, ok = receiptFrame(received, "conn-laptop", "user-1")
+	assert.False(t, ok)
+	event, _, ok := receiptFrame(received, "conn-peer", "user-2")
+	assert.True(t, ok)
+	assert.Equal(t, messageReceived, event)
+}
+
+func TestEventBucket(t *testing.T) {
+	cases := map[events]ratelimit.Bucket{
+		messageSent:     ratelimit.BucketMessages,
+		messageUpdated:  ratelimit.BucketMessages,
+		scheduleMessage: ratelimit.BucketMessages,
+		respondDate:     ratelimit.BucketMessages,
+		typingStarted:   ratelimit.BucketTyping,
+		typingStopped:   ratelimit.BucketTyping,
+		reactionAdded:   ratelimit.BucketReactions,
+		reactionRemoved: ratelimit.BucketReactions,
+		messageSeen:     "",
+		queryMessages:   "",
+	}
+	for e, bucket := range cases {
+		assert.Equal(t, bucket, eventBucket(e), e)
+	}
+}
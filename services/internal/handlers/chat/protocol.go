@@ -0,0 +1,371 @@
+package chat
+
+import (
+	chatservice "blindly/internal/chat_service"
+	"blindly/internal/helpers/ratelimit"
+	"blindly/internal/helpers/wsproto"
+	"blindly/internal/models"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type events string
+
+const (
+	// Chat events
+	messageSent     events = "message_sent"
+	messageReceived events = "message_received"
+	messageSeen     events = "message_seen"
+	// messageDeleted  events = "message_deleted" -> Future feature
+	messageUpdated  events = "message_updated"
+	typingStarted   events = "typing_started"
+	typingStopped   events = "typing_stopped"
+	reactionAdded   events = "reaction_added"
+	reactionRemoved events = "reaction_removed"
+	respondDate     events = "respond_date"
+	callSignal      events = "call_signal" // both directions; call.type says which signal
+
+	// Scheduled message events
+	scheduleMessage  events = "schedule_message"
+	listScheduled    events = "list_scheduled"
+	cancelScheduled  events = "cancel_scheduled"
+	messageScheduled events = "message_scheduled"
+	scheduledList    events = "scheduled_list"
+	scheduledRemoved events = "scheduled_cancelled"
+
+	// Query events
+	queryMessages  events = "query_messages"
+	searchMessages events = "search_messages"
+	messageContext events = "message_context"
+	openViewOnce   events = "open_view_once"
+	getIcebreakers events = "get_icebreakers"
+	messageHistory events = "message_history"
+
+	// Service events
+	errorEvent           events = "error"
+	rateLimitedEvent     events = "rate_limited"
+	unauthorizedEvent    events = "unauthorized"
+	messagesQuerySuccess events = "messages_query_success"
+	messagesSearchResult events = "messages_search_success"
+	messageContextResult events = "message_context_success"
+	viewOnceGrant        events = "view_once_grant"
+	presenceChanged      events = "presence"
+	icebreakersResult    events = "icebreakers_success"
+	messageHistoryResult events = "message_history_success"
+	dateResponseResult   events = "date_response_success"
+)
+
+// Error codes beyond the protocol's own; moderation errors use their reason
+// and rate limits their bucket.
+const (
+	codeUnauthorized   = "unauthorized"
+	codeAlreadyOpened  = "already_opened"
+	codeCallsLocked    = "calls_locked"
+	codeCallInProgress = "call_in_progress"
+	codeNoActiveCall   = "no_active_call"
+)
+
+type reaction struct {
+	MessageId string `json:"message_id" validate:"required"`
+	Reaction  string `json:"reaction" validate:"required"`
+}
+
+type reactionRef struct {
+	MessageId string `json:"message_id" validate:"required"`
+}
+
+type messageRef struct {
+	Id string `json:"id" validate:"required"`
+}
+
+type messageQuery struct {
+	Limit    int    `json:"limit"`
+	BeforeId string `json:"before_id"`
+}
+
+type messageSearch struct {
+	Query  string `json:"query"`
+	Limit  int    `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+type messageContextQuery struct {
+	MessageId string `json:"message_id" validate:"required"`
+	Before    int    `json:"before"`
+	After     int    `json:"after"`
+}
+
+type icebreakerQuery struct {
+	Limit int `json:"limit"`
+}
+
+type incomingDateProposal struct {
+	StartsAt time.Time `json:"starts_at"`
+	Venue    string    `json:"venue"`
+	Note     string    `json:"note"`
+}
+
+func (p *incomingDateProposal) toModel() *models.DateProposal {
+	if p == nil {
+		return nil
+	}
+	return &models.DateProposal{StartsAt: p.StartsAt, Venue: p.Venue, Note: p.Note}
+}
+
+type dateResponse struct {
+	MessageId string                 `json:"message_id" validate:"required"`
+	Action    chatservice.DateAction `json:"action" validate:"required"` // ACCEPT, DECLINE or COUNTER
+	Counter   *incomingDateProposal  `json:"counter"`
+}
+
+type historyQuery struct {
+	MessageId string `json:"message_id" validate:"required"`
+}
+
+type incomingMedia struct {
+	Type      string    `json:"type"`
+	Url       string    `json:"url"`
+	FileId    string    `json:"file_id"` // id from /v1/fs/upload; carries server-computed metadata
+	ViewOnce  bool      `json:"view_once"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type viewOnceOpen struct {
+	MessageId string `json:"message_id" validate:"required"`
+	MediaId   string `json:"media_id" validate:"required"`
+}
+
+type incomingMessage struct {
+	Id        *string            `json:"id"` //For updating
+	Type      models.MessageType `json:"type"`
+	Content   string             `json:"content"`
+	Media     []incomingMedia    `json:"media"`
+	ReplyToId string             `json:"reply_to_id"`
+	CreatedAt time.Time          `json:"created_at"`
+	// DateProposal is required for DATE_PROPOSAL messages.
+	DateProposal *incomingDateProposal `json:"date_proposal"`
+}
+
+// Client payloads, one per event. Each is decoded fresh for every frame.
+type (
+	sendRequest struct {
+		Message *incomingMessage `json:"message" validate:"required"`
+	}
+	editRequest struct {
+		Message *incomingMessage `json:"message" validate:"required"`
+	}
+	scheduleRequest struct {
+		Message   *incomingMessage `json:"message" validate:"required"`
+		DeliverAt *time.Time       `json:"deliver_at" validate:"required"`
+	}
+	listScheduledRequest   struct{}
+	cancelScheduledRequest struct {
+		ScheduledId string `json:"scheduled_id" validate:"required"`
+	}
+	typingStartRequest struct{}
+	typingStopRequest  struct{}
+	addReactionRequest struct {
+		Reaction *reaction `json:"reaction" validate:"required"`
+	}
+	removeReactionRequest struct {
+		Reaction *reactionRef `json:"reaction" validate:"required"`
+	}
+	receivedRequest struct {
+		Message *messageRef `json:"message" validate:"required"`
+	}
+	seenRequest struct {
+		MarkSeen []string `json:"mark_seen" validate:"required"`
+	}
+	queryRequest struct {
+		MessageQuery *messageQuery `json:"message_query" validate:"required"`
+	}
+	searchRequest struct {
+		MessageSearch *messageSearch `json:"message_search" validate:"required"`
+	}
+	contextRequest struct {
+		MessageContext *messageContextQuery `json:"message_context" validate:"required"`
+	}
+	viewOnceRequest struct {
+		ViewOnce *viewOnceOpen `json:"view_once" validate:"required"`
+	}
+	callRequest struct {
+		Call *chatservice.CallSignal `json:"call" validate:"required"`
+	}
+	respondDateRequest struct {
+		DateResponse *dateResponse `json:"date_response" validate:"required"`
+	}
+	icebreakersRequest struct {
+		Icebreakers *icebreakerQuery `json:"icebreakers"`
+	}
+	historyRequest struct {
+		History *historyQuery `json:"history" validate:"required"`
+	}
+)
+
+func (r *editRequest) Validate() *wsproto.Error {
+	if r.Message.Id == nil || *r.Message.Id == "" {
+		return wsproto.Missing("message.id")
+	}
+	return nil
+}
+
+func (r *callRequest) Validate() *wsproto.Error {
+	if r.Call.Type == "" {
+		return wsproto.Missing("call.type")
+	}
+	return nil
+}
+
+// Server payloads. Legacy frames spread their fields next to the event.
+type (
+	empty        struct{}
+	messagesData struct {
+		Messages []models.Message `json:"message"`
+	}
+	updateData struct {
+		Messages []models.Message          `json:"message"`
+		Change   chatservice.MessageChange `json:"change,omitempty"`
+	}
+	searchData struct {
+		Search *chatservice.SearchResult `json:"search"`
+	}
+	grantData struct {
+		Grant *chatservice.MediaGrant `json:"grant"`
+	}
+	presenceData struct {
+		Presence *chatservice.PresenceEvent `json:"presence"`
+	}
+	scheduledData struct {
+		Scheduled []models.ScheduledMessage `json:"scheduled"`
+	}
+	icebreakersData struct {
+		Icebreakers []chatservice.Icebreaker `json:"icebreakers"`
+	}
+	revisionsData struct {
+		Revisions []models.MessageRevision `json:"revisions"`
+	}
+	dateData struct {
+		Date *chatservice.DateResponse `json:"date"`
+	}
+	callData struct {
+		Call *chatservice.CallSignal `json:"call"`
+	}
+)
+
+var protocol = newProtocol()
+
+func newProtocol() *wsproto.Protocol {
+	p := wsproto.NewProtocol("chat", "event")
+
+	p.On(string(messageSent), &sendRequest{}, "Send a new message.")
+	p.On(string(messageUpdated), &editRequest{}, "Edit the content or media of one of your messages.")
+	p.On(string(scheduleMessage), &scheduleRequest{}, "Hold a message until deliver_at.")
+	p.On(string(listScheduled), &listScheduledRequest{}, "List your pending scheduled messages.")
+	p.On(string(cancelScheduled), &cancelScheduledRequest{}, "Cancel a pending scheduled message.")
+	p.On(string(typingStarted), &typingStartRequest{}, "Tell the other participant you are typing.")
+	p.On(string(typingStopped), &typingStopRequest{}, "Tell the other participant you stopped typing.")
+	p.On(string(reactionAdded), &addReactionRequest{}, "React to a message, replacing your previous reaction.")
+	p.On(string(reactionRemoved), &removeReactionRequest{}, "Remove your reaction from a message.")
+	p.On(string(messageReceived), &receivedRequest{}, "Acknowledge delivery of a message.")
+	p.On(string(messageSeen), &seenRequest{}, "Mark messages as seen.")
+	p.On(string(queryMessages), &queryRequest{}, "Page through messages before before_id, newest page first.")
+	p.On(string(searchMessages), &searchRequest{}, "Search the chat's messages.")
+	p.On(string(messageContext), &contextRequest{}, "Load the messages around one message.")
+	p.On(string(openViewOnce), &viewOnceRequest{}, "Open view-once media, once.")
+	p.On(string(callSignal), &callRequest{}, "Send a call offer, answer, candidate or hangup.")
+	p.On(string(respondDate), &respondDateRequest{}, "Accept, decline or counter a date proposal.")
+	p.On(string(getIcebreakers), &icebreakersRequest{}, "Suggest conversation starters.")
+	p.On(string(messageHistory), &historyRequest{}, "List the revisions of an edited message.")
+
+	p.Emits(string(messageSent), messagesData{}, "A new message in the chat.")
+	p.Emits(string(messageUpdated), updateData{}, "A message was edited, reacted to, answered or opened; change says which.")
+	p.Emits(string(messageReceived), messagesData{}, "Messages were delivered to the other participant.")
+	p.Emits(string(messageSeen), messagesData{}, "Messages were seen.")
+	p.Emits(string(typingStarted), empty{}, "The other participant is typing.")
+	p.Emits(string(typingStopped), empty{}, "The other participant stopped typing.")
+	p.Emits(string(callSignal), callData{}, "A call signal, or the ack of your offer with its call_id.")
+	p.Emits(string(presenceChanged), presenceData{}, "The other participant came online or left.")
+	p.Emits(string(messageScheduled), scheduledData{}, "Reply to schedule_message.")
+	p.Emits(string(scheduledList), scheduledData{}, "Reply to list_scheduled.")
+	p.Emits(string(scheduledRemoved), scheduledData{}, "Reply to cancel_scheduled.")
+	p.Emits(string(messagesQuerySuccess), messagesData{}, "Reply to query_messages.")
+	p.Emits(string(messagesSearchResult), searchData{}, "Reply to search_messages.")
+	p.Emits(string(messageContextResult), messagesData{}, "Reply to message_context.")
+	p.Emits(string(viewOnceGrant), grantData{}, "Reply to open_view_once.")
+	p.Emits(string(icebreakersResult), icebreakersData{}, "Reply to get_icebreakers.")
+	p.Emits(string(messageHistoryResult), revisionsData{}, "Reply to message_history.")
+	p.Emits(string(dateResponseResult), dateData{}, "Reply to respond_date.")
+	p.Emits(string(errorEvent), nil, "A frame was rejected or could not be handled.")
+	p.Emits(string(rateLimitedEvent), nil, "A frame was dropped by a rate limit; the code is the bucket.")
+	p.Emits(string(unauthorizedEvent), nil, "The caller is not a participant; the socket closes.")
+
+	return p
+}
+
+// Protocol describes the chat socket, for generating its schema.
+func Protocol() *wsproto.Protocol { return protocol }
+
+// legacyFrame builds the flat frames unversioned clients have always read:
+// message and error are always present.
+func legacyFrame(event string, id string, data any, e *wsproto.Error) any {
+	frame := wsproto.Flatten(data)
+	if _, ok := frame["message"]; !ok {
+		frame["message"] = nil
+	}
+	frame["event"] = event
+	frame["error"] = ""
+	if id != "" {
+		frame["id"] = id
+	}
+	if e != nil {
+		frame["error"] = e.Message
+		frame["code"] = e.Code
+		if e.RetryAfter > 0 {
+			frame["retry_after_ms"] = e.RetryAfter
+		}
+	}
+	return frame
+}
+
+// requestError gives a failed request the code clients act on.
+func requestError(err error) *wsproto.Error {
+	var modErr *chatservice.ModerationError
+	switch {
+	case errors.As(err, &modErr):
+		return &wsproto.Error{Code: string(modErr.Reason), Message: modErr.Error()}
+	case errors.Is(err, chatservice.ErrViewOnceOpened):
+		return &wsproto.Error{Code: codeAlreadyOpened, Message: err.Error()}
+	case errors.Is(err, chatservice.ErrCallsLocked):
+		return &wsproto.Error{Code: codeCallsLocked, Message: err.Error()}
+	case errors.Is(err, chatservice.ErrCallInProgress):
+		return &wsproto.Error{Code: codeCallInProgress, Message: err.Error()}
+	case errors.Is(err, chatservice.ErrNoActiveCall):
+		return &wsproto.Error{Code: codeNoActiveCall, Message: err.Error()}
+	default:
+		return wsproto.Failed(err)
+	}
+}
+
+func rateLimited(bucket ratelimit.Bucket, retryAfter time.Duration) *wsproto.Error {
+	return &wsproto.Error{
+		Code:       string(bucket),
+		Message:    fmt.Sprintf("too many %s, slow down", bucket),
+		RetryAfter: retryAfter.Milliseconds(),
+	}
+}
+
+// eventBucket maps client events to the rate limit bucket they draw from, on
+// top of the per-frame limit every event is subject to.
+func eventBucket(e events) ratelimit.Bucket {
+	switch e {
+	case messageSent, messageUpdated, scheduleMessage, respondDate:
+		return ratelimit.BucketMessages
+	case typingStarted, typingStopped:
+		return ratelimit.BucketTyping
+	case reactionAdded, reactionRemoved:
+		return ratelimit.BucketReactions
+	default:
+		return ""
+	}
+}
@@ -1,11 +1,14 @@
 package fs
 
 import (
+	"blindly/internal/helpers/audio"
 	"blindly/internal/models"
 	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"mime/multipart"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,6 +22,43 @@ import (
 type userUploadRequest struct {
 	Key        string `json:"key"`
 	Visibility string `json:"visibility"`
+	Kind       string `json:"kind"` // "voice_note" requires a valid audio file
+}
+
+const (
+	voiceNoteKind        = "voice_note"
+	maxVoiceNoteDuration = 5 * time.Minute
+)
+
+// analyzeAudio computes metadata for audio uploads. Voice notes must be
+// readable and within maxVoiceNoteDuration; for other audio files the
+// metadata is best effort.
+func analyzeAudio(fh *multipart.FileHeader, kind string) (*models.MediaMetadata, error) {
+	isVoiceNote := kind == voiceNoteKind
+	if !isVoiceNote && !strings.HasPrefix(fh.Header.Get("Content-Type"), "audio/") {
+		return nil, nil
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	meta, err := audio.Analyze(f, fh.Size)
+	if err != nil {
+		if isVoiceNote {
+			return nil, fmt.Errorf("invalid voice note: %w", err)
+		}
+		log.Printf("Skipping audio metadata for %s: %v", fh.Filename, err)
+		return nil, nil
+	}
+
+	if isVoiceNote && (meta.DurationMs <= 0 || audio.Duration(meta) > maxVoiceNoteDuration) {
+		return nil, fmt.Errorf("voice notes must be between 0 and %s long", maxVoiceNoteDuration)
+	}
+
+	return meta, nil
 }
 
 func StoreUserFile(c *fiber.Ctx) error {
@@ -124,6 +164,16 @@ func StoreUserFile(c *fiber.Ctx) error {
 				return
 			}
 
+			metadata, err := analyzeAudio(uploadedFiles[0], fileReq.Kind)
+			if err != nil {
+				resultChan <- uploadResult{
+					Index: i,
+					Error: fmt.Errorf("file %d: %w", i, err),
+				}
+				return
+			}
+			fileToSave.Metadata = metadata
+
 			// Upload the file to S3
 			s3Path, err := kf.HandleSingleFileUpload(uploadedFiles[0])
 			if err != nil {
@@ -0,0 +1,458 @@
+package audio
+
+import (
+	"blindly/internal/models"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// WaveformPeaks is the number of bars in a computed waveform; each peak is in
+// [0, 100].
+const WaveformPeaks = 64
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported audio format")
+	ErrMalformed         = errors.New("malformed audio file")
+)
+
+// Analyze reads the container of an uploaded audio file and returns its
+// duration, codec, size and waveform. WAV files get true amplitude peaks;
+// for compressed formats the waveform follows the per-packet size, which
+// tracks loudness closely enough for display.
+func Analyze(r io.ReaderAt, size int64) (*models.MediaMetadata, error) {
+	head := make([]byte, 12)
+	if _, err := r.ReadAt(head, 0); err != nil {
+		return nil, ErrMalformed
+	}
+
+	var (
+		meta *models.MediaMetadata
+		err  error
+	)
+	switch {
+	case bytes.Equal(head[0:4], []byte("RIFF")) && bytes.Equal(head[8:12], []byte("WAVE")):
+		meta, err = analyzeWAV(r, size)
+	case bytes.Equal(head[4:8], []byte("ftyp")):
+		meta, err = analyzeMP4(r, size)
+	case bytes.Equal(head[0:4], []byte("OggS")):
+		meta, err = analyzeOgg(r, size)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	meta.Size = size
+	return meta, nil
+}
+
+func analyzeWAV(r io.ReaderAt, size int64) (*models.MediaMetadata, error) {
+	var (
+		format, channels, bitsPerSample uint16
+		byteRate                        uint32
+		haveFmt                         bool
+	)
+
+	hdr := make([]byte, 8)
+	for off := int64(12); off+8 <= size; {
+		if _, err := r.ReadAt(hdr, off); err != nil {
+			return nil, ErrMalformed
+		}
+		id := string(hdr[0:4])
+		n := int64(binary.LittleEndian.Uint32(hdr[4:8]))
+		body := off + 8
+
+		switch id {
+		case "fmt ":
+			if n < 16 {
+				return nil, ErrMalformed
+			}
+			f := make([]byte, 16)
+			if _, err := r.ReadAt(f, body); err != nil {
+				return nil, ErrMalformed
+			}
+			format = binary.LittleEndian.Uint16(f[0:2])
+			channels = binary.LittleEndian.Uint16(f[2:4])
+			byteRate = binary.LittleEndian.Uint32(f[8:12])
+			bitsPerSample = binary.LittleEndian.Uint16(f[14:16])
+			haveFmt = true
+		case "data":
+			if !haveFmt || byteRate == 0 || channels == 0 {
+				return nil, ErrMalformed
+			}
+			n = min(n, size-body)
+			meta := &models.MediaMetadata{
+				DurationMs: n * 1000 / int64(byteRate),
+				Codec:      fmt.Sprintf("pcm_%d", bitsPerSample),
+			}
+			if format != 1 {
+				meta.Codec = fmt.Sprintf("wav_%d", format)
+				return meta, nil
+			}
+			peaks, err := pcmPeaks(io.NewSectionReader(r, body, n), n, int(channels), int(bitsPerSample))
+			if err != nil {
+				return nil, err
+			}
+			meta.Waveform = peaks
+			return meta, nil
+		}
+
+		// Chunks are word aligned.
+		off = body + n + n%2
+	}
+
+	return nil, ErrMalformed
+}
+
+// pcmPeaks returns the loudest sample of each bucket, scaled against the
+// loudest sample overall.
+func pcmPeaks(r io.Reader, n int64, channels int, bits int) ([]int, error) {
+	if bits != 8 && bits != 16 {
+		return nil, nil
+	}
+	width := bits / 8
+	frameSize := int64(width * channels)
+	frames := n / frameSize
+	if frames == 0 {
+		return make([]int, WaveformPeaks), nil
+	}
+
+	raw := make([]int, WaveformPeaks)
+	buf := make([]byte, frameSize*1024)
+	var frame int64
+	for frame < frames {
+		read, err := io.ReadFull(r, buf[:min(int64(len(buf)), (frames-frame)*frameSize)])
+		if read == 0 {
+			if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+				return nil, err
+			}
+			break
+		}
+		for i := 0; i+width <= read; i += width {
+			var amp int
+			if bits == 8 {
+				amp = int(buf[i]) - 128
+			} else {
+				amp = int(int16(binary.LittleEndian.Uint16(buf[i:])))
+			}
+			if amp < 0 {
+				amp = -amp
+			}
+			bucket := int((frame + int64(i)/frameSize) * WaveformPeaks / frames)
+			if amp > raw[bucket] {
+				raw[bucket] = amp
+			}
+		}
+		frame += int64(read) / frameSize
+	}
+
+	return normalize(raw, false), nil
+}
+
+type mp4Box struct {
+	typ  string
+	body int64
+	end  int64
+}
+
+func readBoxes(r io.ReaderAt, start int64, end int64) ([]mp4Box, error) {
+	var boxes []mp4Box
+	hdr := make([]byte, 16)
+	for off := start; off+8 <= end; {
+		if _, err := r.ReadAt(hdr[:8], off); err != nil {
+			return nil, ErrMalformed
+		}
+		n := int64(binary.BigEndian.Uint32(hdr[0:4]))
+		typ := string(hdr[4:8])
+		body := off + 8
+		switch n {
+		case 0:
+			n = end - off
+		case 1:
+			if _, err := r.ReadAt(hdr[8:16], off+8); err != nil {
+				return nil, ErrMalformed
+			}
+			n = int64(binary.BigEndian.Uint64(hdr[8:16]))
+			body = off + 16
+		}
+		if n < body-off || off+n > end {
+			return nil, ErrMalformed
+		}
+		boxes = append(boxes, mp4Box{typ: typ, body: body, end: off + n})
+		off += n
+	}
+	return boxes, nil
+}
+
+func findBox(r io.ReaderAt, parent mp4Box, path ...string) (mp4Box, bool) {
+	current := parent
+	for _, typ := range path {
+		children, err := readBoxes(r, current.body, current.end)
+		if err != nil {
+			return mp4Box{}, false
+		}
+		found := false
+		for _, b := range children {
+			if b.typ == typ {
+				current, found = b, true
+				break
+			}
+		}
+		if !found {
+			return mp4Box{}, false
+		}
+	}
+	return current, true
+}
+
+func analyzeMP4(r io.ReaderAt, size int64) (*models.MediaMetadata, error) {
+	moov, ok := findBox(r, mp4Box{body: 0, end: size}, "moov")
+	if !ok {
+		return nil, ErrMalformed
+	}
+
+	mvhd, ok := findBox(r, moov, "mvhd")
+	if !ok {
+		return nil, ErrMalformed
+	}
+	h := make([]byte, 32)
+	if _, err := r.ReadAt(h[:min(int64(len(h)), mvhd.end-mvhd.body)], mvhd.body); err != nil && err != io.EOF {
+		return nil, ErrMalformed
+	}
+	var timescale uint32
+	var duration uint64
+	if h[0] == 1 {
+		timescale = binary.BigEndian.Uint32(h[20:24])
+		duration = binary.BigEndian.Uint64(h[24:32])
+	} else {
+		timescale = binary.BigEndian.Uint32(h[12:16])
+		duration = uint64(binary.BigEndian.Uint32(h[16:20]))
+	}
+	if timescale == 0 {
+		return nil, ErrMalformed
+	}
+
+	meta := &models.MediaMetadata{
+		DurationMs: int64(duration * 1000 / uint64(timescale)),
+	}
+
+	traks, err := readBoxes(r, moov.body, moov.end)
+	if err != nil {
+		return nil, err
+	}
+	for _, trak := range traks {
+		if trak.typ != "trak" {
+			continue
+		}
+		hdlr, ok := findBox(r, trak, "mdia", "hdlr")
+		if !ok {
+			continue
+		}
+		handler := make([]byte, 4)
+		if _, err := r.ReadAt(handler, hdlr.body+8); err != nil || string(handler) != "soun" {
+			continue
+		}
+
+		stbl, ok := findBox(r, trak, "mdia", "minf", "stbl")
+		if !ok {
+			return nil, ErrMalformed
+		}
+		if stsd, ok := findBox(r, stbl, "stsd"); ok {
+			codec := make([]byte, 4)
+			if _, err := r.ReadAt(codec, stsd.body+12); err == nil {
+				meta.Codec = mp4Codec(string(codec))
+			}
+		}
+		if stsz, ok := findBox(r, stbl, "stsz"); ok {
+			meta.Waveform = stszEnvelope(r, stsz)
+		}
+		return meta, nil
+	}
+
+	return nil, fmt.Errorf("%w: no audio track", ErrMalformed)
+}
+
+func mp4Codec(fourcc string) string {
+	switch fourcc {
+	case "mp4a":
+		return "aac"
+	case "Opus":
+		return "opus"
+	case "alac":
+		return "alac"
+	case "samr":
+		return "amr_nb"
+	default:
+		return fourcc
+	}
+}
+
+func stszEnvelope(r io.ReaderAt, stsz mp4Box) []int {
+	h := make([]byte, 12)
+	if _, err := r.ReadAt(h, stsz.body); err != nil {
+		return nil
+	}
+	if binary.BigEndian.Uint32(h[4:8]) != 0 {
+		// Constant sample size, nothing to draw.
+		return make([]int, WaveformPeaks)
+	}
+	count := int64(binary.BigEndian.Uint32(h[8:12]))
+	if count == 0 || stsz.body+12+count*4 > stsz.end {
+		return nil
+	}
+
+	table := make([]byte, count*4)
+	if _, err := r.ReadAt(table, stsz.body+12); err != nil {
+		return nil
+	}
+	sizes := make([]int, count)
+	for i := range sizes {
+		sizes[i] = int(binary.BigEndian.Uint32(table[i*4:]))
+	}
+	return sizeEnvelope(sizes)
+}
+
+func analyzeOgg(r io.ReaderAt, size int64) (*models.MediaMetadata, error) {
+	var (
+		meta       = &models.MediaMetadata{}
+		rate       int64
+		preSkip    int64
+		granule    int64
+		serial     uint32
+		packets    []int
+		pending    int
+		headerLeft = 0
+	)
+
+	hdr := make([]byte, 27)
+	for off := int64(0); off+27 <= size; {
+		if _, err := r.ReadAt(hdr, off); err != nil {
+			return nil, ErrMalformed
+		}
+		if !bytes.Equal(hdr[0:4], []byte("OggS")) {
+			return nil, ErrMalformed
+		}
+		pageSerial := binary.LittleEndian.Uint32(hdr[14:18])
+		segs := make([]byte, hdr[26])
+		if _, err := r.ReadAt(segs, off+27); err != nil {
+			return nil, ErrMalformed
+		}
+		body := off + 27 + int64(len(segs))
+
+		if off == 0 {
+			serial = pageSerial
+			id := make([]byte, 16)
+			if _, err := r.ReadAt(id, body); err != nil {
+				return nil, ErrMalformed
+			}
+			switch {
+			case bytes.Equal(id[0:8], []byte("OpusHead")):
+				meta.Codec = "opus"
+				rate = 48000
+				preSkip = int64(binary.LittleEndian.Uint16(id[10:12]))
+				headerLeft = 2
+			case bytes.Equal(id[0:7], []byte("\x01vorbis")):
+				meta.Codec = "vorbis"
+				rate = int64(binary.LittleEndian.Uint32(id[12:16]))
+				headerLeft = 3
+			default:
+				return nil, ErrUnsupportedFormat
+			}
+		}
+
+		var pageLen int64
+		for _, s := range segs {
+			pageLen += int64(s)
+			if pageSerial != serial {
+				continue
+			}
+			pending += int(s)
+			if s < 255 {
+				if headerLeft > 0 {
+					headerLeft--
+				} else {
+					packets = append(packets, pending)
+				}
+				pending = 0
+			}
+		}
+
+		if pageSerial == serial {
+			if g := int64(binary.LittleEndian.Uint64(hdr[6:14])); g > granule {
+				granule = g
+			}
+		}
+		off = body + pageLen
+	}
+
+	if rate == 0 {
+		return nil, ErrMalformed
+	}
+
+	meta.DurationMs = max(granule-preSkip, 0) * 1000 / rate
+	meta.Waveform = sizeEnvelope(packets)
+	return meta, nil
+}
+
+// sizeEnvelope buckets packet sizes evenly over time and scales the average
+// of each bucket between the quietest and loudest bucket.
+func sizeEnvelope(sizes []int) []int {
+	if len(sizes) == 0 {
+		return nil
+	}
+
+	sums := make([]int, WaveformPeaks)
+	counts := make([]int, WaveformPeaks)
+	for i, s := range sizes {
+		b := i * WaveformPeaks / len(sizes)
+		sums[b] += s
+		counts[b]++
+	}
+
+	avg := make([]int, WaveformPeaks)
+	for i := range avg {
+		if counts[i] > 0 {
+			avg[i] = sums[i] / counts[i]
+		} else if i > 0 {
+			// Fewer packets than bars: repeat the previous bar.
+			avg[i] = avg[i-1]
+		}
+	}
+	return normalize(avg, true)
+}
+
+// normalize scales values into [0, 100]. With floor set, the smallest value
+// maps to 0 rather than zero itself.
+func normalize(values []int, floor bool) []int {
+	lo, hi := 0, 0
+	for i, v := range values {
+		if i == 0 || v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	if !floor {
+		lo = 0
+	}
+
+	out := make([]int, len(values))
+	if hi == lo {
+		return out
+	}
+	for i, v := range values {
+		out[i] = (v - lo) * 100 / (hi - lo)
+	}
+	return out
+}
+
+// Duration is a convenience for callers working with time.Duration.
+func Duration(meta *models.MediaMetadata) time.Duration {
+	return time.Duration(meta.DurationMs) * time.Millisecond
+}
@@ -0,0 +1,103 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// wav16 builds a mono 16-bit PCM file whose samples grow louder over time.
+func wav16(rate int, samples int) []byte {
+	data := new(bytes.Buffer)
+	for i := range samples {
+		amp := int16(i * 30000 / samples)
+		if i%2 == 1 {
+			amp = -amp
+		}
+		binary.Write(data, binary.LittleEndian, amp)
+	}
+
+	b := new(bytes.Buffer)
+	b.WriteString("RIFF")
+	binary.Write(b, binary.LittleEndian, uint32(36+data.Len()))
+	b.WriteString("WAVEfmt ")
+	binary.Write(b, binary.LittleEndian, uint32(16))
+	binary.Write(b, binary.LittleEndian, uint16(1)) // PCM
+	binary.Write(b, binary.LittleEndian, uint16(1)) // mono
+	binary.Write(b, binary.LittleEndian, uint32(rate))
+	binary.Write(b, binary.LittleEndian, uint32(rate*2))
+	binary.Write(b, binary.LittleEndian, uint16(2))
+	binary.Write(b, binary.LittleEndian, uint16(16))
+	b.WriteString("data")
+	binary.Write(b, binary.LittleEndian, uint32(data.Len()))
+	b.Write(data.Bytes())
+	return b.Bytes()
+}
+
+// oggPage builds one page holding packets, each under 255 bytes.
+func oggPage(granule int64, packets ...[]byte) []byte {
+	b := new(bytes.Buffer)
+	b.WriteString("OggS")
+	b.Write([]byte{0, 0})
+	binary.Write(b, binary.LittleEndian, granule)
+	binary.Write(b, binary.LittleEndian, uint32(7)) // serial
+	b.Write(make([]byte, 8))                        // sequence and checksum
+	b.WriteByte(byte(len(packets)))
+	for _, p := range packets {
+		b.WriteByte(byte(len(p)))
+	}
+	for _, p := range packets {
+		b.Write(p)
+	}
+	return b.Bytes()
+}
+
+func TestAnalyzeWAV(t *testing.T) {
+	file := wav16(8000, 8000)
+	meta, err := Analyze(bytes.NewReader(file), int64(len(file)))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1000), meta.DurationMs)
+	assert.Equal(t, "pcm_16", meta.Codec)
+	assert.Equal(t, int64(len(file)), meta.Size)
+	require.Len(t, meta.Waveform, WaveformPeaks)
+	assert.Equal(t, 100, meta.Waveform[WaveformPeaks-1])
+	assert.Less(t, meta.Waveform[0], meta.Waveform[WaveformPeaks/2])
+}
+
+func TestAnalyzeOpus(t *testing.T) {
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	head[8], head[9] = 1, 1
+	binary.LittleEndian.PutUint16(head[10:12], 312)
+
+	// Two seconds of audio in packets that grow, then shrink.
+	var packets [][]byte
+	for i := range 100 {
+		packets = append(packets, make([]byte, 20+min(i, 99-i)))
+	}
+	file := append(oggPage(0, head), oggPage(0, []byte("OpusTags"))...)
+	file = append(file, oggPage(48000+312, packets[:50]...)...)
+	file = append(file, oggPage(2*48000+312, packets[50:]...)...)
+
+	meta, err := Analyze(bytes.NewReader(file), int64(len(file)))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2000), meta.DurationMs)
+	assert.Equal(t, "opus", meta.Codec)
+	require.Len(t, meta.Waveform, WaveformPeaks)
+	assert.Equal(t, 0, meta.Waveform[0])
+	assert.Equal(t, 100, max(meta.Waveform[WaveformPeaks/2-1], meta.Waveform[WaveformPeaks/2]))
+}
+
+func TestAnalyzeRejects(t *testing.T) {
+	_, err := Analyze(bytes.NewReader([]byte("ID3\x04not audio")), 13)
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	file := wav16(8000, 100)[:20]
+	_, err = Analyze(bytes.NewReader(file), int64(len(file)))
+	assert.ErrorIs(t, err, ErrMalformed)
+}
@@ -0,0 +1,182 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/MelloB1989/karma/utils"
+	"github.com/redis/go-redis/v9"
+)
+
+type Bucket string
+
+const (
+	BucketFrames     Bucket = "frames"
+	BucketMessages   Bucket = "messages"
+	BucketTyping     Bucket = "typing"
+	BucketReactions  Bucket = "reactions"
+	BucketAIRequests Bucket = "ai_requests"
+)
+
+// Limit is a token bucket: Burst tokens at most, refilled at Rate per second.
+type Limit struct {
+	Burst int
+	Rate  float64
+}
+
+var DefaultLimits = map[Bucket]Limit{
+	BucketFrames:     {Burst: 60, Rate: 10},
+	BucketMessages:   {Burst: 20, Rate: 1},
+	BucketTyping:     {Burst: 10, Rate: 0.5},
+	BucketReactions:  {Burst: 15, Rate: 1},
+	BucketAIRequests: {Burst: 5, Rate: 1.0 / 6},
+}
+
+const (
+	// MaxStrikes rejected frames within StrikeWindow get a connection closed.
+	MaxStrikes   = 20
+	StrikeWindow = time.Minute
+)
+
+func bucketKey(bucket Bucket, userId string) string {
+	return fmt.Sprintf("blindly:ratelimit:%s:%s", bucket, userId)
+}
+
+// takeToken refills the bucket from the Redis clock and takes one token.
+// Returns {allowed, retry_after_ms}.
+var takeToken = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil then
+	tokens = burst
+	ts = now
+end
+
+tokens = math.min(burst, tokens + (now - ts) * rate / 1000)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, retry}
+`)
+
+type Result struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+// Allower takes tokens from per-user buckets. Limiter is the one sockets use.
+type Allower interface {
+	Allow(userId string, bucket Bucket) Result
+}
+
+// Limiter enforces per-user token buckets shared by every instance through
+// Redis.
+type Limiter struct {
+	rc     *redis.Client
+	limits map[Bucket]Limit
+}
+
+func NewLimiter() *Limiter {
+	return newLimiter(utils.RedisConnect())
+}
+
+func newLimiter(rc *redis.Client) *Limiter {
+	return &Limiter{rc: rc, limits: DefaultLimits}
+}
+
+var (
+	sharedOnce sync.Once
+	shared     *Limiter
+)
+
+// Shared returns the limiter every socket of this instance uses, so they all
+// go through one Redis client. It is never closed.
+func Shared() *Limiter {
+	sharedOnce.Do(func() {
+		shared = NewLimiter()
+	})
+	return shared
+}
+
+// Allow takes a token from userId's bucket. Buckets without a configured limit
+// are always allowed, and so is every request while Redis is unavailable.
+func (l *Limiter) Allow(userId string, bucket Bucket) Result {
+	limit, ok := l.limits[bucket]
+	if !ok {
+		return Result{Allowed: true}
+	}
+
+	res, err := takeToken.Run(context.Background(), l.rc, []string{bucketKey(bucket, userId)}, limit.Burst, limit.Rate).Int64Slice()
+	if err != nil || len(res) != 2 {
+		log.Printf("rate limit check failed for %s/%s: %v", userId, bucket, err)
+		return Result{Allowed: true}
+	}
+
+	return Result{
+		Allowed:    res[0] == 1,
+		RetryAfter: time.Duration(res[1]) * time.Millisecond,
+	}
+}
+
+func (l *Limiter) Close() error {
+	return l.rc.Close()
+}
+
+// Guard tracks violations for a single connection on top of a Limiter.
+type Guard struct {
+	limiter Allower
+	userId  string
+	now     func() time.Time
+
+	mu          sync.Mutex
+	strikes     int
+	windowStart time.Time
+}
+
+func NewGuard(limiter Allower, userId string) *Guard {
+	return &Guard{limiter: limiter, userId: userId, now: time.Now}
+}
+
+// Check takes a token from each bucket in order and stops at the first one
+// that is exhausted. disconnect reports that the connection has exceeded
+// MaxStrikes within StrikeWindow and should be closed.
+func (g *Guard) Check(buckets ...Bucket) (res Result, limited Bucket, disconnect bool) {
+	for _, b := range buckets {
+		res = g.limiter.Allow(g.userId, b)
+		if !res.Allowed {
+			return res, b, g.strike()
+		}
+	}
+	return res, "", false
+}
+
+func (g *Guard) strike() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	if now.Sub(g.windowStart) > StrikeWindow {
+		g.windowStart = now
+		g.strikes = 0
+	}
+	g.strikes++
+	return g.strikes >= MaxStrikes
+}
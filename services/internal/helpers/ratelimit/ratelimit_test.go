@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLimiter refuses the buckets in denied and records every call.
+type fakeLimiter struct {
+	denied map[Bucket]bool
+	calls  []Bucket
+}
+
+func (f *fakeLimiter) Allow(userId string, bucket Bucket) Result {
+	f.calls = append(f.calls, bucket)
+	if f.denied[bucket] {
+		return Result{RetryAfter: time.Second}
+	}
+	return Result{Allowed: true}
+}
+
+func TestGuardCheck(t *testing.T) {
+	limiter := &fakeLimiter{denied: map[Bucket]bool{BucketMessages: true}}
+	g := NewGuard(limiter, "user1")
+
+	res, limited, disconnect := g.Check(BucketFrames, BucketTyping)
+	assert.True(t, res.Allowed)
+	assert.Empty(t, limited)
+	assert.False(t, disconnect)
+
+	// The first exhausted bucket stops the check.
+	limiter.calls = nil
+	res, limited, disconnect = g.Check(BucketFrames, BucketMessages, BucketTyping)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, BucketMessages, limited)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.False(t, disconnect)
+	assert.Equal(t, []Bucket{BucketFrames, BucketMessages}, limiter.calls)
+}
+
+func TestGuardStrikes(t *testing.T) {
+	limiter := &fakeLimiter{denied: map[Bucket]bool{BucketFrames: true}}
+	g := NewGuard(limiter, "user1")
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+
+	for i := 1; i < MaxStrikes; i++ {
+		_, _, disconnect := g.Check(BucketFrames)
+		assert.False(t, disconnect, "strike %d", i)
+		now = now.Add(time.Second)
+	}
+
+	// Once the window has passed the count starts over.
+	now = now.Add(StrikeWindow)
+	for i := 1; i < MaxStrikes; i++ {
+		_, _, disconnect := g.Check(BucketFrames)
+		assert.False(t, disconnect, "strike %d after reset", i)
+	}
+	_, _, disconnect := g.Check(BucketFrames)
+	assert.True(t, disconnect)
+}
+
+func TestLimiterFailsOpen(t *testing.T) {
+	rc := redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		DialTimeout: 100 * time.Millisecond,
+		MaxRetries:  -1,
+	})
+	l := newLimiter(rc)
+	defer l.Close()
+
+	for _, b := range []Bucket{BucketFrames, BucketMessages, "unknown"} {
+		assert.Equal(t, Result{Allowed: true}, l.Allow("user1", b), b)
+	}
+}
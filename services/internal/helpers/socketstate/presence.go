@@ -0,0 +1,282 @@
+package socketstate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/MelloB1989/karma/database"
+	"github.com/MelloB1989/karma/utils"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// HeartbeatInterval is how often a live socket refreshes its presence.
+	HeartbeatInterval = 30 * time.Second
+	// PresenceTTL is how long a connection counts as live without a heartbeat,
+	// so sockets on a crashed instance age out on their own.
+	PresenceTTL = 3 * HeartbeatInterval
+)
+
+func presenceKey(uid string) string { return fmt.Sprintf("blindly:presence:%s:conns", uid) }
+func lastSeenKey(uid string) string { return fmt.Sprintf("blindly:presence:%s:last_seen", uid) }
+
+// presenceStore counts the live connections of each user. Redis backs it in
+// production; with CHAT_BACKEND=memory it is kept in process, like the chat
+// backend.
+type presenceStore interface {
+	// touch makes connId live for ttl and returns how many other live
+	// connections uid has and whether connId was live already.
+	touch(uid string, connId string, ttl time.Duration) (others int, existed bool, err error)
+	// drop removes connId and returns how many live connections uid has
+	// left. When none are, now is recorded as uid's last seen time.
+	drop(uid string, connId string) (live int, now time.Time, err error)
+	isOnline(uid string) (bool, error)
+	lastSeen(uid string) (time.Time, bool)
+}
+
+var (
+	presenceOnce sync.Once
+	presence     presenceStore
+)
+
+func presenceState() presenceStore {
+	presenceOnce.Do(func() {
+		if config.GetEnvRaw("CHAT_BACKEND") == "memory" {
+			presence = newMemoryPresence()
+		} else {
+			presence = redisPresence{rc: utils.RedisConnect()}
+		}
+	})
+	return presence
+}
+
+// Each user has a hash of connection id -> expiry in ms. Expired entries are
+// pruned on every write, so the live count is a reference count that survives
+// instances dying without a clean disconnect.
+var touchConnection = redis.NewScript(`
+	local t = redis.call('TIME')
+	local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+	local ttl = tonumber(ARGV[2])
+	local others = 0
+	local existed = 0
+	local conns = redis.call('HGETALL', KEYS[1])
+	for i = 1, #conns, 2 do
+		if tonumber(conns[i + 1]) <= now then
+			redis.call('HDEL', KEYS[1], conns[i])
+		elseif conns[i] == ARGV[1] then
+			existed = 1
+		else
+			others = others + 1
+		end
+	end
+	redis.call('HSET', KEYS[1], ARGV[1], now + ttl)
+	redis.call('PEXPIRE', KEYS[1], ttl)
+	return {others, existed}
+`)
+
+var dropConnection = redis.NewScript(`
+	local t = redis.call('TIME')
+	local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+	redis.call('HDEL', KEYS[1], ARGV[1])
+	local live = 0
+	local conns = redis.call('HGETALL', KEYS[1])
+	for i = 1, #conns, 2 do
+		if tonumber(conns[i + 1]) <= now then
+			redis.call('HDEL', KEYS[1], conns[i])
+		else
+			live = live + 1
+		end
+	end
+	if live == 0 then
+		redis.call('SET', KEYS[2], now)
+	end
+	return {live, now}
+`)
+
+// Connect registers connId as one of uid's live connections and reports
+// whether this made the user come online.
+func Connect(uid string, connId string) (bool, error) {
+	return touch(uid, connId)
+}
+
+// Heartbeat keeps connId alive. Like Connect, it reports whether the user was
+// offline, which happens when heartbeats were missed for longer than the TTL.
+func Heartbeat(uid string, connId string) (bool, error) {
+	return touch(uid, connId)
+}
+
+func touch(uid string, connId string) (bool, error) {
+	others, existed, err := presenceState().touch(uid, connId, PresenceTTL)
+	if err != nil {
+		return false, fmt.Errorf("failed to update presence: %w", err)
+	}
+	return others == 0 && !existed, nil
+}
+
+// Disconnect removes connId. When it was the user's last live connection it
+// returns true along with the moment they went offline, which is also
+// recorded as their last seen time.
+func Disconnect(uid string, connId string) (bool, time.Time, error) {
+	live, at, err := presenceState().drop(uid, connId)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("failed to update presence: %w", err)
+	}
+	if live > 0 {
+		return false, time.Time{}, nil
+	}
+
+	if err := saveLastSeen(uid, at); err != nil {
+		return true, at, err
+	}
+	return true, at, nil
+}
+
+func saveLastSeen(uid string, at time.Time) error {
+	db, err := database.PostgresConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`UPDATE users SET last_seen_at = $2 WHERE id = $1`, uid, at); err != nil {
+		return fmt.Errorf("failed to save last seen: %w", err)
+	}
+	return nil
+}
+
+// IsOnline reports whether uid has at least one live connection.
+func IsOnline(uid string) (bool, error) {
+	return presenceState().isOnline(uid)
+}
+
+// LastSeen returns when uid last went offline, or nil if that is unknown.
+// The presence store holds the live value; stored is the users.last_seen_at
+// fallback.
+func LastSeen(uid string, stored *time.Time) *time.Time {
+	at, ok := presenceState().lastSeen(uid)
+	if !ok {
+		return stored
+	}
+	return &at
+}
+
+type redisPresence struct {
+	rc *redis.Client
+}
+
+func (p redisPresence) touch(uid string, connId string, ttl time.Duration) (int, bool, error) {
+	res, err := touchConnection.Run(context.Background(), p.rc,
+		[]string{presenceKey(uid)}, connId, ttl.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, false, err
+	}
+	return int(res[0]), res[1] == 1, nil
+}
+
+func (p redisPresence) drop(uid string, connId string) (int, time.Time, error) {
+	res, err := dropConnection.Run(context.Background(), p.rc,
+		[]string{presenceKey(uid), lastSeenKey(uid)}, connId).Int64Slice()
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return int(res[0]), time.UnixMilli(res[1]), nil
+}
+
+func (p redisPresence) isOnline(uid string) (bool, error) {
+	conns, err := p.rc.HGetAll(context.Background(), presenceKey(uid)).Result()
+	if err != nil {
+		return false, err
+	}
+	now := time.Now().UnixMilli()
+	for _, exp := range conns {
+		if ms, err := strconv.ParseInt(exp, 10, 64); err == nil && ms > now {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (p redisPresence) lastSeen(uid string) (time.Time, bool) {
+	ms, err := p.rc.Get(context.Background(), lastSeenKey(uid)).Int64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(ms), true
+}
+
+// memoryPresence keeps the same per-user connection expiries in process.
+type memoryPresence struct {
+	mu    sync.Mutex
+	now   func() time.Time
+	conns map[string]map[string]time.Time
+	seen  map[string]time.Time
+}
+
+func newMemoryPresence() *memoryPresence {
+	return &memoryPresence{
+		now:   time.Now,
+		conns: make(map[string]map[string]time.Time),
+		seen:  make(map[string]time.Time),
+	}
+}
+
+// prune drops uid's expired connections and returns the live ones.
+func (p *memoryPresence) prune(uid string, now time.Time) map[string]time.Time {
+	conns := p.conns[uid]
+	for connId, exp := range conns {
+		if !exp.After(now) {
+			delete(conns, connId)
+		}
+	}
+	return conns
+}
+
+func (p *memoryPresence) touch(uid string, connId string, ttl time.Duration) (int, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	conns := p.prune(uid, now)
+	if conns == nil {
+		conns = make(map[string]time.Time)
+		p.conns[uid] = conns
+	}
+	_, existed := conns[connId]
+	others := len(conns)
+	if existed {
+		others--
+	}
+	conns[connId] = now.Add(ttl)
+	return others, existed, nil
+}
+
+func (p *memoryPresence) drop(uid string, connId string) (int, time.Time, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	delete(p.conns[uid], connId)
+	live := len(p.prune(uid, now))
+	if live == 0 {
+		delete(p.conns, uid)
+		p.seen[uid] = now
+	}
+	return live, now, nil
+}
+
+func (p *memoryPresence) isOnline(uid string) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.prune(uid, p.now())) > 0, nil
+}
+
+func (p *memoryPresence) lastSeen(uid string) (time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	at, ok := p.seen[uid]
+	return at, ok
+}
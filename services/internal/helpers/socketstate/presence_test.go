@@ -0,0 +1,83 @@
+package socketstate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// usePresence swaps the presence store for the rest of the test.
+func usePresence(t *testing.T, p presenceStore) {
+	presenceOnce.Do(func() {})
+	prev := presence
+	presence = p
+	t.Cleanup(func() { presence = prev })
+}
+
+func TestPresenceRefcount(t *testing.T) {
+	p := newMemoryPresence()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+	usePresence(t, p)
+
+	cameOnline, err := Connect("user-1", "phone")
+	require.NoError(t, err)
+	assert.True(t, cameOnline)
+	cameOnline, err = Connect("user-1", "laptop")
+	require.NoError(t, err)
+	assert.False(t, cameOnline, "a second socket does not announce again")
+	cameOnline, err = Heartbeat("user-1", "phone")
+	require.NoError(t, err)
+	assert.False(t, cameOnline)
+
+	wentOffline, _, err := Disconnect("user-1", "phone")
+	require.NoError(t, err)
+	assert.False(t, wentOffline, "the laptop keeps the user online")
+	online, _ := IsOnline("user-1")
+	assert.True(t, online)
+
+	// Disconnecting the last socket goes through saveLastSeen, which needs
+	// Postgres, so the store is asked directly.
+	live, at, err := p.drop("user-1", "laptop")
+	require.NoError(t, err)
+	assert.Zero(t, live)
+	online, _ = IsOnline("user-1")
+	assert.False(t, online)
+	assert.Equal(t, now, *LastSeen("user-1", nil))
+	assert.Equal(t, now, at)
+}
+
+func TestPresenceExpiry(t *testing.T) {
+	p := newMemoryPresence()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+	usePresence(t, p)
+
+	_, err := Connect("user-1", "crashed")
+	require.NoError(t, err)
+	now = now.Add(PresenceTTL / 2)
+	_, err = Connect("user-1", "phone")
+	require.NoError(t, err)
+
+	// The socket on a crashed instance ages out; the live one holds on.
+	now = now.Add(PresenceTTL / 2)
+	online, _ := IsOnline("user-1")
+	assert.True(t, online)
+	wentOffline, _, err := Disconnect("user-1", "crashed")
+	require.NoError(t, err)
+	assert.False(t, wentOffline)
+
+	// Missing heartbeats for longer than the TTL counts as going offline, so
+	// the next heartbeat brings the user back online.
+	now = now.Add(PresenceTTL)
+	online, _ = IsOnline("user-1")
+	assert.False(t, online)
+	cameOnline, err := Heartbeat("user-1", "phone")
+	require.NoError(t, err)
+	assert.True(t, cameOnline)
+
+	stored := now.Add(-time.Hour)
+	assert.Equal(t, &stored, LastSeen("user-2", &stored))
+}
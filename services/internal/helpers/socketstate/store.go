@@ -1,52 +1,19 @@
 package socketstate
 
-import (
-	"context"
-	"fmt"
-	"time"
-
-	"github.com/MelloB1989/karma/utils"
-	"github.com/redis/go-redis/v9"
-)
-
 type PublicUserState struct {
-	uid   string
-	redis *redis.Client
+	uid string
 }
 
 func NewPublicUserState(uid string) *PublicUserState {
 	return &PublicUserState{
-		uid:   uid,
-		redis: utils.RedisConnect(),
+		uid: uid,
 	}
 }
 
 func (s *PublicUserState) GetOnlineStatus() (bool, error) {
-	status, err := s.redis.Get(context.Background(), fmt.Sprintf("user:%s:online", s.uid)).Bool()
-	if err != nil {
-		if err == redis.Nil {
-			return false, nil
-		}
-		return false, err
-	}
-	return status, nil
-}
-
-func (s *PublicUserState) SetOnlineStatus(status bool) error {
-	ctx := context.Background()
-	if status {
-		return s.redis.Set(ctx, fmt.Sprintf("user:%s:online", s.uid), "true", time.Minute*6).Err()
-	}
-	return s.redis.Set(ctx, fmt.Sprintf("user:%s:online", s.uid), "false", time.Minute*6).Err()
+	return IsOnline(s.uid)
 }
 
 func (s *PublicUserState) GetOtherUserOnlineStatus(uid string) (bool, error) {
-	status, err := s.redis.Get(context.Background(), fmt.Sprintf("user:%s:online", uid)).Bool()
-	if err != nil {
-		if err == redis.Nil {
-			return false, nil
-		}
-		return false, err
-	}
-	return status, nil
+	return IsOnline(uid)
 }
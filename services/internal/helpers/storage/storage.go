@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MelloB1989/karma/config"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// Operations on objects written through karma's S3 file backend. Paths may be
+// bare keys or the object URLs stored in user_files.s3_path.
+
+var (
+	clientOnce sync.Once
+	client     *s3.Client
+	clientErr  error
+)
+
+func s3Client() (*s3.Client, error) {
+	clientOnce.Do(func() {
+		var opts []func(*awsconfig.LoadOptions) error
+		if region := config.GetEnvRaw("AWS_REGION"); region != "" {
+			opts = append(opts, awsconfig.WithRegion(region))
+		}
+		cfg, err := awsconfig.LoadDefaultConfig(context.Background(), opts...)
+		if err != nil {
+			clientErr = fmt.Errorf("failed to load aws config: %w", err)
+			return
+		}
+		client = s3.NewFromConfig(cfg)
+	})
+	return client, clientErr
+}
+
+func bucket() string {
+	return config.GetEnvRaw("AWS_BUCKET_NAME")
+}
+
+// ObjectKey extracts the object key from a stored path, accepting both
+// virtual-hosted and path-style S3 URLs.
+func ObjectKey(path string) string {
+	u, err := url.Parse(path)
+	if err != nil || u.Host == "" {
+		return strings.TrimPrefix(path, "/")
+	}
+	key := strings.TrimPrefix(u.Path, "/")
+	if b := bucket(); b != "" && !strings.HasPrefix(u.Host, b+".") {
+		key = strings.TrimPrefix(key, b+"/")
+	}
+	return key
+}
+
+// PresignGet returns a URL that grants read access to the object for ttl.
+func PresignGet(path string, ttl time.Duration) (string, error) {
+	c, err := s3Client()
+	if err != nil {
+		return "", err
+	}
+
+	req, err := s3.NewPresignClient(c).PresignGetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(bucket()),
+		Key:    aws.String(ObjectKey(path)),
+	}, s3.WithPresignExpires(ttl))
+	if err != nil {
+		return "", fmt.Errorf("failed to presign object: %w", err)
+	}
+	return req.URL, nil
+}
+
+// Put writes body under key and returns the object URL, in the same form
+// karma's S3 backend stores in user_files.s3_path.
+func Put(key string, body []byte, contentType string) (string, error) {
+	c, err := s3Client()
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := c.PutObject(context.Background(), &s3.PutObjectInput{
+		Bucket:      aws.String(bucket()),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+		Body:        bytes.NewReader(body),
+	}); err != nil {
+		return "", fmt.Errorf("failed to put object: %w", err)
+	}
+	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", bucket(), config.GetEnvRaw("AWS_REGION"), key), nil
+}
+
+// Get reads the whole object.
+func Get(path string) ([]byte, error) {
+	c, err := s3Client()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := c.GetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(bucket()),
+		Key:    aws.String(ObjectKey(path)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	defer out.Body.Close()
+
+	body, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return body, nil
+}
+
+func Delete(path string) error {
+	c, err := s3Client()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.DeleteObject(context.Background(), &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket()),
+		Key:    aws.String(ObjectKey(path)),
+	}); err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
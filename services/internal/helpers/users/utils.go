@@ -2,7 +2,9 @@ package users
 
 import (
 	"blindly/internal/graph/model"
+	"blindly/internal/helpers/socketstate"
 	"blindly/internal/models"
+	"log"
 	"time"
 )
 
@@ -15,6 +17,8 @@ func ToUserPublic(d models.User) *model.UserPublic {
 		})
 	}
 
+	isOnline, lastSeen := Presence(d.Id, d.HideOnline, d.LastSeenAt)
+
 	return &model.UserPublic{
 		ID:                d.Id,
 		Name:              d.FirstName + " " + d.LastName,
@@ -30,6 +34,24 @@ func ToUserPublic(d models.User) *model.UserPublic {
 		IsVerified:        d.IsVerified,
 		Extra:             &d.Extra,
 		CreatedAt:         d.CreatedAt,
-		IsOnline:          false,
+		IsOnline:          isOnline,
+		LastSeenAt:        lastSeen,
+	}
+}
+
+// Presence returns what other users may see of uid's online status. Hidden
+// users always appear offline with no last seen time, and last seen is only
+// reported while offline.
+func Presence(uid string, hidden bool, storedLastSeen *time.Time) (bool, *time.Time) {
+	if hidden {
+		return false, nil
+	}
+	online, err := socketstate.IsOnline(uid)
+	if err != nil {
+		log.Printf("failed to get online status for %s: %v", uid, err)
+	}
+	if online {
+		return true, nil
 	}
+	return false, socketstate.LastSeen(uid, storedLastSeen)
 }
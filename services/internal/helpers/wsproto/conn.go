@@ -0,0 +1,139 @@
+package wsproto
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gofiber/websocket/v2"
+)
+
+// LegacyFrame builds the flat frame a Legacy client expects for an event.
+type LegacyFrame func(event string, id string, data any, err *Error) any
+
+// Conn writes frames to a socket in the format it negotiated. Writes are
+// serialized, so it is safe to share between a socket's goroutines.
+type Conn struct {
+	ws        *websocket.Conn
+	mu        sync.Mutex
+	format    Format
+	writeWait time.Duration
+	legacy    LegacyFrame
+}
+
+func NewConn(ws *websocket.Conn, format Format, writeWait time.Duration, legacy LegacyFrame) *Conn {
+	return &Conn{ws: ws, format: format, writeWait: writeWait, legacy: legacy}
+}
+
+func (c *Conn) Format() Format { return c.format }
+
+// Hello greets a versioned client. Legacy clients do not expect it.
+func (c *Conn) Hello(session string) error {
+	if c.format.Version == Legacy {
+		return nil
+	}
+	return c.Send(HelloEvent, "", Hello{
+		Version:   c.format.Version,
+		Versions:  Versions,
+		Encoding:  c.format.Encoding,
+		Encodings: Encodings,
+		Session:   session,
+	})
+}
+
+// Send writes event with data, replying to the client frame id if set.
+func (c *Conn) Send(event string, id string, data any) error {
+	switch {
+	case c.format.Version == Legacy:
+		return c.writeJSON(c.legacy(event, id, data, nil))
+	case c.format.Encoding == MessagePack:
+		raw, err := pack(data)
+		if err != nil {
+			return err
+		}
+		return c.writePacked(packedEnvelope{V: c.format.Version, Event: event, Id: id, Data: raw})
+	default:
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		return c.writeJSON(Envelope{V: c.format.Version, Event: event, Id: id, Data: raw})
+	}
+}
+
+// SendError writes an error event, replying to the client frame id if set.
+func (c *Conn) SendError(event string, id string, e *Error) error {
+	switch {
+	case c.format.Version == Legacy:
+		return c.writeJSON(c.legacy(event, id, nil, e))
+	case c.format.Encoding == MessagePack:
+		return c.writePacked(packedEnvelope{V: c.format.Version, Event: event, Id: id, Error: e})
+	default:
+		return c.writeJSON(Envelope{V: c.format.Version, Event: event, Id: id, Error: e})
+	}
+}
+
+func (c *Conn) writeJSON(v any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ws.SetWriteDeadline(time.Now().Add(c.writeWait))
+	err := c.ws.WriteJSON(v)
+	c.ws.SetWriteDeadline(time.Time{}) // Clear deadline after write
+	return err
+}
+
+func (c *Conn) writePacked(env packedEnvelope) error {
+	frame, err := pack(env)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ws.SetWriteDeadline(time.Now().Add(c.writeWait))
+	err = c.ws.WriteMessage(websocket.BinaryMessage, frame)
+	c.ws.SetWriteDeadline(time.Time{})
+	return err
+}
+
+func (c *Conn) Ping() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ws.SetWriteDeadline(time.Now().Add(c.writeWait))
+	err := c.ws.WriteMessage(websocket.PingMessage, nil)
+	c.ws.SetWriteDeadline(time.Time{})
+	return err
+}
+
+// ClosePolicyViolation sends a policy-violation close frame and drops the
+// connection.
+func (c *Conn) ClosePolicyViolation(reason string) {
+	c.mu.Lock()
+	c.ws.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.ClosePolicyViolation, reason),
+		time.Now().Add(c.writeWait),
+	)
+	c.mu.Unlock()
+	c.ws.Close()
+}
+
+// Flatten spreads data's fields into a map, for Legacy frames that put them
+// next to the event name.
+func Flatten(data any) map[string]any {
+	out := make(map[string]any)
+	if data == nil {
+		return out
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return out
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(raw, &fields) != nil {
+		return out
+	}
+	for k, v := range fields {
+		out[k] = v
+	}
+	return out
+}
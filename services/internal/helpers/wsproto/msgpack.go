@@ -0,0 +1,71 @@
+package wsproto
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const msgpackNil = 0xc0
+
+// packedEnvelope is Envelope on a MessagePack socket, where data is nested
+// MessagePack rather than JSON text.
+type packedEnvelope struct {
+	V     int                `msgpack:"v"`
+	Event string             `msgpack:"event"`
+	Id    string             `msgpack:"id,omitempty"`
+	Data  msgpack.RawMessage `msgpack:"data,omitempty"`
+	Error *Error             `msgpack:"error,omitempty"`
+}
+
+func init() {
+	// Opaque JSON, such as call candidates, travels as the value it holds so
+	// MessagePack clients never see JSON text.
+	msgpack.Register(json.RawMessage{},
+		func(e *msgpack.Encoder, v reflect.Value) error {
+			raw := v.Bytes()
+			if len(raw) == 0 {
+				return e.EncodeNil()
+			}
+			var value any
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return err
+			}
+			return e.Encode(value)
+		},
+		func(d *msgpack.Decoder, v reflect.Value) error {
+			value, err := d.DecodeInterface()
+			if err != nil || value == nil {
+				v.SetBytes(nil)
+				return err
+			}
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(raw)
+			return nil
+		},
+	)
+}
+
+// pack encodes v with the field names and omissions of its JSON tags.
+func pack(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetCustomStructTag("json")
+	enc.UseCompactInts(true)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func unpack(data []byte, v any, strict bool) error {
+	dec := msgpack.NewDecoder(bytes.NewReader(data))
+	dec.SetCustomStructTag("json")
+	dec.DisallowUnknownFields(strict)
+	return dec.Decode(v)
+}
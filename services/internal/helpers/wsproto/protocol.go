@@ -0,0 +1,371 @@
+package wsproto
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/websocket/v2"
+)
+
+// Sockets speak one of two framings. Clients that do not negotiate get
+// Legacy, the flat frames the app has always sent, with the payload fields
+// next to the event name. Clients that ask for V1, through the "blindly.v1"
+// subprotocol or the v query parameter, get every payload wrapped in an
+// Envelope, strict decoding and a hello frame on connect.
+//
+// V1 frames are JSON text by default. A "blindly.v1.msgpack" subprotocol or
+// an encoding=msgpack query parameter switches both directions to binary
+// MessagePack frames carrying the same fields.
+const (
+	Legacy = 0
+	V1     = 1
+	Latest = V1
+
+	subprotocolPrefix = "blindly.v"
+
+	// HelloEvent is the first frame a versioned socket receives.
+	HelloEvent = "hello"
+)
+
+type Encoding string
+
+const (
+	JSON        Encoding = "json"
+	MessagePack Encoding = "msgpack"
+)
+
+// Encodings lists every encoding the server speaks, JSON first.
+var Encodings = []Encoding{JSON, MessagePack}
+
+// Format is what a socket negotiated.
+type Format struct {
+	Version  int
+	Encoding Encoding
+}
+
+// Versions lists every version the server speaks.
+var Versions = []int{Legacy, V1}
+
+// Validation error codes. Handlers add their own, such as moderation
+// reasons and rate limit buckets.
+const (
+	CodeInvalidFrame       = "invalid_frame"
+	CodeUnsupportedVersion = "unsupported_version"
+	CodeUnknownEvent       = "unknown_event"
+	CodeInvalidPayload     = "invalid_payload"
+	CodeMissingField       = "missing_field"
+	CodeInvalidField       = "invalid_field"
+	CodeRequestFailed      = "request_failed"
+)
+
+// Envelope is a V1 frame. Client frames carry Data; server frames carry
+// Data or Error. Id is chosen by the client and echoed on every reply to
+// that frame.
+type Envelope struct {
+	V     int             `json:"v"`
+	Event string          `json:"event"`
+	Id    string          `json:"id,omitempty"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error *Error          `json:"error,omitempty"`
+}
+
+// Hello tells a versioned client what the server settled on.
+type Hello struct {
+	Version   int        `json:"version"`
+	Versions  []int      `json:"versions"`
+	Encoding  Encoding   `json:"encoding"`
+	Encodings []Encoding `json:"encodings"`
+	Session   string     `json:"session"`
+}
+
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Field is the JSON path of the offending payload field, if any.
+	Field string `json:"field,omitempty"`
+	// RetryAfter accompanies rate limit errors, in milliseconds.
+	RetryAfter int64 `json:"retry_after_ms,omitempty"`
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func Missing(field string) *Error {
+	return &Error{Code: CodeMissingField, Field: field, Message: field + " is required"}
+}
+
+func Invalid(field string, message string) *Error {
+	return &Error{Code: CodeInvalidField, Field: field, Message: message}
+}
+
+// Failed reports an error from handling a valid request.
+func Failed(err error) *Error {
+	return &Error{Code: CodeRequestFailed, Message: err.Error()}
+}
+
+// Validator is implemented by payloads with rules beyond required fields.
+type Validator interface {
+	Validate() *Error
+}
+
+// UpgradeConfig lets the websocket upgrade select a versioned subprotocol,
+// preferring MessagePack.
+func UpgradeConfig() websocket.Config {
+	var subprotocols []string
+	for i := len(Versions) - 1; i >= 0; i-- {
+		if Versions[i] == Legacy {
+			continue
+		}
+		name := subprotocolPrefix + strconv.Itoa(Versions[i])
+		subprotocols = append(subprotocols, name+"."+string(MessagePack), name)
+	}
+	return websocket.Config{Subprotocols: subprotocols}
+}
+
+// Negotiate picks the format for a new socket from the subprotocol chosen at
+// upgrade or, without one, the v and encoding query parameters. Asking for
+// MessagePack alone implies the latest version, since Legacy frames are
+// always JSON.
+func Negotiate(c *websocket.Conn) (Format, *Error) {
+	requested, encoding, _ := strings.Cut(strings.TrimPrefix(c.Subprotocol(), subprotocolPrefix), ".")
+	if requested == "" {
+		requested, encoding = c.Query("v"), c.Query("encoding")
+	}
+	f := Format{Version: Legacy, Encoding: JSON}
+	if encoding != "" {
+		f.Encoding = Encoding(encoding)
+		if !slices.Contains(Encodings, f.Encoding) {
+			return Format{Version: Latest, Encoding: JSON}, &Error{
+				Code:    CodeUnsupportedVersion,
+				Message: fmt.Sprintf("encoding %q is not supported", encoding),
+				Field:   "encoding",
+			}
+		}
+		if requested == "" && f.Encoding != JSON {
+			f.Version = Latest
+		}
+	}
+	if requested == "" {
+		return f, nil
+	}
+	v, err := strconv.Atoi(requested)
+	if err != nil || !supported(v) {
+		return Format{Version: Latest, Encoding: f.Encoding}, &Error{
+			Code:    CodeUnsupportedVersion,
+			Message: fmt.Sprintf("protocol version %q is not supported", requested),
+			Field:   "v",
+		}
+	}
+	if v == Legacy && f.Encoding != JSON {
+		return Format{Version: Latest, Encoding: f.Encoding}, &Error{
+			Code:    CodeUnsupportedVersion,
+			Message: "legacy frames are JSON only",
+			Field:   "encoding",
+		}
+	}
+	f.Version = v
+	return f, nil
+}
+
+func supported(v int) bool {
+	for _, s := range Versions {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+type eventSpec struct {
+	typ reflect.Type
+	doc string
+}
+
+// Protocol is the set of events one socket understands and sends. It is
+// built once at startup and only read afterwards.
+type Protocol struct {
+	name           string
+	legacyEventKey string
+	client         map[string]eventSpec
+	server         map[string]eventSpec
+}
+
+// NewProtocol starts a protocol. legacyEventKey names the field that carries
+// the event in Legacy frames.
+func NewProtocol(name string, legacyEventKey string) *Protocol {
+	return &Protocol{
+		name:           name,
+		legacyEventKey: legacyEventKey,
+		client:         make(map[string]eventSpec),
+		server:         make(map[string]eventSpec),
+	}
+}
+
+// On registers a client event with a pointer to its zero payload. Fields
+// tagged validate:"required" must be set.
+func (p *Protocol) On(event string, payload any, doc string) {
+	t := reflect.TypeOf(payload)
+	if t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("wsproto: payload of %s must be a pointer to a struct", event))
+	}
+	p.client[event] = eventSpec{typ: t.Elem(), doc: doc}
+}
+
+// Emits documents a server event and the data it carries.
+func (p *Protocol) Emits(event string, data any, doc string) {
+	p.server[event] = eventSpec{typ: reflect.TypeOf(data), doc: doc}
+}
+
+// Request is a decoded client frame.
+type Request struct {
+	Event   string
+	Id      string
+	Payload any
+}
+
+// Decode parses a frame in the socket's format into its typed payload. The
+// returned request carries whatever event and id could be read even when
+// decoding fails, so the error can be correlated.
+func (p *Protocol) Decode(f Format, frame []byte) (*Request, *Error) {
+	req := &Request{}
+	var data []byte
+	switch {
+	case f.Version == Legacy:
+		var head map[string]json.RawMessage
+		if err := json.Unmarshal(frame, &head); err != nil {
+			return req, &Error{Code: CodeInvalidFrame, Message: err.Error()}
+		}
+		json.Unmarshal(head[p.legacyEventKey], &req.Event)
+		json.Unmarshal(head["id"], &req.Id)
+		data = frame
+	case f.Encoding == MessagePack:
+		var env packedEnvelope
+		if err := unpack(frame, &env, false); err != nil {
+			return req, &Error{Code: CodeInvalidFrame, Message: err.Error()}
+		}
+		req.Event, req.Id, data = env.Event, env.Id, env.Data
+		if env.V != f.Version {
+			return req, versionMismatch(env.V, f.Version)
+		}
+	default:
+		var env Envelope
+		if err := json.Unmarshal(frame, &env); err != nil {
+			return req, &Error{Code: CodeInvalidFrame, Message: err.Error()}
+		}
+		req.Event, req.Id, data = env.Event, env.Id, env.Data
+		if env.V != f.Version {
+			return req, versionMismatch(env.V, f.Version)
+		}
+	}
+
+	if req.Event == "" {
+		return req, Missing("event")
+	}
+	spec, ok := p.client[req.Event]
+	if !ok {
+		return req, &Error{Code: CodeUnknownEvent, Message: fmt.Sprintf("unknown event: %s", req.Event), Field: "event"}
+	}
+
+	payload := reflect.New(spec.typ)
+	if err := decodePayload(f, data, payload.Interface()); err != nil {
+		return req, &Error{Code: CodeInvalidPayload, Message: err.Error()}
+	}
+	req.Payload = payload.Interface()
+
+	if err := checkRequired(payload.Elem(), ""); err != nil {
+		return req, err
+	}
+	if v, ok := req.Payload.(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return req, err
+		}
+	}
+	return req, nil
+}
+
+func versionMismatch(got int, negotiated int) *Error {
+	return &Error{
+		Code:    CodeUnsupportedVersion,
+		Message: fmt.Sprintf("frame is v%d but the socket negotiated v%d", got, negotiated),
+		Field:   "v",
+	}
+}
+
+// decodePayload fills v from a frame's data. Legacy frames share their object
+// with the event name, so only versioned frames reject unknown fields.
+func decodePayload(f Format, data []byte, v any) error {
+	switch {
+	case len(data) == 0:
+		return nil
+	case f.Encoding == MessagePack && f.Version != Legacy:
+		if len(data) == 1 && data[0] == msgpackNil {
+			return nil
+		}
+		return unpack(data, v, true)
+	default:
+		if bytes.Equal(data, []byte("null")) {
+			return nil
+		}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		if f.Version != Legacy {
+			dec.DisallowUnknownFields()
+		}
+		return dec.Decode(v)
+	}
+}
+
+// checkRequired reports the first field tagged validate:"required" that is
+// unset, descending into nested payload structs that are present.
+func checkRequired(v reflect.Value, prefix string) *Error {
+	t := v.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+		name := prefix + jsonName(f)
+		if f.Tag.Get("validate") == "required" {
+			empty := fv.IsZero()
+			switch fv.Kind() {
+			case reflect.Slice, reflect.Map:
+				empty = fv.Len() == 0
+			}
+			if empty {
+				return Missing(name)
+			}
+		}
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.Struct && fv.Type() != timeType {
+			nested := name + "."
+			if f.Anonymous && f.Tag.Get("json") == "" {
+				nested = prefix
+			}
+			if err := checkRequired(fv, nested); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// jsonName is the name a field has on the wire, or "" when it has none.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
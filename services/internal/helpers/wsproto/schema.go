@@ -0,0 +1,197 @@
+package wsproto
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"slices"
+	"time"
+)
+
+var (
+	timeType = reflect.TypeFor[time.Time]()
+	rawType  = reflect.TypeFor[json.RawMessage]()
+)
+
+// Schema describes the V1 protocol as a JSON Schema: one frame per client
+// and server event, with the payload types under $defs. Legacy frames carry
+// the same data fields at the top level instead of under data.
+func (p *Protocol) Schema() ([]byte, error) {
+	g := &schemaGen{defs: make(map[string]any), names: make(map[reflect.Type]string)}
+
+	client := make([]any, 0, len(p.client))
+	for _, event := range sortedEvents(p.client) {
+		spec := p.client[event]
+		client = append(client, frameSchema(event, spec.doc, "data", g.typeSchema(spec.typ), false))
+	}
+
+	server := []any{
+		frameSchema(HelloEvent, "First frame on a versioned socket.", "data", g.typeSchema(reflect.TypeFor[Hello]()), true),
+	}
+	for _, event := range sortedEvents(p.server) {
+		spec := p.server[event]
+		if spec.typ == nil {
+			server = append(server, frameSchema(event, spec.doc, "error", map[string]any{"$ref": "#/$defs/Error"}, true))
+			continue
+		}
+		server = append(server, frameSchema(event, spec.doc, "data", g.typeSchema(spec.typ), false))
+	}
+
+	g.defs["Error"] = g.structSchema(reflect.TypeFor[Error]())
+	g.defs["ClientFrame"] = map[string]any{"oneOf": client}
+	g.defs["ServerFrame"] = map[string]any{"oneOf": server}
+
+	return json.MarshalIndent(map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title":   p.name + " socket protocol",
+		"description": "Frames of protocol v1. Legacy (v0) frames put the data fields at the top level, next to \"" + p.legacyEventKey + "\". " +
+			"MessagePack frames carry the same fields, with date-times as timestamps and bytes as binary.",
+		"x-versions":  Versions,
+		"x-encodings": Encodings,
+		"$defs":       g.defs,
+		"oneOf": []any{
+			map[string]any{"$ref": "#/$defs/ClientFrame"},
+			map[string]any{"$ref": "#/$defs/ServerFrame"},
+		},
+	}, "", "  ")
+}
+
+func sortedEvents(specs map[string]eventSpec) []string {
+	events := make([]string, 0, len(specs))
+	for event := range specs {
+		events = append(events, event)
+	}
+	slices.Sort(events)
+	return events
+}
+
+func frameSchema(event string, doc string, field string, body any, required bool) map[string]any {
+	req := []string{"v", "event"}
+	if required {
+		req = append(req, field)
+	}
+	return map[string]any{
+		"type":        "object",
+		"description": doc,
+		"properties": map[string]any{
+			"v":     map[string]any{"type": "integer", "minimum": V1},
+			"event": map[string]any{"const": event},
+			"id":    map[string]any{"type": "string"},
+			field:   body,
+		},
+		"required":             req,
+		"additionalProperties": false,
+	}
+}
+
+type schemaGen struct {
+	defs  map[string]any
+	names map[reflect.Type]string
+}
+
+// defName names a struct by package and type, qualifying the package further
+// when two packages share a name.
+func (g *schemaGen) defName(t reflect.Type) string {
+	if name, ok := g.names[t]; ok {
+		return name
+	}
+	pkg := t.PkgPath()
+	name := path.Base(pkg) + "." + t.Name()
+	for g.taken(name) && path.Dir(pkg) != "." {
+		pkg = path.Dir(pkg)
+		name = path.Base(pkg) + "." + name
+	}
+	g.names[t] = name
+	return name
+}
+
+func (g *schemaGen) taken(name string) bool {
+	for _, other := range g.names {
+		if other == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *schemaGen) typeSchema(t reflect.Type) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case t == rawType:
+		return map[string]any{}
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return map[string]any{"type": "string", "contentEncoding": "base64"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.typeSchema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		name := g.defName(t)
+		if _, ok := g.defs[name]; !ok {
+			// Claim the name first so recursive types terminate.
+			g.defs[name] = nil
+			g.defs[name] = g.structSchema(t)
+		}
+		return map[string]any{"$ref": "#/$defs/" + name}
+	default:
+		return map[string]any{}
+	}
+}
+
+func (g *schemaGen) structSchema(t reflect.Type) map[string]any {
+	props := make(map[string]any)
+	var required []string
+	g.collectFields(t, props, &required)
+
+	s := map[string]any{"type": "object", "properties": props}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+func (g *schemaGen) collectFields(t reflect.Type, props map[string]any, required *[]string) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name := jsonName(f)
+		if name == "" {
+			continue
+		}
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.collectFields(ft, props, required)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		props[name] = g.typeSchema(f.Type)
+		if f.Tag.Get("validate") == "required" {
+			*required = append(*required, name)
+		}
+	}
+}
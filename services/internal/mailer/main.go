@@ -1,6 +1,8 @@
 package mailer
 
 import (
+	"sync"
+
 	"github.com/MelloB1989/karma/config"
 	"github.com/MelloB1989/karma/mails"
 	m "github.com/MelloB1989/karma/models"
@@ -13,8 +15,47 @@ type Template struct {
 	HTML    string
 }
 
+// Transport delivers a Template. SES is used unless MAILER_SMTP_ADDR points
+// at an SMTP server, such as a local sink during development.
+type Transport interface {
+	Send(t *Template) error
+}
+
+var (
+	transportMu sync.RWMutex
+	transport   Transport
+)
+
+// SetTransport replaces the transport every Template is sent through. Passing
+// nil goes back to the one picked from the environment.
+func SetTransport(t Transport) {
+	transportMu.Lock()
+	defer transportMu.Unlock()
+	transport = t
+}
+
+func currentTransport() Transport {
+	transportMu.RLock()
+	defer transportMu.RUnlock()
+	if transport != nil {
+		return transport
+	}
+	if addr := config.GetEnvRaw("MAILER_SMTP_ADDR"); addr != "" {
+		return &SMTPTransport{Addr: addr, From: config.GetEnvRaw("MAILER_ADDRESS")}
+	}
+	return &SESTransport{From: config.GetEnvRaw("MAILER_ADDRESS")}
+}
+
 func (t *Template) Send() error {
-	km := mails.NewKarmaMail(config.GetEnvRaw("MAILER_ADDRESS"), mails.AWS_SES)
+	return currentTransport().Send(t)
+}
+
+type SESTransport struct {
+	From string
+}
+
+func (s *SESTransport) Send(t *Template) error {
+	km := mails.NewKarmaMail(s.From, mails.AWS_SES)
 
 	// Send email
 	if err := km.SendSingleMail(m.SingleEmailRequest{
@@ -0,0 +1,67 @@
+package mailer
+
+import (
+	"fmt"
+	"html"
+	"time"
+)
+
+const safetyTimeLayout = "Mon, 2 Jan 2006 at 15:04 MST"
+
+// DateDetails is what a trusted contact is told about a date.
+type DateDetails struct {
+	UserName string
+	Venue    string
+	StartsAt time.Time
+	Note     string
+}
+
+func (d DateDetails) text() string {
+	s := fmt.Sprintf("Where: %s\nWhen: %s", d.Venue, d.StartsAt.UTC().Format(safetyTimeLayout))
+	if d.Note != "" {
+		s += fmt.Sprintf("\nNote: %s", d.Note)
+	}
+	return s
+}
+
+func (d DateDetails) html() string {
+	s := fmt.Sprintf("<p><strong>Where:</strong> %s<br><strong>When:</strong> %s",
+		html.EscapeString(d.Venue), d.StartsAt.UTC().Format(safetyTimeLayout))
+	if d.Note != "" {
+		s += fmt.Sprintf("<br><strong>Note:</strong> %s", html.EscapeString(d.Note))
+	}
+	return s + "</p>"
+}
+
+func BuildDateShared(email string, contactName string, d DateDetails) *Template {
+	return &Template{
+		ToEmail: email,
+		Subject: fmt.Sprintf("%s shared their date plans with you", d.UserName),
+		Text: fmt.Sprintf("Hi %s,\n\n%s added you as a trusted contact on Blindly and shared the details of an upcoming date.\n\n%s\n\nWe will email you if they miss a safety check-in.",
+			contactName, d.UserName, d.text()),
+		HTML: fmt.Sprintf("<p>Hi %s,</p><p>%s added you as a trusted contact on Blindly and shared the details of an upcoming date.</p>%s<p>We will email you if they miss a safety check-in.</p>",
+			html.EscapeString(contactName), html.EscapeString(d.UserName), d.html()),
+	}
+}
+
+func BuildMissedCheckIn(email string, contactName string, d DateDetails, checkInAt time.Time) *Template {
+	at := checkInAt.UTC().Format(safetyTimeLayout)
+	return &Template{
+		ToEmail: email,
+		Subject: fmt.Sprintf("%s missed a safety check-in", d.UserName),
+		Text: fmt.Sprintf("Hi %s,\n\n%s planned to check in on Blindly by %s after their date and has not. Please try to reach them.\n\n%s\n\nIf you believe they are in danger, contact local emergency services.",
+			contactName, d.UserName, at, d.text()),
+		HTML: fmt.Sprintf("<p>Hi %s,</p><p>%s planned to check in on Blindly by <strong>%s</strong> after their date and has not. Please try to reach them.</p>%s<p>If you believe they are in danger, contact local emergency services.</p>",
+			html.EscapeString(contactName), html.EscapeString(d.UserName), at, d.html()),
+	}
+}
+
+func BuildCheckInAllClear(email string, contactName string, userName string) *Template {
+	return &Template{
+		ToEmail: email,
+		Subject: fmt.Sprintf("%s has checked in", userName),
+		Text:    fmt.Sprintf("Hi %s,\n\n%s has now checked in on Blindly and is safe. Thanks for looking out for them.", contactName, userName),
+		HTML: fmt.Sprintf("<p>Hi %s,</p><p>%s has now checked in on Blindly and is safe. Thanks for looking out for them.</p>",
+			html.EscapeString(contactName), html.EscapeString(userName)),
+	}
+}
@@ -0,0 +1,90 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/smtp"
+	"sync"
+	"time"
+
+	"github.com/MelloB1989/karma/utils"
+)
+
+// SMTPTransport sends plain SMTP without authentication. It is meant for
+// local sinks like MailHog or Mailpit, not for production delivery.
+type SMTPTransport struct {
+	Addr string
+	From string
+}
+
+func (s *SMTPTransport) Send(t *Template) error {
+	msg, err := buildMIME(s.From, t)
+	if err != nil {
+		return err
+	}
+	if err := smtp.SendMail(s.Addr, nil, s.From, []string{t.ToEmail}, msg); err != nil {
+		return fmt.Errorf("failed to send mail to %s: %w", t.ToEmail, err)
+	}
+	return nil
+}
+
+// buildMIME renders t as a multipart/alternative message.
+func buildMIME(from string, t *Template) ([]byte, error) {
+	boundary := "blindly-" + utils.GenerateID(16)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", t.ToEmail)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", t.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain", t.Text},
+		{"text/html", t.HTML},
+	} {
+		if part.body == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		fmt.Fprintf(&buf, "Content-Type: %s; charset=utf-8\r\n", part.contentType)
+		buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+		qp := quotedprintable.NewWriter(&buf)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\r\n")
+	}
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+
+	return buf.Bytes(), nil
+}
+
+// Sink keeps mail in memory instead of sending it, so flows that send mail
+// can be checked without a mail server.
+type Sink struct {
+	mu   sync.Mutex
+	sent []Template
+}
+
+func (s *Sink) Send(t *Template) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, *t)
+	return nil
+}
+
+// Sent returns a copy of everything sent so far.
+func (s *Sink) Sent() []Template {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Template, len(s.sent))
+	copy(out, s.sent)
+	return out
+}
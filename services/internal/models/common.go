@@ -19,10 +19,22 @@ type PostUnlockRating struct {
 }
 
 type Media struct {
-	Id        string    `json:"id"`
-	Type      string    `json:"type"`
-	Url       string    `json:"url"`
-	CreatedAt time.Time `json:"created_at"`
+	Id        string         `json:"id"`
+	Type      string         `json:"type"`
+	Url       string         `json:"url"`
+	FileId    string         `json:"file_id,omitempty"`
+	Metadata  *MediaMetadata `json:"metadata,omitempty" db:"metadata"`
+	ViewOnce  bool           `json:"view_once,omitempty"`
+	OpenedAt  *time.Time     `json:"opened_at,omitempty"` // set once view-once media has been opened
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// MediaMetadata is computed by the server when an audio file is uploaded.
+type MediaMetadata struct {
+	DurationMs int64  `json:"duration_ms"`
+	Waveform   []int  `json:"waveform,omitempty"` // peaks in [0, 100]
+	Codec      string `json:"codec"`
+	Size       int64  `json:"size"`
 }
 
 type Reaction struct {
@@ -32,17 +44,74 @@ type Reaction struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// ReactionCount aggregates the reactions on a message per emoji. It is
+// recomputed whenever a message's reactions change.
+type ReactionCount struct {
+	Content string `json:"content"`
+	Count   int    `json:"count"`
+}
+
+// MessagePreview is the server-resolved snapshot of a message being replied to.
+type MessagePreview struct {
+	Id       string      `json:"id"`
+	SenderId string      `json:"sender_id"`
+	Content  string      `json:"content"`
+	Type     MessageType `json:"type"`
+}
+
 type Message struct {
-	Id        string      `json:"id"`
-	Type      MessageType `json:"type"`
-	Content   string      `json:"content"`
-	SenderId  string      `json:"sender_id"`
-	Received  bool        `json:"received"`
-	Seen      bool        `json:"seen"`
-	Media     []Media     `json:"media" db:"media"`
-	Reactions []Reaction  `json:"reactions" db:"reactions"`
-	CreatedAt time.Time   `json:"created_at"`
-	UpdatedAt time.Time   `json:"updated_at"`
+	Id             string          `json:"id"`
+	Type           MessageType     `json:"type"`
+	Content        string          `json:"content"`
+	SenderId       string          `json:"sender_id"`
+	Received       bool            `json:"received"`
+	Seen           bool            `json:"seen"`
+	Media          []Media         `json:"media" db:"media"`
+	Reactions      []Reaction      `json:"reactions" db:"reactions"`
+	ReactionCounts []ReactionCount `json:"reaction_counts,omitempty" db:"reaction_counts"`
+	ReplyToId      string          `json:"reply_to_id,omitempty"`
+	ReplyTo        *MessagePreview `json:"reply_to,omitempty" db:"reply_to"`
+	DateProposal   *DateProposal   `json:"date_proposal,omitempty" db:"date_proposal"`
+	Call           *CallLog        `json:"call,omitempty" db:"call"`
+	EditedAt       *time.Time      `json:"edited_at,omitempty"`
+	// Sealed replaces the content, media, reply preview and date proposal
+	// while the message is at rest. Messages handed out are always opened.
+	Sealed    *SealedContent `json:"sealed,omitempty" db:"sealed"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// SealedContent is the AES-GCM encrypted part of a message, sealed with
+// version KeyVersion of the chat's data key.
+type SealedContent struct {
+	KeyVersion int    `json:"v"`
+	Nonce      []byte `json:"n"`
+	Data       []byte `json:"d"`
+}
+
+// DateProposal is the structured part of a DATE_PROPOSAL message. The
+// response fields are filled in once the other participant answers it.
+type DateProposal struct {
+	StartsAt    time.Time          `json:"starts_at"`
+	Venue       string             `json:"venue"`
+	Note        string             `json:"note,omitempty"`
+	Status      DateProposalStatus `json:"status"`
+	RespondedBy string             `json:"responded_by,omitempty"`
+	RespondedAt *time.Time         `json:"responded_at,omitempty"`
+	CounterId   string             `json:"counter_id,omitempty"` // the proposal sent back instead
+}
+
+// CallLog is the structured part of a CALL message. The message is sent by
+// the caller.
+type CallLog struct {
+	CallId      string     `json:"call_id"`
+	Media       string     `json:"media"` // "audio" or "video"
+	Status      CallStatus `json:"status"`
+	StartedAt   time.Time  `json:"started_at"`
+	AnsweredAt  *time.Time `json:"answered_at,omitempty"`
+	EndedAt     time.Time  `json:"ended_at"`
+	DurationSec int        `json:"duration_sec"` // time since answered
+	EndedBy     string     `json:"ended_by,omitempty"`
 }
 
 type Claims struct {
@@ -29,4 +29,32 @@ const (
 	VIDEO MessageType = "VIDEO"
 	AUDIO MessageType = "AUDIO"
 	FILE  MessageType = "FILE"
+	// SYSTEM messages are posted by the server, never by a participant.
+	SYSTEM MessageType = "SYSTEM"
+	// DATE_PROPOSAL messages carry a DateProposal.
+	DATE_PROPOSAL MessageType = "DATE_PROPOSAL"
+	// CALL messages carry a CallLog and are written by the server when a
+	// call ends.
+	CALL MessageType = "CALL"
+)
+
+// SystemSenderId is the sender of SYSTEM messages.
+const SystemSenderId = "system"
+
+type DateProposalStatus string
+
+const (
+	DatePending   DateProposalStatus = "PENDING"
+	DateAccepted  DateProposalStatus = "ACCEPTED"
+	DateDeclined  DateProposalStatus = "DECLINED"
+	DateCountered DateProposalStatus = "COUNTERED"
+)
+
+type CallStatus string
+
+const (
+	CallCompleted CallStatus = "COMPLETED"
+	CallMissed    CallStatus = "MISSED"
+	CallDeclined  CallStatus = "DECLINED"
+	CallCancelled CallStatus = "CANCELLED"
 )
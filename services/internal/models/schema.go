@@ -24,6 +24,8 @@ type User struct {
 	IsVerified        bool           `json:"is_verified"`
 	Address           Address        `json:"address" db:"address"`
 	Extra             ExtraMetadata  `json:"extra" db:"extra"`
+	HideOnline        bool           `json:"hide_online"`
+	LastSeenAt        *time.Time     `json:"last_seen_at"` // durable copy; live value is in redis
 	CreatedAt         time.Time      `json:"created_at"`
 	UpdatedAt         time.Time      `json:"updated_at"`
 }
@@ -73,14 +75,15 @@ type Comment struct {
 }
 
 type UserFiles struct {
-	TableName  struct{}  `karma_table:"user_files"`
-	Id         string    `json:"id" karma:"primary"`
-	Uid        string    `json:"uid"`
-	Key        string    `json:"key"`
-	S3Path     string    `json:"s3_path"`
-	Visibility string    `json:"visibility"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	TableName  struct{}       `karma_table:"user_files"`
+	Id         string         `json:"id" karma:"primary"`
+	Uid        string         `json:"uid"`
+	Key        string         `json:"key"`
+	S3Path     string         `json:"s3_path"`
+	Visibility string         `json:"visibility"`
+	Metadata   *MediaMetadata `json:"metadata,omitempty" db:"metadata"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
 }
 
 type UserProfileActivity struct {
@@ -132,3 +135,155 @@ type UserVerification struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+type HeldMessage struct {
+	TableName string    `karma_table:"held_messages" json:"-"`
+	Id        string    `json:"id" karma:"primary"`
+	ChatId    string    `json:"chat_id"`
+	SenderId  string    `json:"sender_id"`
+	Message   Message   `json:"message" db:"message"`
+	Reason    string    `json:"reason"`
+	Status    string    `json:"status"` // "pending", "released", "discarded"
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ChatReadCursor struct {
+	TableName string    `karma_table:"chat_read_cursors" json:"-"`
+	Id        string    `json:"id" karma:"primary"`
+	ChatId    string    `json:"chat_id"`
+	UserId    string    `json:"user_id"`
+	MessageId string    `json:"message_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ScheduledMessage struct {
+	TableName string    `karma_table:"scheduled_messages" json:"-"`
+	Id        string    `json:"id" karma:"primary"`
+	ChatId    string    `json:"chat_id"`
+	SenderId  string    `json:"sender_id"`
+	Message   Message   `json:"message" db:"message"`
+	DeliverAt time.Time `json:"deliver_at"`
+	Status    string    `json:"status"` // "pending", "sending", "sent", "held", "cancelled", "failed"
+	Attempts  int       `json:"attempts"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ConnectionSettings is how one user organizes one of their matches. Other
+// users never see it.
+type ConnectionSettings struct {
+	TableName  string     `karma_table:"connection_settings" json:"-"`
+	Id         string     `json:"id" karma:"primary"`
+	UserId     string     `json:"user_id"`
+	MatchId    string     `json:"match_id"`
+	Pinned     bool       `json:"pinned"`
+	Archived   bool       `json:"archived"`
+	MutedUntil *time.Time `json:"muted_until"`
+	Nickname   string     `json:"nickname"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// MessageRevision is a version of a message that was later edited. CreatedAt
+// is when this version was written and ReplacedAt when the edit replaced it.
+type MessageRevision struct {
+	TableName string  `karma_table:"message_revisions" json:"-"`
+	Id        string  `json:"id" karma:"primary"`
+	ChatId    string  `json:"chat_id"`
+	MessageId string  `json:"message_id"`
+	SenderId  string  `json:"sender_id"`
+	Content   string  `json:"content"`
+	Media     []Media `json:"media" db:"media"`
+	// Sealed holds the content and media at rest, as for messages.
+	Sealed     *SealedContent `json:"sealed,omitempty" db:"sealed"`
+	CreatedAt  time.Time      `json:"created_at"`
+	ReplacedAt time.Time      `json:"replaced_at"`
+}
+
+// PlannedDate is an accepted date proposal.
+type PlannedDate struct {
+	TableName  string    `karma_table:"planned_dates" json:"-"`
+	Id         string    `json:"id" karma:"primary"`
+	MatchId    string    `json:"match_id"`
+	ChatId     string    `json:"chat_id"`
+	MessageId  string    `json:"message_id"`
+	ProposedBy string    `json:"proposed_by"`
+	AcceptedBy string    `json:"accepted_by"`
+	StartsAt   time.Time `json:"starts_at"`
+	Venue      string    `json:"venue"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TrustedContact is someone a user wants told about their dates and alerted
+// if they miss a check-in.
+type TrustedContact struct {
+	TableName string    `karma_table:"trusted_contacts" json:"-"`
+	Id        string    `json:"id" karma:"primary"`
+	UserId    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DateCheckIn is a promise to check in by CheckInAt after a planned date.
+// Missing it alerts the user's trusted contacts.
+type DateCheckIn struct {
+	TableName   string     `karma_table:"date_checkins" json:"-"`
+	Id          string     `json:"id" karma:"primary"`
+	UserId      string     `json:"user_id"`
+	DateId      string     `json:"date_id"`
+	CheckInAt   time.Time  `json:"check_in_at"`
+	Status      string     `json:"status"` // "scheduled", "checked_in", "missed", "cancelled"
+	CheckedInAt *time.Time `json:"checked_in_at"`
+	AlertedAt   *time.Time `json:"alerted_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ChatExport is a participant's request for a transcript of a chat. Once
+// ready, FileId points at the user_files row holding it.
+type ChatExport struct {
+	TableName string    `karma_table:"chat_exports" json:"-"`
+	Id        string    `json:"id" karma:"primary"`
+	ChatId    string    `json:"chat_id"`
+	UserId    string    `json:"user_id"`
+	Format    string    `json:"format"` // "json", "html"
+	Status    string    `json:"status"` // "pending", "running", "ready", "failed"
+	Attempts  int       `json:"attempts"`
+	FileId    string    `json:"file_id"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ChatKey is one version of a chat's data key, wrapped by the master key
+// MasterKeyId. Messages record the version they were sealed with.
+type ChatKey struct {
+	TableName   string    `karma_table:"chat_keys" json:"-"`
+	Id          string    `json:"id" karma:"primary"`
+	ChatId      string    `json:"chat_id"`
+	Version     int       `json:"version"`
+	MasterKeyId int       `json:"master_key_id"`
+	WrappedKey  string    `json:"wrapped_key"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ChatArchive is a compressed segment of a chat's oldest messages, moved out
+// of the chats row by the archival job. Seq orders a chat's segments from
+// oldest to newest.
+type ChatArchive struct {
+	TableName      string    `karma_table:"chat_archives" json:"-"`
+	Id             string    `json:"id" karma:"primary"`
+	ChatId         string    `json:"chat_id"`
+	Seq            int       `json:"seq"`
+	MessageIds     []string  `json:"message_ids" db:"message_ids"`
+	MessageCount   int       `json:"message_count"`
+	FirstMessageAt time.Time `json:"first_message_at"`
+	LastMessageAt  time.Time `json:"last_message_at"`
+	S3Path         string    `json:"s3_path"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
@@ -4,6 +4,7 @@ import (
 	"blindly/internal/handlers/ai"
 	"blindly/internal/handlers/chat"
 	"blindly/internal/handlers/fs"
+	"blindly/internal/helpers/wsproto"
 	"blindly/internal/middlewares"
 
 	"github.com/gofiber/fiber/v2"
@@ -28,11 +29,17 @@ func Routes() *fiber.App {
 
 	chatserviceRoutes := v1.Group("/chat")
 	chatserviceRoutes.Post("/flush", chat.FlushHandler)
-	chatserviceRoutes.Get("/ws/:chatId", middlewares.IsWebsocketVerified, websocket.New(chat.WSHandler))
+	chatserviceRoutes.Post("/view-once/purge", chat.ViewOncePurgeHandler)
+	chatserviceRoutes.Post("/scheduled/deliver", chat.ScheduledDeliveryHandler)
+	chatserviceRoutes.Post("/checkins/due", chat.CheckInDueHandler)
+	chatserviceRoutes.Post("/exports/run", chat.ExportRunHandler)
+	chatserviceRoutes.Get("/dates/ics", middlewares.IsUserVerified, chat.DatesICSHandler)
+	chatserviceRoutes.Get("/dates/:dateId/ics", middlewares.IsUserVerified, chat.DatesICSHandler)
+	chatserviceRoutes.Get("/ws/:chatId", middlewares.IsWebsocketVerified, websocket.New(chat.WSHandler, wsproto.UpgradeConfig()))
 
 	aiRoutes := v1.Group("/ai")
 	aiRoutes.Get("/summarize_profile/:userId", middlewares.IsUserVerified, ai.GetProfileSummary)
-	aiRoutes.Get("/chat", middlewares.IsWebsocketVerified, websocket.New(ai.AIChatHandler))
+	aiRoutes.Get("/chat", middlewares.IsWebsocketVerified, websocket.New(ai.AIChatHandler, wsproto.UpgradeConfig()))
 
 	return app
 }
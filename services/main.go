@@ -4,6 +4,7 @@ import (
 	"blindly/internal/cmd"
 	"blindly/internal/logger"
 	"context"
+	"log"
 	"os"
 	"os/signal"
 	"syscall"
@@ -13,12 +14,20 @@ import (
 var Version = "dev" // overridden at build/run time
 
 func main() {
+	if len(os.Args) > 1 {
+		if err := cmd.RunCommand(os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	go cmd.StartGraphql(ctx)
 	go cmd.StartGoFiber(ctx)
 	go cmd.StartProxyServer(ctx)
+	go cmd.StartScheduler(ctx)
 
 	l := logger.NewLogger()
 	l.Startup(Version)